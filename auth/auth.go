@@ -2,25 +2,48 @@ package auth
 
 import (
 	"context"
+	"time"
 
 	"github.com/bnb-chain/greenfield-go-sdk/client"
 	"github.com/bnb-chain/greenfield/x/permission/types"
 	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/node-real/greenfield-bundle-service/cache"
+	"github.com/node-real/greenfield-bundle-service/metrics"
 )
 
 type AuthManager struct {
 	gnfdClient client.IClient
+	gnfdCache  *cache.GnfdCache
 }
 
-func NewAuthManager(gnfdClient client.IClient) *AuthManager {
+func NewAuthManager(gnfdClient client.IClient, gnfdCache *cache.GnfdCache) *AuthManager {
 	return &AuthManager{
 		gnfdClient: gnfdClient,
+		gnfdCache:  gnfdCache,
 	}
 }
 
-// IsBucketPermissionGranted check if the bucket permission is granted
+// IsBucketPermissionGranted check if the permission to create objects in the bucket is granted, the result is cached
 func (a *AuthManager) IsBucketPermissionGranted(bundlerAddress common.Address, bucket string) (bool, error) {
-	effect, err := a.gnfdClient.IsBucketPermissionAllowed(context.Background(), bundlerAddress.Hex(), bucket, types.ACTION_CREATE_OBJECT)
+	return a.isBucketActionAllowed(bundlerAddress, bucket, types.ACTION_CREATE_OBJECT)
+}
+
+// IsBucketReadPermissionGranted check if the permission to get objects in the bucket is granted, the result is cached
+func (a *AuthManager) IsBucketReadPermissionGranted(address common.Address, bucket string) (bool, error) {
+	return a.isBucketActionAllowed(address, bucket, types.ACTION_GET_OBJECT)
+}
+
+func (a *AuthManager) isBucketActionAllowed(address common.Address, bucket string, action types.ActionType) (bool, error) {
+	return a.gnfdCache.GetBucketPermission(address.Hex(), bucket, action, func() (bool, error) {
+		return a.queryBucketPermission(address, bucket, action)
+	})
+}
+
+func (a *AuthManager) queryBucketPermission(address common.Address, bucket string, action types.ActionType) (bool, error) {
+	start := time.Now()
+	effect, err := a.gnfdClient.IsBucketPermissionAllowed(context.Background(), address.Hex(), bucket, action)
+	metrics.ObserveGnfdRequest("is_bucket_permission_allowed", start, err)
 	if err != nil {
 		return false, err
 	}
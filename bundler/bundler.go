@@ -1,15 +1,15 @@
 package bundler
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"io"
+	"os"
 	"strconv"
+	"sync"
 	"time"
 
-	"github.com/bnb-chain/greenfield-bundle-sdk/bundle"
 	bundleTypes "github.com/bnb-chain/greenfield-bundle-sdk/types"
 	"github.com/bnb-chain/greenfield-go-sdk/client"
 	"github.com/bnb-chain/greenfield-go-sdk/types"
@@ -20,6 +20,7 @@ import (
 
 	"github.com/node-real/greenfield-bundle-service/dao"
 	"github.com/node-real/greenfield-bundle-service/database"
+	"github.com/node-real/greenfield-bundle-service/metrics"
 	"github.com/node-real/greenfield-bundle-service/service"
 	"github.com/node-real/greenfield-bundle-service/storage"
 	btypes "github.com/node-real/greenfield-bundle-service/types"
@@ -37,7 +38,14 @@ type Bundler struct {
 	objectDao         dao.ObjectDao
 	bundleDao         dao.BundleDao
 	bundlerAccountDao dao.BundlerAccountDao
+	leaseDao          dao.LeaseDao
+	unbundleJobDao    dao.UnbundleJobDao
 	fileManager       *storage.FileManager
+
+	leaseHolder         string
+	leaseDuration       time.Duration
+	shutdownTimeout     time.Duration
+	compactionThreshold float64
 }
 
 func NewBundler(config *util.ServerConfig, db *gorm.DB) (*Bundler, error) {
@@ -50,26 +58,67 @@ func NewBundler(config *util.ServerConfig, db *gorm.DB) (*Bundler, error) {
 		util.Logger.Fatalf("unable to new greenfield client, %v", err)
 	}
 
+	leaseDuration := DefaultLeaseDuration
+	if config.BundleConfig.LeaseDuration > 0 {
+		leaseDuration = time.Duration(config.BundleConfig.LeaseDuration) * time.Second
+	}
+
+	shutdownTimeout := DefaultShutdownTimeout
+	if config.BundleConfig.ShutdownTimeout > 0 {
+		shutdownTimeout = time.Duration(config.BundleConfig.ShutdownTimeout) * time.Second
+	}
+
+	compactionThreshold := DefaultCompactionThreshold
+	if config.BundleConfig.CompactionThreshold > 0 {
+		compactionThreshold = config.BundleConfig.CompactionThreshold
+	}
+
 	fileManager := storage.NewFileManager(config, objectDao, bundleDao, gnfdClient)
 	return &Bundler{
-		config:            config,
-		objectDao:         objectDao,
-		bundleDao:         bundleDao,
-		bundlerAccountDao: bundlerAccountDao,
-		fileManager:       fileManager,
+		config:              config,
+		objectDao:           objectDao,
+		bundleDao:           bundleDao,
+		bundlerAccountDao:   bundlerAccountDao,
+		leaseDao:            dao.NewLeaseDao(db),
+		unbundleJobDao:      dao.NewUnbundleJobDao(db),
+		fileManager:         fileManager,
+		leaseHolder:         newLeaseHolder(),
+		leaseDuration:       leaseDuration,
+		shutdownTimeout:     shutdownTimeout,
+		compactionThreshold: compactionThreshold,
 	}, nil
 }
 
-func (b *Bundler) Run() {
-	b.startSubmitLoops()
-	b.finalizeLoop()
+// Run runs the finalize loop and the submit loops until ctx is done, multiple bundler replicas can run at the same
+// time since every loop only runs on the replica holding its lease. Once ctx is done, the loops stop picking up new
+// bundles and Run returns after the in-flight submissions finish or are canceled after the shutdown timeout.
+func (b *Bundler) Run(ctx context.Context) {
+	util.Logger.Infof("bundler started, lease holder=%s", b.leaseHolder)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		b.metricsLoop(ctx)
+	}()
+	b.startSubmitLoops(ctx, &wg)
+	b.runWithLease(ctx, FinalizerLeaseName, b.finalizeLoop)
+	wg.Wait()
+
+	util.Logger.Infof("bundler stopped, lease holder=%s", b.leaseHolder)
 }
 
-func (b *Bundler) finalizeLoop() {
+func (b *Bundler) finalizeLoop(ctx context.Context) {
 	ticker := time.NewTicker(1 * time.Second)
 	defer ticker.Stop()
 
-	for range ticker.C {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
 		bundles, err := b.bundleDao.GetBundlingBundles()
 		if err != nil {
 			util.Logger.Errorf("get time out bundling bundles failed, err=%v", err.Error())
@@ -77,6 +126,9 @@ func (b *Bundler) finalizeLoop() {
 		}
 
 		for _, bundle := range bundles {
+			if ctx.Err() != nil {
+				return
+			}
 			if !service.IsAutoGeneratedBundleName(bundle.Name) {
 				// mark the bundle created by user as expired if it is not finalized in time
 				if time.Since(bundle.CreatedAt).Seconds() >= float64(bundle.MaxFinalizeTime) {
@@ -101,7 +153,28 @@ func (b *Bundler) finalizeLoop() {
 	}
 }
 
-func (b *Bundler) startSubmitLoops() {
+// metricsLoop refreshes the bundle metrics, it runs on every replica since it only reads the database
+func (b *Bundler) metricsLoop(ctx context.Context) {
+	ticker := time.NewTicker(15 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		counts, err := b.bundleDao.CountBundlesByStatus()
+		if err != nil {
+			util.Logger.Errorf("count bundles by status failed, err=%v", err.Error())
+		} else {
+			metrics.SetBundleCounts(counts)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (b *Bundler) startSubmitLoops(ctx context.Context, wg *sync.WaitGroup) {
 	if len(b.config.BundleConfig.BundlerPrivateKeys) == 0 {
 		util.Logger.Fatal("no bundler account available")
 	}
@@ -115,7 +188,13 @@ func (b *Bundler) startSubmitLoops() {
 		// register bundler account
 		b.registerBundler(account)
 
-		go b.submitLoop(account)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			b.runWithLease(ctx, SubmitterLeasePrefix+account.GetAddress().String(), func(ctx context.Context) {
+				b.submitLoop(ctx, account)
+			})
+		}()
 	}
 }
 
@@ -141,11 +220,17 @@ func (b *Bundler) registerBundler(account *types.Account) {
 	}
 }
 
-func (b *Bundler) submitLoop(account *types.Account) {
+func (b *Bundler) submitLoop(ctx context.Context, account *types.Account) {
 	ticker := time.NewTicker(1 * time.Second)
 	defer ticker.Stop()
 	sealTicker := time.NewTicker(30 * time.Second)
 	defer sealTicker.Stop()
+	compactTicker := time.NewTicker(CompactionInterval)
+	defer compactTicker.Stop()
+	unbundleTicker := time.NewTicker(UnbundleInterval)
+	defer unbundleTicker.Stop()
+	importTicker := time.NewTicker(ImportInterval)
+	defer importTicker.Stop()
 
 	accountAddr := account.GetAddress().String()
 	client, err := client.New(b.config.GnfdConfig.ChainId, b.config.GnfdConfig.RpcUrl, client.Option{DefaultAccount: account})
@@ -155,6 +240,8 @@ func (b *Bundler) submitLoop(account *types.Account) {
 
 	for {
 		select {
+		case <-ctx.Done():
+			return
 		case <-ticker.C:
 			bundles, err := b.bundleDao.GetFinalizedBundlesByBundlerAccount(accountAddr)
 			if err != nil {
@@ -163,43 +250,14 @@ func (b *Bundler) submitLoop(account *types.Account) {
 			}
 
 			for _, bundle := range bundles {
-				if !bundle.IsTimeToRetry() {
-					continue
-				}
-
-				bundledObject, size, err := b.assembleOrGetBundleObject(bundle)
-				if err != nil {
-					util.Logger.Errorf("assemble bundle object failed, bundle=%s, err=%v", bundle.Bucket+bundle.Name, err.Error())
-					bundle.RetryCounter++
-					bundle.ErrMessage = fmt.Sprintf("assemble bundle failed: %v", err)
-					_, err = b.bundleDao.UpdateBundle(*bundle)
-					if err != nil {
-						util.Logger.Errorf("update bundle error, bundle=%+v, err=%s", bundle, err.Error())
-					}
-					continue
+				// stop picking up new bundles once the loop is stopped
+				if ctx.Err() != nil {
+					return
 				}
-
-				txHash, objectDetail, err := b.submitBundledObject(client, bundle, bundledObject, size)
-				if err != nil {
-					util.Logger.Errorf("submit bundle object failed, bundle=%s, err=%v", bundle.Bucket+bundle.Name, err.Error())
-					bundle.RetryCounter++
-					bundle.ErrMessage = fmt.Sprintf("submit bundle failed: %v", err)
-					_, err = b.bundleDao.UpdateBundle(*bundle)
-					if err != nil {
-						util.Logger.Errorf("update bundle error, bundle=%+v, err=%s", bundle, err.Error())
-					}
+				if !bundle.IsTimeToRetry() {
 					continue
 				}
-
-				bundle.Status = database.BundleStatusCreatedOnChain
-				bundle.TxHash = txHash
-				bundle.ObjectId = objectDetail.ObjectInfo.Id.Uint64()
-				bundle.RetryCounter = 0
-				bundle.ErrMessage = EmptyErrMessage
-				_, err = b.bundleDao.UpdateBundle(*bundle)
-				if err != nil {
-					util.Logger.Errorf("update bundle error, bundle=%+v, err=%s", bundle, err.Error())
-				}
+				b.submitBundle(ctx, client, bundle)
 			}
 
 		case <-sealTicker.C:
@@ -210,103 +268,211 @@ func (b *Bundler) submitLoop(account *types.Account) {
 			}
 
 			for _, bundle := range bundles {
-				sealed := b.checkBundleSealed(client, bundle)
-				if sealed {
-					bundle.Status = database.BundleStatusSealedOnChain
-					_, err = b.bundleDao.UpdateBundle(*bundle)
-					if err != nil {
-						util.Logger.Errorf("update bundle error, bundle=%+v, err=%s", bundle, err.Error())
-					}
-					continue
+				if ctx.Err() != nil {
+					return
 				}
+				b.checkBundle(ctx, client, bundle)
+			}
 
-				if bundle.RetryCounter == 0 && time.Since(bundle.UpdatedAt).Seconds() < MaxSealOnChainTime {
-					continue
-				}
+		case <-compactTicker.C:
+			b.compactBundles(ctx, client, accountAddr)
 
-				// Cancel create for sealing timeout bundled object.
-				if !bundle.IsTimeToRetry() {
-					continue
-				}
-				err = b.cancelCreateBundle(client, bundle)
-				if err != nil {
-					util.Logger.Errorf("cancel create timeout bundle error, bundle=%+v, err=%s", bundle, err.Error())
-					bundle.RetryCounter++
-					bundle.ErrMessage = fmt.Sprintf("seal timeout, but cancel bundle failed: %v", err)
-					_, err = b.bundleDao.UpdateBundle(*bundle)
-					if err != nil {
-						util.Logger.Errorf("update bundle error, bundle=%+v, err=%s", bundle, err.Error())
-					}
-					continue
-				}
+		case <-unbundleTicker.C:
+			b.unbundleObjects(ctx, client, accountAddr)
 
-				// Change the bundle status to "finalized" to trigger resubmission.
-				bundle.Status = database.BundleStatusFinalized
-				bundle.RetryCounter = 0
-				bundle.ErrMessage = EmptyErrMessage
-				_, err = b.bundleDao.UpdateBundle(*bundle)
-				if err != nil {
-					util.Logger.Errorf("update bundle error, bundle=%+v, err=%s", bundle, err.Error())
-				}
-			}
+		case <-importTicker.C:
+			b.importBundles(ctx, client, accountAddr)
 		}
 	}
 }
 
-func (b *Bundler) assembleOrGetBundleObject(bundleRecord *database.Bundle) (io.ReadCloser, int64, error) {
-	storedBundle, err := b.fileManager.GetBundle(bundleRecord.Bucket, bundleRecord.Name)
-	if err == nil {
-		return storedBundle, bundleRecord.Size, nil
+// submitBundle assembles the finalized bundle and submits it to Greenfield, the submission is allowed to finish
+// within the shutdown timeout once the loop is stopped
+func (b *Bundler) submitBundle(ctx context.Context, client client.IClient, bundle *database.Bundle) {
+	ctx, cancel := b.withShutdownGrace(ctx)
+	defer cancel()
+
+	bundledObject, err := b.spoolBundleObject(ctx, bundle)
+	if err != nil {
+		metrics.ObserveBundleOperation(metrics.OperationSubmit, bundle.BundlerAccount, err)
+		util.Logger.Errorf("assemble bundle object failed, bundle=%s, err=%v", bundle.Bucket+bundle.Name, err.Error())
+		bundle.RetryCounter++
+		bundle.ErrMessage = fmt.Sprintf("assemble bundle failed: %v", err)
+		_, err = b.bundleDao.UpdateBundle(*bundle)
+		if err != nil {
+			util.Logger.Errorf("update bundle error, bundle=%+v, err=%s", bundle, err.Error())
+		}
+		return
 	}
 
-	newBundle, err := bundle.NewBundle()
+	txHash, objectDetail, err := b.submitBundledObject(ctx, client, bundle, bundledObject.File, bundledObject.size)
+	bundledObject.Remove()
+	metrics.ObserveBundleOperation(metrics.OperationSubmit, bundle.BundlerAccount, err)
 	if err != nil {
-		return nil, 0, fmt.Errorf("new bundle failed: %v", err)
+		util.Logger.Errorf("submit bundle object failed, bundle=%s, err=%v", bundle.Bucket+bundle.Name, err.Error())
+		bundle.RetryCounter++
+		bundle.ErrMessage = fmt.Sprintf("submit bundle failed: %v", err)
+		_, err = b.bundleDao.UpdateBundle(*bundle)
+		if err != nil {
+			util.Logger.Errorf("update bundle error, bundle=%+v, err=%s", bundle, err.Error())
+		}
+		return
 	}
 
-	objects, err := b.objectDao.GetBundleObjects(bundleRecord.Bucket, bundleRecord.Name)
+	bundle.Status = database.BundleStatusCreatedOnChain
+	bundle.TxHash = txHash
+	bundle.ObjectId = objectDetail.ObjectInfo.Id.Uint64()
+	bundle.RetryCounter = 0
+	bundle.ErrMessage = EmptyErrMessage
+	_, err = b.bundleDao.UpdateBundle(*bundle)
 	if err != nil {
-		return nil, 0, fmt.Errorf("get bundle objects failed: %v", err)
+		util.Logger.Errorf("update bundle error, bundle=%+v, err=%s", bundle, err.Error())
 	}
+}
 
-	for _, object := range objects {
-		objectReader, err := b.fileManager.GetObject(bundleRecord.Bucket, bundleRecord.Name, object.ObjectName)
+// checkBundle checks whether the bundle created on chain is sealed, and cancels the creation for resubmission if
+// it is not sealed in time
+func (b *Bundler) checkBundle(ctx context.Context, client client.IClient, bundle *database.Bundle) {
+	ctx, cancel := b.withShutdownGrace(ctx)
+	defer cancel()
+
+	sealed := b.checkBundleSealed(ctx, client, bundle)
+	if sealed {
+		metrics.ObserveBundleOperation(metrics.OperationSeal, bundle.BundlerAccount, nil)
+		metrics.BundleSealSeconds.Observe(time.Since(bundle.CreatedAt).Seconds())
+		bundle.Status = database.BundleStatusSealedOnChain
+		_, err := b.bundleDao.UpdateBundle(*bundle)
 		if err != nil {
-			return nil, 0, fmt.Errorf("get object failed, object=%s, err=%v", object.ObjectName, err)
+			util.Logger.Errorf("update bundle error, bundle=%+v, err=%s", bundle, err.Error())
 		}
+		return
+	}
 
-		var tags map[string]string
-		err = json.Unmarshal([]byte(object.Tags), &tags)
-		if err != nil {
-			util.Logger.Warnf("unmarshal tags failed, tags=%s, err=%v", object.Tags, err.Error())
-			tags = nil
-		}
-		objectMeta, err := newBundle.AppendObject(object.ObjectName, objectReader, &bundleTypes.AppendObjectOptions{
-			HashAlgo:    object.HashAlgo,
-			Hash:        object.Hash,
-			ContentType: object.ContentType,
-			Tags:        tags,
-		})
+	if bundle.RetryCounter == 0 && time.Since(bundle.UpdatedAt).Seconds() < MaxSealOnChainTime {
+		return
+	}
+
+	// Cancel create for sealing timeout bundled object.
+	if !bundle.IsTimeToRetry() {
+		return
+	}
+	// the bundle is not sealed in time
+	metrics.ObserveBundleOperation(metrics.OperationSeal, bundle.BundlerAccount, fmt.Errorf("seal timeout"))
+	err := b.cancelCreateBundle(ctx, client, bundle)
+	metrics.ObserveBundleOperation(metrics.OperationCancel, bundle.BundlerAccount, err)
+	if err != nil {
+		util.Logger.Errorf("cancel create timeout bundle error, bundle=%+v, err=%s", bundle, err.Error())
+		bundle.RetryCounter++
+		bundle.ErrMessage = fmt.Sprintf("seal timeout, but cancel bundle failed: %v", err)
+		_, err = b.bundleDao.UpdateBundle(*bundle)
 		if err != nil {
-			return nil, 0, fmt.Errorf("append object to bundle object failed, object=%s, err=%v", object.ObjectName, err)
+			util.Logger.Errorf("update bundle error, bundle=%+v, err=%s", bundle, err.Error())
 		}
+		return
+	}
+
+	// Change the bundle status to "finalized" to trigger resubmission.
+	bundle.Status = database.BundleStatusFinalized
+	bundle.RetryCounter = 0
+	bundle.ErrMessage = EmptyErrMessage
+	_, err = b.bundleDao.UpdateBundle(*bundle)
+	if err != nil {
+		util.Logger.Errorf("update bundle error, bundle=%+v, err=%s", bundle, err.Error())
+	}
+}
+
+// spoolBundleObject returns the bundled object spooled to a temporary file, so the memory used for submitting a bundle
+// is bounded regardless of the bundle size. The stored bundle file is used if it exists, otherwise the bundle is
+// assembled from the stored object files. The caller should remove the spooled bundle once it is submitted.
+func (b *Bundler) spoolBundleObject(ctx context.Context, bundleRecord *database.Bundle) (*spooledBundle, error) {
+	storedBundle, err := b.fileManager.GetBundle(ctx, bundleRecord.Bucket, bundleRecord.Name)
+	if err == nil {
+		defer storedBundle.Close()
+		return spoolReader(storedBundle)
+	}
+	if !storage.IsNoSuchKey(err) {
+		return nil, fmt.Errorf("get stored bundle failed: %v", err)
+	}
+
+	return b.assembleBundleObject(ctx, bundleRecord)
+}
+
+// assembleBundleObject assembles the bundle from the stored object files, the objects are appended to a temporary
+// file instead of being kept in memory
+func (b *Bundler) assembleBundleObject(ctx context.Context, bundleRecord *database.Bundle) (*spooledBundle, error) {
+	objects, err := b.objectDao.GetBundleObjects(bundleRecord.Bucket, bundleRecord.Name)
+	if err != nil {
+		return nil, fmt.Errorf("get bundle objects failed: %v", err)
+	}
+	if len(objects) == 0 {
+		return nil, fmt.Errorf("empty bundle")
+	}
 
-		object.OffsetInBundle = int64(objectMeta.Offset)
-		_, err = b.objectDao.UpdateObject(*object)
+	newBundle, err := newBundleWriter()
+	if err != nil {
+		return nil, fmt.Errorf("new bundle failed: %v", err)
+	}
+
+	for _, object := range objects {
+		err = b.appendObjectToBundle(ctx, newBundle, bundleRecord, object)
 		if err != nil {
-			return nil, 0, fmt.Errorf("update object error, object=%+v, err=%s", object, err.Error())
+			newBundle.Discard()
+			return nil, err
 		}
 	}
 
-	bundledObject, size, err := newBundle.FinalizeBundle()
+	spooled, err := newBundle.Finalize()
+	if err != nil {
+		newBundle.Discard()
+		return nil, fmt.Errorf("finalize bundle failed, err=%v", err)
+	}
+	return spooled, nil
+}
+
+func (b *Bundler) appendObjectToBundle(ctx context.Context, newBundle *bundleWriter, bundleRecord *database.Bundle, object *database.Object) error {
+	offset, err := appendObject(ctx, b.fileManager, newBundle, bundleRecord, object)
+	if err != nil {
+		return err
+	}
+
+	object.OffsetInBundle = offset
+	_, err = b.objectDao.UpdateObject(*object)
+	if err != nil {
+		return fmt.Errorf("update object error, object=%+v, err=%s", object, err.Error())
+	}
+	return nil
+}
+
+// appendObject appends the object of the bundle record read by the file manager to the new bundle, and returns its
+// offset in the new bundle
+func appendObject(ctx context.Context, fileManager *storage.FileManager, newBundle *bundleWriter, bundleRecord *database.Bundle, object *database.Object) (int64, error) {
+	objectReader, err := fileManager.GetObject(ctx, bundleRecord.Bucket, bundleRecord.Name, object.ObjectName, 0, 0)
 	if err != nil {
-		return nil, 0, fmt.Errorf("finalize bundle failed, err=%v", err)
+		return 0, fmt.Errorf("get object failed, object=%s, err=%v", object.ObjectName, err)
 	}
+	defer objectReader.Close()
 
-	return bundledObject, size, nil
+	var tags map[string]string
+	err = json.Unmarshal([]byte(object.Tags), &tags)
+	if err != nil {
+		util.Logger.Warnf("unmarshal tags failed, tags=%s, err=%v", object.Tags, err.Error())
+		tags = nil
+	}
+	objectMeta, err := newBundle.AppendObject(object.ObjectName, objectReader, &bundleTypes.AppendObjectOptions{
+		HashAlgo:    object.HashAlgo,
+		Hash:        object.Hash,
+		ContentType: object.ContentType,
+		Tags:        tags,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("append object to bundle object failed, object=%s, err=%v", object.ObjectName, err)
+	}
+	return int64(objectMeta.Offset), nil
 }
 
-func (b *Bundler) submitBundledObject(client client.IClient, bundle *database.Bundle, object io.ReadCloser, size int64) (string, *types.ObjectDetail, error) {
+// submitBundledObject creates the bundled object on Greenfield if it does not exist and uploads it, the checksums
+// are computed in one pass over the file and the content is then uploaded from the start of the file again
+func (b *Bundler) submitBundledObject(ctx context.Context, client client.IClient, bundle *database.Bundle, object *os.File, size int64) (string, *types.ObjectDetail, error) {
 	if size == 0 {
 		return "", nil, fmt.Errorf("invalid bundle size")
 	}
@@ -316,40 +482,69 @@ func (b *Bundler) submitBundledObject(client client.IClient, bundle *database.Bu
 		return "", nil, fmt.Errorf("invalid owner address, owner=%s, err=%v", bundle.Owner, err)
 	}
 
-	// Read all bytes from the object
-	objectBytes, err := io.ReadAll(object)
-	if err != nil {
-		return "", nil, err
-	}
-
 	var txHash string
-	objectDetail, err := client.HeadObject(context.Background(), bundle.Bucket, bundle.Name)
+	objectDetail, err := headObjectIfExists(ctx, client, bundle.Bucket, bundle.Name)
 	if err != nil {
+		return "", nil, fmt.Errorf("head bundle object failed, bucket=%s, bundle=%s, err=%v", bundle.Bucket, bundle.Name, err)
+	}
+	if objectDetail == nil {
+		// the bundle object follows the visibility of the bucket, so the bundles of a private bucket are private
 		opts := types.CreateObjectOptions{
-			Visibility:  storageTypes.VISIBILITY_TYPE_PUBLIC_READ,
+			Visibility:  storageTypes.VISIBILITY_TYPE_INHERIT,
 			ContentType: "bundle",
 			TxOpts:      &gnfdsdktypes.TxOption{FeeGranter: owner},
 		}
 
-		txHash, err = client.CreateObject(context.Background(), bundle.Bucket, bundle.Name, bytes.NewReader(objectBytes), opts)
+		if _, err = object.Seek(0, io.SeekStart); err != nil {
+			return "", nil, err
+		}
+		start := time.Now()
+		txHash, err = client.CreateObject(ctx, bundle.Bucket, bundle.Name, object, opts)
+		metrics.ObserveGnfdRequest("create_object", start, err)
 		if err != nil {
 			return "", nil, fmt.Errorf("create bundle object failed, bucket=%s, bundle=%s, err=%v", bundle.Bucket, bundle.Name, err)
 		}
 
-		objectDetail, err = client.HeadObject(context.Background(), bundle.Bucket, bundle.Name)
+		start = time.Now()
+		objectDetail, err = client.HeadObject(ctx, bundle.Bucket, bundle.Name)
+		metrics.ObserveGnfdRequest("head_object", start, err)
 		if err != nil {
 			return "", nil, fmt.Errorf("head bundle object failed, bucket=%s, bundle=%s, err=%v", bundle.Bucket, bundle.Name, err)
 		}
 	}
 
+	if _, err = object.Seek(0, io.SeekStart); err != nil {
+		return "", nil, err
+	}
 	opts := types.PutObjectOptions{
 		ContentType: "bundle",
 	}
-	return txHash, objectDetail, client.PutObject(context.Background(), bundle.Bucket, bundle.Name, size, bytes.NewReader(objectBytes), opts)
+	start := time.Now()
+	err = client.PutObject(ctx, bundle.Bucket, bundle.Name, size, object, opts)
+	metrics.ObserveGnfdRequest("put_object", start, err)
+	return txHash, objectDetail, err
+}
+
+// headObjectIfExists queries the object from Greenfield, it returns nil without error if the object does not exist,
+// which is not recorded as a failed request
+func headObjectIfExists(ctx context.Context, client client.IClient, bucket string, object string) (*types.ObjectDetail, error) {
+	start := time.Now()
+	objectDetail, err := client.HeadObject(ctx, bucket, object)
+	if err != nil && service.IsObjectNotFoundError(err) {
+		metrics.ObserveGnfdRequest("head_object", start, nil)
+		return nil, nil
+	}
+	metrics.ObserveGnfdRequest("head_object", start, err)
+	if err != nil {
+		return nil, err
+	}
+	return objectDetail, nil
 }
 
-func (b *Bundler) checkBundleSealed(client client.IClient, bundle *database.Bundle) bool {
-	objectDetail, err := client.HeadObjectByID(context.Background(), strconv.FormatUint(bundle.ObjectId, 10))
+func (b *Bundler) checkBundleSealed(ctx context.Context, client client.IClient, bundle *database.Bundle) bool {
+	start := time.Now()
+	objectDetail, err := client.HeadObjectByID(ctx, strconv.FormatUint(bundle.ObjectId, 10))
+	metrics.ObserveGnfdRequest("head_object_by_id", start, err)
 	if err != nil {
 		util.Logger.Errorf("head bundle object failed, bundle=%s, objectId = %d, err=%v", bundle.Bucket+bundle.Name, bundle.ObjectId, err)
 		return false
@@ -358,15 +553,17 @@ func (b *Bundler) checkBundleSealed(client client.IClient, bundle *database.Bund
 	return objectDetail.ObjectInfo.ObjectStatus == storageTypes.OBJECT_STATUS_SEALED
 }
 
-func (b *Bundler) cancelCreateBundle(client client.IClient, bundle *database.Bundle) error {
+func (b *Bundler) cancelCreateBundle(ctx context.Context, client client.IClient, bundle *database.Bundle) error {
 	owner, err := sdk.AccAddressFromHexUnsafe(bundle.Owner)
 	if err != nil {
 		return fmt.Errorf("invalid owner address, owner=%s, err=%v", bundle.Owner, err)
 	}
 
-	_, err = client.CancelCreateObject(context.Background(), bundle.Bucket, bundle.Name, types.CancelCreateOption{
+	start := time.Now()
+	_, err = client.CancelCreateObject(ctx, bundle.Bucket, bundle.Name, types.CancelCreateOption{
 		TxOpts: &gnfdsdktypes.TxOption{FeeGranter: owner}},
 	)
+	metrics.ObserveGnfdRequest("cancel_create_object", start, err)
 
 	return err
 }
@@ -0,0 +1,39 @@
+package bundler
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/bnb-chain/greenfield-go-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// unavailableGnfdClient is a Greenfield client whose calls fail
+type unavailableGnfdClient struct {
+	accountGnfdClient
+}
+
+func (c *unavailableGnfdClient) HeadObject(ctx context.Context, bucketName, objectName string) (*types.ObjectDetail, error) {
+	return nil, errors.New("connection refused")
+}
+
+func TestHeadObjectIfExists(t *testing.T) {
+	ctx := context.Background()
+	gnfdClient := &accountGnfdClient{objects: map[string][]byte{"bucket/a.txt": []byte("object a")}}
+
+	objectDetail, err := headObjectIfExists(ctx, gnfdClient, "bucket", "a.txt")
+	require.NoError(t, err)
+	require.NotNil(t, objectDetail)
+	assert.Equal(t, "a.txt", objectDetail.ObjectInfo.ObjectName)
+
+	// a missing object is not an error
+	objectDetail, err = headObjectIfExists(ctx, gnfdClient, "bucket", "b.txt")
+	require.NoError(t, err)
+	assert.Nil(t, objectDetail)
+
+	// other errors are returned
+	_, err = headObjectIfExists(ctx, &unavailableGnfdClient{}, "bucket", "a.txt")
+	assert.Error(t, err)
+}
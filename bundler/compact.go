@@ -0,0 +1,219 @@
+package bundler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/bnb-chain/greenfield-go-sdk/client"
+	"github.com/bnb-chain/greenfield-go-sdk/types"
+	gnfdsdktypes "github.com/bnb-chain/greenfield/sdk/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/node-real/greenfield-bundle-service/dao"
+	"github.com/node-real/greenfield-bundle-service/database"
+	"github.com/node-real/greenfield-bundle-service/metrics"
+	"github.com/node-real/greenfield-bundle-service/service"
+	"github.com/node-real/greenfield-bundle-service/util"
+)
+
+const (
+	// DefaultCompactionThreshold is the default fraction of the size of a sealed bundle taken by deleted objects at
+	// which the bundle is compacted
+	DefaultCompactionThreshold = 0.5
+	// CompactionInterval is the interval at which the bundles of a bundler account are checked for compaction
+	CompactionInterval = time.Minute
+)
+
+// compactBundles compacts the sealed bundles of the bundler account with enough deleted objects, and deletes the
+// compacted bundles from Greenfield once the bundles their objects are moved to are sealed
+func (b *Bundler) compactBundles(ctx context.Context, client client.IClient, account string) {
+	bundles, err := b.bundleDao.GetCompactableBundlesByBundlerAccount(account, b.compactionThreshold)
+	if err != nil {
+		util.Logger.Errorf("get compactable bundles by bundler account failed, bundler=%s, err=%v", account, err.Error())
+		return
+	}
+	for _, bundle := range bundles {
+		if ctx.Err() != nil {
+			return
+		}
+		err := b.compactBundle(ctx, client, bundle)
+		metrics.ObserveBundleOperation(metrics.OperationCompact, account, err)
+		if err != nil {
+			util.Logger.Errorf("compact bundle failed, bundle=%s, err=%v", bundle.Bucket+bundle.Name, err.Error())
+		}
+	}
+
+	bundles, err = b.bundleDao.GetCompactedBundlesByBundlerAccount(account)
+	if err != nil {
+		util.Logger.Errorf("get compacted bundles by bundler account failed, bundler=%s, err=%v", account, err.Error())
+		return
+	}
+	for _, bundle := range bundles {
+		if ctx.Err() != nil {
+			return
+		}
+		deleted, err := b.deleteCompactedBundle(ctx, client, bundle)
+		if deleted || err != nil {
+			metrics.ObserveBundleOperation(metrics.OperationDelete, account, err)
+		}
+		if err != nil {
+			util.Logger.Errorf("delete compacted bundle failed, bundle=%s, err=%v", bundle.Bucket+bundle.Name, err.Error())
+		}
+	}
+}
+
+// compactBundle moves the surviving objects of a sealed bundle to a new finalized bundle, which is submitted like any
+// other bundle. The new bundle file is stored before the objects are moved, so the objects can be read from it until
+// the new bundle is sealed. The objects which are not cached are read from Greenfield with the client.
+func (b *Bundler) compactBundle(ctx context.Context, client client.IClient, sealedBundle *database.Bundle) error {
+	ctx, cancel := b.withShutdownGrace(ctx)
+	defer cancel()
+
+	objects, err := b.objectDao.GetBundleObjects(sealedBundle.Bucket, sealedBundle.Name)
+	if err != nil {
+		return fmt.Errorf("get bundle objects failed: %v", err)
+	}
+
+	var newBundle *database.Bundle
+	if len(objects) > 0 {
+		newBundle, err = b.rebundleObjects(ctx, client, sealedBundle, objects)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = b.bundleDao.CompactBundle(*sealedBundle, newBundle, objects)
+	if err != nil {
+		if newBundle != nil {
+			if err := b.fileManager.DeleteBundle(ctx, newBundle.Bucket, newBundle.Name); err != nil {
+				util.Logger.Warnf("delete stored bundle failed, bundle=%s, err=%v", newBundle.Bucket+newBundle.Name, err)
+			}
+		}
+		if errors.Is(err, dao.ErrBundleChanged) {
+			// objects were deleted meanwhile, the bundle is compacted again on the next round
+			return nil
+		}
+		return fmt.Errorf("compact bundle failed: %v", err)
+	}
+
+	// the objects cached under the compacted bundle are not read anymore
+	for _, object := range objects {
+		if err := b.fileManager.DeleteObject(ctx, sealedBundle.Bucket, sealedBundle.Name, object.ObjectName); err != nil {
+			util.Logger.Warnf("delete cached object failed, bundle=%s, object=%s, err=%v", sealedBundle.Bucket+sealedBundle.Name, object.ObjectName, err)
+		}
+	}
+
+	compactedTo := ""
+	if newBundle != nil {
+		compactedTo = newBundle.Name
+	}
+	util.Logger.Infof("bundle compacted, bundle=%s, objects=%d, compactedTo=%s", sealedBundle.Bucket+sealedBundle.Name, len(objects), compactedTo)
+	return nil
+}
+
+// rebundleObjects assembles the objects of the sealed bundle into the file of a new auto generated bundle, stores it
+// and returns the new bundle, the bundle names and offsets of the objects are set to the ones in the new bundle
+func (b *Bundler) rebundleObjects(ctx context.Context, client client.IClient, sealedBundle *database.Bundle, objects []*database.Object) (*database.Bundle, error) {
+	maxNonceBundle, err := b.bundleDao.QueryBundleWithMaxNonce(sealedBundle.Bucket)
+	if err != nil {
+		return nil, fmt.Errorf("query bundle with max nonce failed: %v", err)
+	}
+	nonce := maxNonceBundle.Nonce + 1
+	newBundle := &database.Bundle{
+		Owner:           sealedBundle.Owner,
+		Bucket:          sealedBundle.Bucket,
+		Name:            fmt.Sprintf(service.BundleNameFormat, nonce),
+		BundlerAccount:  sealedBundle.BundlerAccount,
+		Status:          database.BundleStatusFinalized,
+		Files:           int64(len(objects)),
+		MaxFiles:        sealedBundle.MaxFiles,
+		MaxSize:         sealedBundle.MaxSize,
+		MaxFinalizeTime: sealedBundle.MaxFinalizeTime,
+		Nonce:           nonce,
+	}
+
+	bundleWriter, err := newBundleWriter()
+	if err != nil {
+		return nil, fmt.Errorf("new bundle failed: %v", err)
+	}
+	fileManager := b.fileManager.WithGnfdClient(client)
+	offsets := make([]int64, len(objects))
+	for i, object := range objects {
+		offsets[i], err = appendObject(ctx, fileManager, bundleWriter, sealedBundle, object)
+		if err != nil {
+			bundleWriter.Discard()
+			return nil, err
+		}
+	}
+
+	spooled, err := bundleWriter.Finalize()
+	if err != nil {
+		bundleWriter.Discard()
+		return nil, fmt.Errorf("finalize bundle failed, err=%v", err)
+	}
+	defer spooled.Remove()
+
+	if _, _, err = b.fileManager.StoreBundle(ctx, newBundle.Bucket, newBundle.Name, spooled.File); err != nil {
+		return nil, fmt.Errorf("store bundle failed: %v", err)
+	}
+	newBundle.Size = spooled.size
+
+	for i, object := range objects {
+		object.BundleName = newBundle.Name
+		object.OffsetInBundle = offsets[i]
+	}
+	return newBundle, nil
+}
+
+// deleteCompactedBundle deletes the bundle object of a compacted bundle from Greenfield and then the bundle record, it
+// waits until the bundle the objects are moved to is sealed, and returns whether the bundle is deleted
+func (b *Bundler) deleteCompactedBundle(ctx context.Context, client client.IClient, compactedBundle *database.Bundle) (bool, error) {
+	ctx, cancel := b.withShutdownGrace(ctx)
+	defer cancel()
+
+	if compactedBundle.CompactedTo != "" {
+		newBundle, err := b.bundleDao.QueryBundle(compactedBundle.Bucket, compactedBundle.CompactedTo)
+		if err != nil {
+			return false, fmt.Errorf("query bundle failed: %v", err)
+		}
+		if newBundle.Id != 0 && newBundle.Status != database.BundleStatusSealedOnChain {
+			return false, nil
+		}
+	}
+
+	owner, err := sdk.AccAddressFromHexUnsafe(compactedBundle.Owner)
+	if err != nil {
+		return false, fmt.Errorf("invalid owner address, owner=%s, err=%v", compactedBundle.Owner, err)
+	}
+
+	// the bundle object may be deleted already if a previous round failed to delete the record
+	objectDetail, err := headObjectIfExists(ctx, client, compactedBundle.Bucket, compactedBundle.Name)
+	if err != nil {
+		return false, fmt.Errorf("head bundle object failed: %v", err)
+	}
+	if objectDetail != nil {
+		start := time.Now()
+		txHash, err := client.DeleteObject(ctx, compactedBundle.Bucket, compactedBundle.Name, types.DeleteObjectOption{
+			TxOpts: &gnfdsdktypes.TxOption{FeeGranter: owner},
+		})
+		metrics.ObserveGnfdRequest("delete_object", start, err)
+		if err != nil {
+			return false, fmt.Errorf("delete bundle object failed: %v", err)
+		}
+		if _, err = client.WaitForTx(ctx, txHash); err != nil {
+			return false, fmt.Errorf("wait for delete bundle object tx failed: %v", err)
+		}
+	}
+
+	if err := b.fileManager.DeleteBundle(ctx, compactedBundle.Bucket, compactedBundle.Name); err != nil {
+		util.Logger.Warnf("delete stored bundle failed, bundle=%s, err=%v", compactedBundle.Bucket+compactedBundle.Name, err)
+	}
+	if err := b.bundleDao.DeleteBundle(compactedBundle.Bucket, compactedBundle.Name); err != nil {
+		return false, fmt.Errorf("delete bundle failed: %v", err)
+	}
+
+	util.Logger.Infof("compacted bundle deleted, bundle=%s", compactedBundle.Bucket+compactedBundle.Name)
+	return true, nil
+}
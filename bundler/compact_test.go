@@ -0,0 +1,161 @@
+package bundler
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"path/filepath"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	bundleTypes "github.com/bnb-chain/greenfield-bundle-sdk/types"
+	"github.com/bnb-chain/greenfield-go-sdk/client"
+	"github.com/bnb-chain/greenfield-go-sdk/types"
+	storageTypes "github.com/bnb-chain/greenfield/x/storage/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/node-real/greenfield-bundle-service/dao"
+	"github.com/node-real/greenfield-bundle-service/database"
+	"github.com/node-real/greenfield-bundle-service/storage"
+	"github.com/node-real/greenfield-bundle-service/util"
+)
+
+// accountGnfdClient is a Greenfield client of a bundler account which serves the ranges of the objects on Greenfield
+type accountGnfdClient struct {
+	client.IClient
+
+	objects map[string][]byte
+}
+
+func (c *accountGnfdClient) GetObject(ctx context.Context, bucketName, objectName string, opts types.GetObjectOptions) (io.ReadCloser, types.ObjectStat, error) {
+	content, ok := c.objects[bucketName+"/"+objectName]
+	if !ok {
+		return nil, types.ObjectStat{}, fmt.Errorf("object not found, bucket=%s, object=%s", bucketName, objectName)
+	}
+
+	var start, end int64
+	if _, err := fmt.Sscanf(opts.Range, "bytes=%d-%d", &start, &end); err != nil {
+		return nil, types.ObjectStat{}, err
+	}
+	return io.NopCloser(bytes.NewReader(content[start : end+1])), types.ObjectStat{}, nil
+}
+
+func (c *accountGnfdClient) HeadObject(ctx context.Context, bucketName, objectName string) (*types.ObjectDetail, error) {
+	content, ok := c.objects[bucketName+"/"+objectName]
+	if !ok {
+		return nil, fmt.Errorf("No such object")
+	}
+	return &types.ObjectDetail{ObjectInfo: &storageTypes.ObjectInfo{
+		BucketName:   bucketName,
+		ObjectName:   objectName,
+		Id:           sdkmath.NewUint(uint64(len(c.objects))),
+		PayloadSize:  uint64(len(content)),
+		ObjectStatus: storageTypes.OBJECT_STATUS_SEALED,
+	}}, nil
+}
+
+func newTestBundler(t *testing.T) *Bundler {
+	db, err := database.ConnectDBWithConfig(&util.DBConfig{
+		DBDialect: "sqlite3",
+		DBPath:    filepath.Join(t.TempDir(), "bundler.sqlite3"),
+	})
+	require.NoError(t, err)
+
+	objectDao := dao.NewObjectDao(db)
+	bundleDao := dao.NewBundleDao(db)
+	config := &util.ServerConfig{BundleConfig: &util.BundleConfig{LocalStoragePath: t.TempDir()}}
+	return &Bundler{
+		config:         config,
+		objectDao:      objectDao,
+		bundleDao:      bundleDao,
+		unbundleJobDao: dao.NewUnbundleJobDao(db),
+		// the file manager of the bundler has no client of an account, so it can not read from Greenfield
+		fileManager: storage.NewFileManager(config, objectDao, bundleDao, nil),
+	}
+}
+
+// newTestBundleFile returns the content of a bundle of the objects, and the records of the objects in the bundle
+func newTestBundleFile(t *testing.T, bundleName string, contents map[string][]byte) ([]byte, []database.Object) {
+	bundleWriter, err := newBundleWriter()
+	require.NoError(t, err)
+
+	var objects []database.Object
+	for _, name := range []string{"a.txt", "b.txt", "c.txt"} {
+		content, ok := contents[name]
+		if !ok {
+			continue
+		}
+		hash := sha256.Sum256(content)
+		meta, err := bundleWriter.AppendObject(name, bytes.NewReader(content), &bundleTypes.AppendObjectOptions{HashAlgo: bundleTypes.HashAlgo_SHA256, Hash: hash[:]})
+		require.NoError(t, err)
+		objects = append(objects, database.Object{
+			Bucket:         "bucket",
+			BundleName:     bundleName,
+			ObjectName:     name,
+			HashAlgo:       bundleTypes.HashAlgo_SHA256,
+			Hash:           hash[:],
+			Size:           int64(len(content)),
+			OffsetInBundle: int64(meta.Offset),
+		})
+	}
+	spooled, err := bundleWriter.Finalize()
+	require.NoError(t, err)
+	defer spooled.Remove()
+	bundleContent, err := io.ReadAll(spooled)
+	require.NoError(t, err)
+	return bundleContent, objects
+}
+
+// sealTestBundle creates a sealed bundle of the contents on the Greenfield client, whose objects are not cached
+func sealTestBundle(t *testing.T, b *Bundler, gnfdClient *accountGnfdClient, bundleName string, contents map[string][]byte) {
+	bundleContent, objects := newTestBundleFile(t, bundleName, contents)
+	gnfdClient.objects["bucket/"+bundleName] = bundleContent
+
+	_, err := b.bundleDao.InsertObjectsInOneTransaction(database.Bundle{
+		Owner:          "owner",
+		Bucket:         "bucket",
+		Name:           bundleName,
+		BundlerAccount: "bundler",
+		Status:         database.BundleStatusSealedOnChain,
+		Files:          int64(len(objects)),
+		Size:           int64(len(bundleContent)),
+		Nonce:          1,
+	}, objects, nil)
+	require.NoError(t, err)
+}
+
+func TestCompactBundle_ReadsSealedObjectsWithAccountClient(t *testing.T) {
+	b := newTestBundler(t)
+	gnfdClient := &accountGnfdClient{objects: make(map[string][]byte)}
+	ctx := context.Background()
+
+	contents := map[string][]byte{"a.txt": []byte("object a"), "b.txt": []byte("deleted object b"), "c.txt": []byte("object c")}
+	sealTestBundle(t, b, gnfdClient, "bundle-1", contents)
+	_, err := b.objectDao.TombstoneObject("bucket", "bundle-1", "b.txt")
+	require.NoError(t, err)
+	sealedBundle, err := b.bundleDao.QueryBundle("bucket", "bundle-1")
+	require.NoError(t, err)
+
+	require.NoError(t, b.compactBundle(ctx, gnfdClient, sealedBundle))
+
+	compactedBundle, err := b.bundleDao.QueryBundle("bucket", "bundle-1")
+	require.NoError(t, err)
+	assert.Equal(t, database.BundleStatusCompacted, compactedBundle.Status)
+	require.NotEmpty(t, compactedBundle.CompactedTo)
+
+	// the surviving objects are read from the stored file of the new bundle
+	for _, name := range []string{"a.txt", "c.txt"} {
+		object, err := b.objectDao.GetObject("bucket", compactedBundle.CompactedTo, name)
+		require.NoError(t, err)
+		require.NotZero(t, object.Id)
+
+		objectFile, err := b.fileManager.GetObject(ctx, "bucket", compactedBundle.CompactedTo, name, 0, 0)
+		require.NoError(t, err)
+		content, err := io.ReadAll(objectFile)
+		require.NoError(t, err)
+		assert.Equal(t, contents[name], content)
+	}
+}
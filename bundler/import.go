@@ -0,0 +1,149 @@
+package bundler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	bundleTypes "github.com/bnb-chain/greenfield-bundle-sdk/types"
+	"github.com/bnb-chain/greenfield-go-sdk/client"
+	storageTypes "github.com/bnb-chain/greenfield/x/storage/types"
+
+	"github.com/node-real/greenfield-bundle-service/dao"
+	"github.com/node-real/greenfield-bundle-service/database"
+	"github.com/node-real/greenfield-bundle-service/metrics"
+	"github.com/node-real/greenfield-bundle-service/service"
+	"github.com/node-real/greenfield-bundle-service/types"
+	"github.com/node-real/greenfield-bundle-service/util"
+)
+
+// ImportInterval is the interval at which the importing bundles of a bundler account are imported
+const ImportInterval = 10 * time.Second
+
+// errImportRejected wraps the errors of a bundle which cannot be imported, the import is failed instead of retried
+var errImportRejected = errors.New("bundle cannot be imported")
+
+// importBundles imports the objects of the importing bundles of the bundler account
+func (b *Bundler) importBundles(ctx context.Context, client client.IClient, account string) {
+	bundles, err := b.bundleDao.GetImportingBundlesByBundlerAccount(account)
+	if err != nil {
+		util.Logger.Errorf("get importing bundles by bundler account failed, bundler=%s, err=%v", account, err.Error())
+		return
+	}
+
+	for _, bundle := range bundles {
+		if ctx.Err() != nil {
+			return
+		}
+		if !bundle.IsTimeToRetry() {
+			continue
+		}
+
+		err := b.importBundle(ctx, client, bundle)
+		metrics.ObserveBundleOperation(metrics.OperationImport, account, err)
+		if err == nil {
+			util.Logger.Infof("bundle imported, bucket=%s, bundle=%s, files=%d", bundle.Bucket, bundle.Name, bundle.Files)
+			continue
+		}
+
+		util.Logger.Errorf("import bundle failed, bucket=%s, bundle=%s, err=%v", bundle.Bucket, bundle.Name, err.Error())
+		if errors.Is(err, dao.ErrBundleChanged) {
+			continue
+		}
+		if errors.Is(err, errImportRejected) {
+			bundle.Status = database.BundleStatusImportFailed
+		} else {
+			bundle.RetryCounter++
+		}
+		bundle.ErrMessage = err.Error()
+		if _, err := b.bundleDao.UpdateBundle(*bundle); err != nil {
+			util.Logger.Errorf("update bundle error, bundle=%+v, err=%s", bundle, err.Error())
+		}
+	}
+}
+
+// importBundle reads the meta of the bundle sealed on Greenfield, and creates the objects of the bundle with their
+// offsets in the bundle, so they are read from the bundle on Greenfield
+func (b *Bundler) importBundle(ctx context.Context, client client.IClient, bundle *database.Bundle) error {
+	ctx, cancel := b.withShutdownGrace(ctx)
+	defer cancel()
+
+	objectDetail, err := headObjectIfExists(ctx, client, bundle.Bucket, bundle.Name)
+	if err != nil {
+		return fmt.Errorf("head object failed: %v", err)
+	}
+	if objectDetail == nil {
+		return fmt.Errorf("%w: bundle object not found on Greenfield", errImportRejected)
+	}
+	if objectDetail.ObjectInfo.ObjectStatus != storageTypes.OBJECT_STATUS_SEALED {
+		return fmt.Errorf("%w: bundle object is not sealed on Greenfield", errImportRejected)
+	}
+	bundle.ObjectId = objectDetail.ObjectInfo.Id.Uint64()
+	bundle.Size = int64(objectDetail.ObjectInfo.PayloadSize)
+
+	// the meta is read with the client of the bundler account, which signs the Greenfield requests
+	meta, dataSize, err := b.fileManager.WithGnfdClient(client).GetBundleMetaFromGnfd(ctx, bundle.Bucket, bundle.Name, bundle.Size)
+	if err != nil {
+		return fmt.Errorf("get bundle meta failed: %v", err)
+	}
+	objects, err := importedObjects(bundle, meta, dataSize)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errImportRejected, err)
+	}
+
+	// the imported objects are subject to the object name conflict policy like the uploaded ones
+	if b.config.BundleConfig.ObjectNameConflictPolicy == service.ObjectNameConflictReject {
+		objectNames := make([]string, 0, len(objects))
+		for _, object := range objects {
+			objectNames = append(objectNames, object.ObjectName)
+		}
+		conflicts, err := b.objectDao.GetLatestObjects(bundle.Bucket, objectNames)
+		if err != nil {
+			return fmt.Errorf("get latest objects failed: %v", err)
+		}
+		if len(conflicts) > 0 {
+			return fmt.Errorf("%w: object name %s is already used in the bucket", errImportRejected, conflicts[0].ObjectName)
+		}
+	}
+
+	bundle.Files = int64(len(objects))
+	return b.bundleDao.CompleteBundleImport(*bundle, objects)
+}
+
+// importedObjects returns the objects of the bundle described by its meta, the objects should have valid and distinct
+// names and valid tags, and lie within the dataSize bytes of object data of the bundle without overlapping
+func importedObjects(bundle *database.Bundle, meta *bundleTypes.BundleMeta, dataSize int64) ([]database.Object, error) {
+	if merr := types.ValidateBundleObjectsMeta(meta.Meta, dataSize); merr != nil {
+		return nil, fmt.Errorf("invalid bundle meta: %s", merr.Message)
+	}
+
+	objects := make([]database.Object, 0, len(meta.Meta))
+	for _, objectMeta := range meta.Meta {
+		if merr := types.ValidateObjectName(objectMeta.Name); merr != nil {
+			return nil, fmt.Errorf("invalid object name %s: %s", objectMeta.Name, merr.Message)
+		}
+		if merr := types.ValidateTagKeys(objectMeta.Tags); merr != nil {
+			return nil, fmt.Errorf("invalid tags of object %s: %s", objectMeta.Name, merr.Message)
+		}
+
+		tags, err := json.Marshal(objectMeta.Tags)
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, database.Object{
+			Bucket:         bundle.Bucket,
+			BundleName:     bundle.Name,
+			ObjectName:     objectMeta.Name,
+			ContentType:    objectMeta.ContentType,
+			HashAlgo:       objectMeta.HashAlgo,
+			Hash:           objectMeta.Hash,
+			Owner:          bundle.Owner,
+			Tags:           string(tags),
+			OffsetInBundle: int64(objectMeta.Offset),
+			Size:           int64(objectMeta.Size),
+		})
+	}
+	return objects, nil
+}
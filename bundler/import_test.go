@@ -0,0 +1,40 @@
+package bundler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/node-real/greenfield-bundle-service/database"
+)
+
+func TestImportBundle_ReadsMetaWithAccountClient(t *testing.T) {
+	b := newTestBundler(t)
+	gnfdClient := &accountGnfdClient{objects: make(map[string][]byte)}
+
+	contents := map[string][]byte{"a.txt": []byte("object a"), "c.txt": []byte("object c")}
+	bundleContent, expected := newTestBundleFile(t, "imported", contents)
+	gnfdClient.objects["bucket/imported"] = bundleContent
+
+	importingBundle, err := b.bundleDao.CreateImportingBundle(database.Bundle{
+		Owner:          "owner",
+		Bucket:         "bucket",
+		Name:           "imported",
+		BundlerAccount: "bundler",
+	})
+	require.NoError(t, err)
+	require.NoError(t, b.importBundle(context.Background(), gnfdClient, &importingBundle))
+
+	importedBundle, err := b.bundleDao.QueryBundle("bucket", "imported")
+	require.NoError(t, err)
+	assert.Equal(t, int64(len(contents)), importedBundle.Files)
+	for _, object := range expected {
+		imported, err := b.objectDao.GetObject("bucket", "imported", object.ObjectName)
+		require.NoError(t, err)
+		assert.Equal(t, object.OffsetInBundle, imported.OffsetInBundle)
+		assert.Equal(t, object.Size, imported.Size)
+		assert.Equal(t, object.Hash, imported.Hash)
+	}
+}
@@ -0,0 +1,118 @@
+package bundler
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/node-real/greenfield-bundle-service/util"
+)
+
+const (
+	// DefaultLeaseDuration is the default duration of the leases held by a bundler replica
+	DefaultLeaseDuration = 30 * time.Second
+
+	// FinalizerLeaseName is the name of the lease of the finalize loop
+	FinalizerLeaseName = "bundler/finalizer"
+	// SubmitterLeasePrefix is the prefix of the lease names of the submit loops, followed by the bundler account
+	SubmitterLeasePrefix = "bundler/submitter/"
+)
+
+// newLeaseHolder returns the identity of this bundler replica used as the lease holder
+func newLeaseHolder() string {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "unknown"
+	}
+
+	suffix := make([]byte, 4)
+	_, _ = rand.Read(suffix)
+	return fmt.Sprintf("%s-%d-%s", hostname, os.Getpid(), hex.EncodeToString(suffix))
+}
+
+// runWithLease runs the work only while this replica holds the lease, the lease is renewed every third of its
+// duration. The work is canceled once the lease is lost, or once it is not renewed within half of its duration, so
+// it is stopped well before another replica can take over the lease. The work is started again once the lease is
+// acquired again, e.g. after the replica holding it is gone and the lease expires.
+func (b *Bundler) runWithLease(ctx context.Context, name string, work func(ctx context.Context)) {
+	renewTicker := time.NewTicker(b.leaseDuration / 3)
+	defer renewTicker.Stop()
+
+	// the work is stopped once the lease is not renewed within the safety margin
+	safetyMargin := b.leaseDuration / 2
+	safetyTimer := time.NewTimer(safetyMargin)
+	defer safetyTimer.Stop()
+
+	var (
+		cancelWork  context.CancelFunc
+		workDone    chan struct{}
+		lastRenewed time.Time
+	)
+	stopWork := func() {
+		if cancelWork == nil {
+			return
+		}
+		cancelWork()
+		<-workDone
+		cancelWork = nil
+	}
+	defer func() {
+		if cancelWork == nil {
+			return
+		}
+		stopWork()
+		if err := b.leaseDao.ReleaseLease(name, b.leaseHolder); err != nil {
+			util.Logger.Errorf("release lease failed, lease=%s, err=%v", name, err.Error())
+		}
+	}()
+
+	for {
+		// the lease expires at least a lease duration after the renewal is started
+		renewStarted := time.Now()
+		acquired, err := b.leaseDao.AcquireLease(name, b.leaseHolder, b.leaseDuration)
+		switch {
+		case err == nil && acquired:
+			lastRenewed = renewStarted
+			if !safetyTimer.Stop() {
+				select {
+				case <-safetyTimer.C:
+				default:
+				}
+			}
+			safetyTimer.Reset(safetyMargin - time.Since(lastRenewed))
+			if cancelWork == nil {
+				util.Logger.Infof("lease acquired, lease=%s, holder=%s", name, b.leaseHolder)
+
+				workCtx, cancel := context.WithCancel(ctx)
+				cancelWork = cancel
+				workDone = make(chan struct{})
+				go func(done chan struct{}) {
+					defer close(done)
+					work(workCtx)
+				}(workDone)
+			}
+		case err != nil && cancelWork != nil && time.Since(lastRenewed) < safetyMargin:
+			// the lease is still valid, keep working and retry renewing it on the next tick
+			util.Logger.Errorf("renew lease failed, lease=%s, err=%v", name, err.Error())
+		default:
+			if cancelWork != nil {
+				util.Logger.Infof("lease lost, lease=%s, holder=%s", name, b.leaseHolder)
+				stopWork()
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-renewTicker.C:
+		case <-safetyTimer.C:
+			if cancelWork != nil {
+				util.Logger.Errorf("lease not renewed in time, lease=%s, holder=%s", name, b.leaseHolder)
+				stopWork()
+			}
+		}
+	}
+}
@@ -0,0 +1,31 @@
+package bundler
+
+import (
+	"context"
+	"time"
+)
+
+// DefaultShutdownTimeout is the default time the in-flight submissions are allowed to run after a stop is requested
+const DefaultShutdownTimeout = 15 * time.Second
+
+// withShutdownGrace returns a context for an in-flight operation which outlives ctx by at most the shutdown timeout,
+// so an operation started before ctx is done can finish and checkpoint its result instead of being aborted halfway
+func (b *Bundler) withShutdownGrace(ctx context.Context) (context.Context, context.CancelFunc) {
+	opCtx, cancel := context.WithCancel(context.Background())
+	go func() {
+		select {
+		case <-opCtx.Done():
+			return
+		case <-ctx.Done():
+		}
+
+		timer := time.NewTimer(b.shutdownTimeout)
+		defer timer.Stop()
+		select {
+		case <-opCtx.Done():
+		case <-timer.C:
+			cancel()
+		}
+	}()
+	return opCtx, cancel
+}
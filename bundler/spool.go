@@ -0,0 +1,136 @@
+package bundler
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	bundleTypes "github.com/bnb-chain/greenfield-bundle-sdk/types"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/node-real/greenfield-bundle-service/util"
+)
+
+// SpoolFilePrefix is the prefix of the temporary files used to spool stored bundles before submitting
+const SpoolFilePrefix = "gnfd-bundle-spool-"
+
+// spooledBundle is a bundled object kept in a temporary file, it is read twice when submitted to Greenfield,
+// once to compute the checksums and once to upload the content
+type spooledBundle struct {
+	*os.File
+
+	size int64
+}
+
+// Remove closes and removes the temporary file
+func (s *spooledBundle) Remove() {
+	if err := s.File.Close(); err != nil && !errors.Is(err, os.ErrClosed) {
+		util.Logger.Warnf("close spooled bundle failed, file=%s, err=%v", s.File.Name(), err)
+	}
+
+	if err := os.Remove(s.File.Name()); err != nil && !os.IsNotExist(err) {
+		util.Logger.Warnf("remove spooled bundle failed, file=%s, err=%v", s.File.Name(), err)
+	}
+}
+
+// spoolReader copies the reader to a temporary file and returns the file rewound to the start
+func spoolReader(reader io.Reader) (*spooledBundle, error) {
+	spoolFile, err := os.CreateTemp(os.TempDir(), SpoolFilePrefix)
+	if err != nil {
+		return nil, fmt.Errorf("create spool file failed: %v", err)
+	}
+	spooled := &spooledBundle{File: spoolFile}
+
+	spooled.size, err = io.Copy(spoolFile, reader)
+	if err != nil {
+		spooled.Remove()
+		return nil, fmt.Errorf("spool bundle failed: %v", err)
+	}
+
+	if _, err = spoolFile.Seek(0, io.SeekStart); err != nil {
+		spooled.Remove()
+		return nil, err
+	}
+	return spooled, nil
+}
+
+// bundleWriter assembles a bundle in the layout of the bundle sdk in a temporary file owned by the bundler, the
+// objects are appended to the file, followed by the meta of the objects, the meta size and the version when the bundle
+// is finalized
+type bundleWriter struct {
+	spooled *spooledBundle
+	meta    bundleTypes.BundleMeta
+}
+
+// newBundleWriter returns a writer of a new empty bundle
+func newBundleWriter() (*bundleWriter, error) {
+	spoolFile, err := os.CreateTemp(os.TempDir(), SpoolFilePrefix)
+	if err != nil {
+		return nil, fmt.Errorf("create spool file failed: %v", err)
+	}
+	return &bundleWriter{spooled: &spooledBundle{File: spoolFile}}, nil
+}
+
+// AppendObject appends the object read from the reader to the bundle and returns its meta
+func (w *bundleWriter) AppendObject(name string, reader io.Reader, options *bundleTypes.AppendObjectOptions) (*bundleTypes.ObjectMeta, error) {
+	for _, objectMeta := range w.meta.Meta {
+		if objectMeta.Name == name {
+			return nil, fmt.Errorf("duplicated name")
+		}
+	}
+
+	written, err := io.Copy(w.spooled.File, reader)
+	if err != nil {
+		return nil, fmt.Errorf("copy to bundle failed: %v", err)
+	}
+
+	objectMeta := &bundleTypes.ObjectMeta{
+		Name:   name,
+		Offset: uint64(w.spooled.size),
+		Size:   uint64(written),
+	}
+	if options != nil {
+		objectMeta.HashAlgo = options.HashAlgo
+		objectMeta.Hash = options.Hash
+		objectMeta.ContentType = options.ContentType
+		objectMeta.Tags = options.Tags
+	}
+
+	w.spooled.size += written
+	w.meta.Meta = append(w.meta.Meta, objectMeta)
+	return objectMeta, nil
+}
+
+// Finalize writes the meta of the bundle and returns the bundle file rewound to the start, the writer should not be
+// used afterwards
+func (w *bundleWriter) Finalize() (*spooledBundle, error) {
+	if len(w.meta.Meta) == 0 {
+		return nil, fmt.Errorf("empty bundle")
+	}
+
+	metaData, err := proto.Marshal(&w.meta)
+	if err != nil {
+		return nil, fmt.Errorf("bundle meta marshal failed: %v", err)
+	}
+	footer := make([]byte, bundleTypes.MetaSizeLength+bundleTypes.VersionLength)
+	binary.BigEndian.PutUint64(footer[:bundleTypes.MetaSizeLength], uint64(len(metaData)))
+	binary.BigEndian.PutUint64(footer[bundleTypes.MetaSizeLength:], uint64(bundleTypes.BundleVersion_V1))
+
+	written, err := w.spooled.File.Write(append(metaData, footer...))
+	if err != nil {
+		return nil, fmt.Errorf("write bundle meta failed: %v", err)
+	}
+	w.spooled.size += int64(written)
+
+	if _, err := w.spooled.File.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	return w.spooled, nil
+}
+
+// Discard removes the temporary file of a bundle which is not finalized
+func (w *bundleWriter) Discard() {
+	w.spooled.Remove()
+}
@@ -0,0 +1,66 @@
+package bundler
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/bnb-chain/greenfield-bundle-sdk/bundle"
+	bundleTypes "github.com/bnb-chain/greenfield-bundle-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBundleWriter_Discard(t *testing.T) {
+	for _, withObject := range []bool{false, true} {
+		bundleWriter, err := newBundleWriter()
+		require.NoError(t, err)
+		if withObject {
+			_, err = bundleWriter.AppendObject("object", bytes.NewReader([]byte("content")), nil)
+			require.NoError(t, err)
+		}
+
+		fileName := bundleWriter.spooled.Name()
+		_, err = os.Stat(fileName)
+		require.NoError(t, err)
+
+		bundleWriter.Discard()
+		_, err = os.Stat(fileName)
+		require.True(t, os.IsNotExist(err))
+	}
+}
+
+func TestBundleWriter_Finalize(t *testing.T) {
+	bundleWriter, err := newBundleWriter()
+	require.NoError(t, err)
+	_, err = bundleWriter.AppendObject("a.txt", bytes.NewReader([]byte("object a")), &bundleTypes.AppendObjectOptions{ContentType: "text/plain"})
+	require.NoError(t, err)
+	_, err = bundleWriter.AppendObject("a.txt", bytes.NewReader([]byte("duplicated")), nil)
+	require.Error(t, err)
+	meta, err := bundleWriter.AppendObject("b.txt", bytes.NewReader([]byte("object b")), nil)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(8), meta.Offset)
+
+	spooled, err := bundleWriter.Finalize()
+	require.NoError(t, err)
+	defer spooled.Remove()
+
+	// the bundle is read by the bundle sdk
+	sdkBundle, err := bundle.NewBundleFromFile(spooled.Name())
+	require.NoError(t, err)
+	defer sdkBundle.Close()
+	assert.Equal(t, uint64(spooled.size), sdkBundle.GetBundleSize())
+	object, size, err := sdkBundle.GetObject("b.txt")
+	require.NoError(t, err)
+	content, err := io.ReadAll(object)
+	require.NoError(t, err)
+	assert.Equal(t, int64(8), size)
+	assert.Equal(t, []byte("object b"), content)
+	assert.Equal(t, "text/plain", sdkBundle.GetObjectMeta("a.txt").ContentType)
+
+	// the spooled bundle can be removed more than once
+	spooled.Remove()
+	_, err = os.Stat(spooled.Name())
+	require.True(t, os.IsNotExist(err))
+}
@@ -0,0 +1,249 @@
+package bundler
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bnb-chain/greenfield-go-sdk/client"
+	"github.com/bnb-chain/greenfield-go-sdk/types"
+	gnfdsdktypes "github.com/bnb-chain/greenfield/sdk/types"
+	storageTypes "github.com/bnb-chain/greenfield/x/storage/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/node-real/greenfield-bundle-service/database"
+	"github.com/node-real/greenfield-bundle-service/metrics"
+	"github.com/node-real/greenfield-bundle-service/util"
+)
+
+// UnbundleInterval is the interval at which the unbundle jobs of a bundler account are run
+const UnbundleInterval = 10 * time.Second
+
+// unbundleObjects runs the unbundle jobs of the bundler account, the pending jobs create the standalone objects and
+// the jobs created on chain wait for the objects to be sealed
+func (b *Bundler) unbundleObjects(ctx context.Context, client client.IClient, account string) {
+	jobs, err := b.unbundleJobDao.GetActiveUnbundleJobsByBundlerAccount(account)
+	if err != nil {
+		util.Logger.Errorf("get active unbundle jobs by bundler account failed, bundler=%s, err=%v", account, err.Error())
+		return
+	}
+
+	for _, job := range jobs {
+		if ctx.Err() != nil {
+			return
+		}
+		if !job.IsTimeToRetry() {
+			continue
+		}
+
+		switch job.Status {
+		case database.UnbundleJobStatusPending:
+			b.createUnbundledObject(ctx, client, account, job)
+		case database.UnbundleJobStatusCreatedOnChain:
+			b.checkUnbundledObject(ctx, client, job)
+		}
+	}
+}
+
+// createUnbundledObject reads the object out of its sealed bundle on Greenfield and creates it as a standalone object
+// in the bucket
+func (b *Bundler) createUnbundledObject(ctx context.Context, client client.IClient, account string, job *database.UnbundleJob) {
+	ctx, cancel := b.withShutdownGrace(ctx)
+	defer cancel()
+
+	object, err := b.objectDao.GetObjectById(job.ObjectId)
+	if err != nil {
+		b.retryUnbundleJob(job, fmt.Errorf("get object failed: %v", err))
+		return
+	}
+	if object.Id == 0 {
+		b.failUnbundleJob(job, fmt.Errorf("object is deleted"))
+		return
+	}
+
+	// a previous attempt may have created the standalone object before failing
+	objectDetail, err := headObjectIfExists(ctx, client, object.Bucket, object.ObjectName)
+	if err != nil {
+		b.retryUnbundleJob(job, fmt.Errorf("head object failed: %v", err))
+		return
+	}
+	if objectDetail != nil {
+		owned, err := b.isUnbundledObject(account, object, objectDetail)
+		if err != nil {
+			b.retryUnbundleJob(job, err)
+			return
+		}
+		if !owned {
+			b.failUnbundleJob(job, fmt.Errorf("object already exists on Greenfield"))
+			return
+		}
+		if objectDetail.ObjectInfo.ObjectStatus == storageTypes.OBJECT_STATUS_SEALED {
+			job.GnfdObjectId = objectDetail.ObjectInfo.Id.Uint64()
+			b.completeUnbundleJob(job)
+			return
+		}
+	}
+
+	txHash, objectDetail, err := b.submitUnbundledObject(ctx, client, job, object, objectDetail)
+	metrics.ObserveBundleOperation(metrics.OperationUnbundle, account, err)
+	if err != nil {
+		util.Logger.Errorf("submit unbundled object failed, bucket=%s, object=%s, err=%v", object.Bucket, object.ObjectName, err.Error())
+		b.retryUnbundleJob(job, fmt.Errorf("submit object failed: %v", err))
+		return
+	}
+
+	job.Status = database.UnbundleJobStatusCreatedOnChain
+	job.GnfdObjectId = objectDetail.ObjectInfo.Id.Uint64()
+	job.TxHash = txHash
+	job.RetryCounter = 0
+	job.ErrMessage = EmptyErrMessage
+	if err := b.unbundleJobDao.UpdateUnbundleJob(*job); err != nil {
+		util.Logger.Errorf("update unbundle job error, job=%+v, err=%s", job, err.Error())
+	}
+}
+
+// isUnbundledObject returns whether the object on Greenfield is the standalone object created by a previous attempt of
+// the job, the bundles are also created by the bundler account, so an object named like a bundle is not one
+func (b *Bundler) isUnbundledObject(account string, object database.Object, objectDetail *types.ObjectDetail) (bool, error) {
+	if !strings.EqualFold(objectDetail.ObjectInfo.Creator, account) {
+		return false, nil
+	}
+
+	bundle, err := b.bundleDao.QueryBundle(object.Bucket, object.ObjectName)
+	if err != nil {
+		return false, fmt.Errorf("query bundle failed: %v", err)
+	}
+	return bundle.Id == 0, nil
+}
+
+// submitUnbundledObject creates the standalone object on Greenfield if objectDetail is nil and uploads the object
+// spooled from its bundle
+func (b *Bundler) submitUnbundledObject(ctx context.Context, client client.IClient, job *database.UnbundleJob, object database.Object, objectDetail *types.ObjectDetail) (string, *types.ObjectDetail, error) {
+	owner, err := sdk.AccAddressFromHexUnsafe(job.Owner)
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid owner address, owner=%s, err=%v", job.Owner, err)
+	}
+
+	// the bundle is read with the client of the bundler account, which signs the Greenfield requests
+	objectReader, err := b.fileManager.WithGnfdClient(client).GetObjectFromGnfdBundle(ctx, object.Bucket, object.BundleName, object.ObjectName, 0, 0)
+	if err != nil {
+		return "", nil, fmt.Errorf("get object from bundle failed: %v", err)
+	}
+	spooled, err := spoolReader(objectReader)
+	_ = objectReader.Close()
+	if err != nil {
+		return "", nil, err
+	}
+	defer spooled.Remove()
+	if spooled.size != object.Size {
+		return "", nil, fmt.Errorf("object should have %d bytes, got %d", object.Size, spooled.size)
+	}
+
+	var txHash string
+	if objectDetail == nil {
+		start := time.Now()
+		txHash, err = client.CreateObject(ctx, object.Bucket, object.ObjectName, spooled.File, types.CreateObjectOptions{
+			Visibility:  storageTypes.VISIBILITY_TYPE_INHERIT,
+			ContentType: object.ContentType,
+			TxOpts:      &gnfdsdktypes.TxOption{FeeGranter: owner},
+		})
+		metrics.ObserveGnfdRequest("create_object", start, err)
+		if err != nil {
+			return "", nil, fmt.Errorf("create object failed, bucket=%s, object=%s, err=%v", object.Bucket, object.ObjectName, err)
+		}
+
+		start = time.Now()
+		objectDetail, err = client.HeadObject(ctx, object.Bucket, object.ObjectName)
+		metrics.ObserveGnfdRequest("head_object", start, err)
+		if err != nil {
+			return "", nil, fmt.Errorf("head object failed, bucket=%s, object=%s, err=%v", object.Bucket, object.ObjectName, err)
+		}
+	}
+
+	if _, err = spooled.Seek(0, io.SeekStart); err != nil {
+		return "", nil, err
+	}
+	start := time.Now()
+	err = client.PutObject(ctx, object.Bucket, object.ObjectName, spooled.size, spooled.File, types.PutObjectOptions{
+		ContentType: object.ContentType,
+	})
+	metrics.ObserveGnfdRequest("put_object", start, err)
+	return txHash, objectDetail, err
+}
+
+// checkUnbundledObject completes the unbundle job once the standalone object is sealed, the creation is canceled if
+// the object is not sealed in time, and the object is created again
+func (b *Bundler) checkUnbundledObject(ctx context.Context, client client.IClient, job *database.UnbundleJob) {
+	ctx, cancel := b.withShutdownGrace(ctx)
+	defer cancel()
+
+	start := time.Now()
+	objectDetail, err := client.HeadObjectByID(ctx, strconv.FormatUint(job.GnfdObjectId, 10))
+	metrics.ObserveGnfdRequest("head_object_by_id", start, err)
+	if err != nil {
+		util.Logger.Errorf("head unbundled object failed, bucket=%s, object=%s, objectId=%d, err=%v", job.Bucket, job.ObjectName, job.GnfdObjectId, err)
+		return
+	}
+	if objectDetail.ObjectInfo.ObjectStatus == storageTypes.OBJECT_STATUS_SEALED {
+		b.completeUnbundleJob(job)
+		return
+	}
+
+	if time.Since(job.UpdatedAt).Seconds() < MaxSealOnChainTime {
+		return
+	}
+
+	owner, err := sdk.AccAddressFromHexUnsafe(job.Owner)
+	if err != nil {
+		b.failUnbundleJob(job, fmt.Errorf("invalid owner address, owner=%s, err=%v", job.Owner, err))
+		return
+	}
+	start = time.Now()
+	_, err = client.CancelCreateObject(ctx, job.Bucket, objectDetail.ObjectInfo.ObjectName, types.CancelCreateOption{
+		TxOpts: &gnfdsdktypes.TxOption{FeeGranter: owner},
+	})
+	metrics.ObserveGnfdRequest("cancel_create_object", start, err)
+	if err != nil {
+		b.retryUnbundleJob(job, fmt.Errorf("seal timeout, but cancel object failed: %v", err))
+		return
+	}
+
+	job.Status = database.UnbundleJobStatusPending
+	job.GnfdObjectId = 0
+	job.TxHash = ""
+	job.RetryCounter = 0
+	job.ErrMessage = EmptyErrMessage
+	if err := b.unbundleJobDao.UpdateUnbundleJob(*job); err != nil {
+		util.Logger.Errorf("update unbundle job error, job=%+v, err=%s", job, err.Error())
+	}
+}
+
+func (b *Bundler) completeUnbundleJob(job *database.UnbundleJob) {
+	if err := b.unbundleJobDao.CompleteUnbundleJob(*job); err != nil {
+		util.Logger.Errorf("complete unbundle job error, job=%+v, err=%s", job, err.Error())
+		return
+	}
+	util.Logger.Infof("object unbundled, bucket=%s, bundle=%s, object=%s", job.Bucket, job.BundleName, job.ObjectName)
+}
+
+// retryUnbundleJob records the error of the job, which is retried later
+func (b *Bundler) retryUnbundleJob(job *database.UnbundleJob, err error) {
+	job.RetryCounter++
+	job.ErrMessage = err.Error()
+	if err := b.unbundleJobDao.UpdateUnbundleJob(*job); err != nil {
+		util.Logger.Errorf("update unbundle job error, job=%+v, err=%s", job, err.Error())
+	}
+}
+
+// failUnbundleJob stops the job, which is restarted if the object is requested to be unbundled again
+func (b *Bundler) failUnbundleJob(job *database.UnbundleJob, err error) {
+	util.Logger.Errorf("unbundle job failed, bucket=%s, bundle=%s, object=%s, err=%v", job.Bucket, job.BundleName, job.ObjectName, err)
+	job.Status = database.UnbundleJobStatusFailed
+	job.ErrMessage = err.Error()
+	if err := b.unbundleJobDao.UpdateUnbundleJob(*job); err != nil {
+		util.Logger.Errorf("update unbundle job error, job=%+v, err=%s", job, err.Error())
+	}
+}
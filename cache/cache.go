@@ -0,0 +1,131 @@
+package cache
+
+import (
+	"container/list"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/node-real/greenfield-bundle-service/metrics"
+)
+
+// Cache caches at most maxEntries values which expire after the ttl they are set with, the least recently used value
+// is evicted when the cache is full. The errors returned by the loader can be cached as well, which is used to cache
+// the missing values. It is safe for concurrent use.
+type Cache[V any] struct {
+	name       string
+	maxEntries int
+	now        func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	lru     *list.List // the most recently used entry is at the front
+}
+
+type entry[V any] struct {
+	key       string
+	value     V
+	err       error
+	expiresAt time.Time
+}
+
+// New returns a new Cache named name in the metrics, which holds at most maxEntries values
+func New[V any](name string, maxEntries int) *Cache[V] {
+	return &Cache[V]{
+		name:       name,
+		maxEntries: maxEntries,
+		now:        time.Now,
+		entries:    make(map[string]*list.Element),
+		lru:        list.New(),
+	}
+}
+
+// GetOrLoad returns the cached value and error of the key, or calls load and caches its result for the duration
+// returned by ttl, the result is not cached if the duration is not positive
+func (c *Cache[V]) GetOrLoad(key string, load func() (V, error), ttl func(V, error) time.Duration) (V, error) {
+	if cached, ok := c.get(key); ok {
+		metrics.CacheRequestsCounter.WithLabelValues(c.name, metrics.CacheResultHit).Inc()
+		return cached.value, cached.err
+	}
+	metrics.CacheRequestsCounter.WithLabelValues(c.name, metrics.CacheResultMiss).Inc()
+
+	value, err := load()
+	if d := ttl(value, err); d > 0 {
+		c.set(key, value, err, d)
+	}
+	return value, err
+}
+
+// get returns a copy of the entry of the key if it is cached and not expired
+func (c *Cache[V]) get(key string) (entry[V], bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		return entry[V]{}, false
+	}
+	e := elem.Value.(*entry[V])
+	if !c.now().Before(e.expiresAt) {
+		c.remove(elem)
+		return entry[V]{}, false
+	}
+	c.lru.MoveToFront(elem)
+	return *e, true
+}
+
+func (c *Cache[V]) set(key string, value V, err error, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	expiresAt := c.now().Add(ttl)
+	if elem, ok := c.entries[key]; ok {
+		e := elem.Value.(*entry[V])
+		e.value, e.err, e.expiresAt = value, err, expiresAt
+		c.lru.MoveToFront(elem)
+		return
+	}
+
+	c.entries[key] = c.lru.PushFront(&entry[V]{key: key, value: value, err: err, expiresAt: expiresAt})
+	for c.maxEntries > 0 && c.lru.Len() > c.maxEntries {
+		c.remove(c.lru.Back())
+		metrics.CacheEvictionsCounter.WithLabelValues(c.name).Inc()
+	}
+	metrics.CacheEntriesGauge.WithLabelValues(c.name).Set(float64(c.lru.Len()))
+}
+
+// Delete removes the value of the key
+func (c *Cache[V]) Delete(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.entries[key]; ok {
+		c.remove(elem)
+	}
+}
+
+// DeletePrefix removes the values of the keys starting with prefix
+func (c *Cache[V]) DeletePrefix(prefix string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key, elem := range c.entries {
+		if strings.HasPrefix(key, prefix) {
+			c.remove(elem)
+		}
+	}
+}
+
+// Len returns the number of cached values, including the expired ones which are not removed yet
+func (c *Cache[V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.lru.Len()
+}
+
+func (c *Cache[V]) remove(elem *list.Element) {
+	c.lru.Remove(elem)
+	delete(c.entries, elem.Value.(*entry[V]).key)
+	metrics.CacheEntriesGauge.WithLabelValues(c.name).Set(float64(c.lru.Len()))
+}
@@ -0,0 +1,128 @@
+package cache
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	permTypes "github.com/bnb-chain/greenfield/x/permission/types"
+	storageTypes "github.com/bnb-chain/greenfield/x/storage/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/node-real/greenfield-bundle-service/util"
+)
+
+func TestCache_GetOrLoad(t *testing.T) {
+	c := New[int]("test", 2)
+	now := time.Now()
+	c.now = func() time.Time { return now }
+
+	loads := 0
+	load := func(value int, err error) func() (int, error) {
+		return func() (int, error) {
+			loads++
+			return value, err
+		}
+	}
+	ttl := func(_ int, err error) time.Duration {
+		if err != nil {
+			return 0
+		}
+		return time.Minute
+	}
+
+	value, err := c.GetOrLoad("a", load(1, nil), ttl)
+	require.NoError(t, err)
+	assert.Equal(t, 1, value)
+	value, err = c.GetOrLoad("a", load(2, nil), ttl)
+	require.NoError(t, err)
+	assert.Equal(t, 1, value, "the cached value should be returned")
+	assert.Equal(t, 1, loads)
+
+	// the results with a non-positive ttl are not cached
+	_, err = c.GetOrLoad("b", load(0, errors.New("failed")), ttl)
+	assert.Error(t, err)
+	_, err = c.GetOrLoad("b", load(0, errors.New("failed")), ttl)
+	assert.Error(t, err)
+	assert.Equal(t, 3, loads)
+
+	// the expired values are loaded again
+	now = now.Add(time.Minute)
+	value, err = c.GetOrLoad("a", load(2, nil), ttl)
+	require.NoError(t, err)
+	assert.Equal(t, 2, value)
+	assert.Equal(t, 4, loads)
+
+	// the least recently used value is evicted when the cache is full
+	_, _ = c.GetOrLoad("b", load(3, nil), ttl)
+	_, _ = c.GetOrLoad("a", load(0, nil), ttl)
+	_, _ = c.GetOrLoad("c", load(4, nil), ttl)
+	assert.Equal(t, 2, c.Len())
+	value, _ = c.GetOrLoad("a", load(0, nil), ttl)
+	assert.Equal(t, 2, value)
+	value, _ = c.GetOrLoad("b", load(5, nil), ttl)
+	assert.Equal(t, 5, value, "the least recently used value should be evicted")
+
+	c.Delete("b")
+	value, _ = c.GetOrLoad("b", load(6, nil), ttl)
+	assert.Equal(t, 6, value)
+}
+
+func TestGnfdCache(t *testing.T) {
+	c := NewGnfdCache(&util.CacheConfig{TTL: 60, NegativeTTL: 10})
+	now := time.Now()
+	c.buckets.now = func() time.Time { return now }
+	c.permissions.now = func() time.Time { return now }
+
+	queries := 0
+	queryBucket := func(bucket *storageTypes.BucketInfo, err error) func() (*storageTypes.BucketInfo, error) {
+		return func() (*storageTypes.BucketInfo, error) {
+			queries++
+			return bucket, err
+		}
+	}
+
+	// the missing buckets are cached for the negative ttl
+	_, err := c.GetBucket("bucket", queryBucket(nil, errors.New("rpc error: No such bucket")))
+	assert.Error(t, err)
+	_, err = c.GetBucket("bucket", queryBucket(&storageTypes.BucketInfo{Owner: "owner"}, nil))
+	assert.Error(t, err)
+	assert.Equal(t, 1, queries)
+
+	now = now.Add(10 * time.Second)
+	bucket, err := c.GetBucket("bucket", queryBucket(&storageTypes.BucketInfo{Owner: "owner"}, nil))
+	require.NoError(t, err)
+	assert.Equal(t, "owner", bucket.Owner)
+
+	// other errors are not cached
+	_, err = c.GetBucket("other", queryBucket(nil, errors.New("connection refused")))
+	assert.Error(t, err)
+	_, err = c.GetBucket("other", queryBucket(&storageTypes.BucketInfo{Owner: "owner"}, nil))
+	assert.NoError(t, err)
+	assert.Equal(t, 4, queries)
+
+	// the denied permissions are cached for the negative ttl and the granted ones for the ttl
+	granted, err := c.GetBucketPermission("account", "bucket", permTypes.ACTION_CREATE_OBJECT, func() (bool, error) { return false, nil })
+	require.NoError(t, err)
+	assert.False(t, granted)
+	granted, _ = c.GetBucketPermission("account", "bucket", permTypes.ACTION_CREATE_OBJECT, func() (bool, error) { return true, nil })
+	assert.False(t, granted)
+	now = now.Add(10 * time.Second)
+	granted, _ = c.GetBucketPermission("account", "bucket", permTypes.ACTION_CREATE_OBJECT, func() (bool, error) { return true, nil })
+	assert.True(t, granted)
+	_, _ = c.GetBucketPermission("account", "other", permTypes.ACTION_CREATE_OBJECT, func() (bool, error) { return true, nil })
+
+	// the permissions of the actions are cached separately
+	granted, _ = c.GetBucketPermission("account", "bucket", permTypes.ACTION_GET_OBJECT, func() (bool, error) { return false, nil })
+	assert.False(t, granted)
+
+	// the invalidation removes the bucket and the permissions on it only
+	c.InvalidateBucket("bucket")
+	bucket, _ = c.GetBucket("bucket", queryBucket(&storageTypes.BucketInfo{Owner: "new owner"}, nil))
+	assert.Equal(t, "new owner", bucket.Owner)
+	granted, _ = c.GetBucketPermission("account", "bucket", permTypes.ACTION_CREATE_OBJECT, func() (bool, error) { return false, nil })
+	assert.False(t, granted)
+	granted, _ = c.GetBucketPermission("account", "other", permTypes.ACTION_CREATE_OBJECT, func() (bool, error) { return false, nil })
+	assert.True(t, granted)
+}
@@ -0,0 +1,96 @@
+package cache
+
+import (
+	"strings"
+	"time"
+
+	permTypes "github.com/bnb-chain/greenfield/x/permission/types"
+	storageTypes "github.com/bnb-chain/greenfield/x/storage/types"
+
+	"github.com/node-real/greenfield-bundle-service/util"
+)
+
+const (
+	DefaultMaxEntries  = 10000
+	DefaultTTL         = 60 * time.Second
+	DefaultNegativeTTL = 10 * time.Second
+)
+
+// GnfdCache caches the bucket info and the bucket permissions of the bundler accounts queried from Greenfield, which
+// are checked by every mutating request. The missing buckets and the denied permissions are cached for a shorter
+// negative ttl, so that they are picked up soon after they are created or granted.
+type GnfdCache struct {
+	ttl         time.Duration
+	negativeTTL time.Duration
+
+	buckets     *Cache[*storageTypes.BucketInfo]
+	permissions *Cache[bool]
+}
+
+// NewGnfdCache returns a new GnfdCache, the defaults are used for the missing config
+func NewGnfdCache(config *util.CacheConfig) *GnfdCache {
+	maxEntries, ttl, negativeTTL := DefaultMaxEntries, DefaultTTL, DefaultNegativeTTL
+	if config != nil {
+		if config.MaxEntries > 0 {
+			maxEntries = config.MaxEntries
+		}
+		if config.TTL > 0 {
+			ttl = time.Duration(config.TTL) * time.Second
+		}
+		if config.NegativeTTL > 0 {
+			negativeTTL = time.Duration(config.NegativeTTL) * time.Second
+		}
+	}
+
+	return &GnfdCache{
+		ttl:         ttl,
+		negativeTTL: negativeTTL,
+		buckets:     New[*storageTypes.BucketInfo]("bucket", maxEntries),
+		permissions: New[bool]("bucket_permission", maxEntries),
+	}
+}
+
+// IsBucketNotFoundError returns whether the error is returned by Greenfield for a missing bucket
+func IsBucketNotFoundError(err error) bool {
+	return strings.Contains(err.Error(), "No such bucket")
+}
+
+// GetBucket returns the cached info of the bucket, or queries it with query. The missing buckets are cached, other
+// errors are not.
+func (c *GnfdCache) GetBucket(bucket string, query func() (*storageTypes.BucketInfo, error)) (*storageTypes.BucketInfo, error) {
+	return c.buckets.GetOrLoad(bucket, query, func(_ *storageTypes.BucketInfo, err error) time.Duration {
+		if err == nil {
+			return c.ttl
+		}
+		if IsBucketNotFoundError(err) {
+			return c.negativeTTL
+		}
+		return 0
+	})
+}
+
+// GetBucketPermission returns whether the cached permission of the account for the action on the bucket is granted,
+// or queries it with query. Errors are not cached.
+func (c *GnfdCache) GetBucketPermission(account string, bucket string, action permTypes.ActionType, query func() (bool, error)) (bool, error) {
+	return c.permissions.GetOrLoad(permissionKey(bucket, account+"/"+action.String()), query, func(granted bool, err error) time.Duration {
+		if err != nil {
+			return 0
+		}
+		if granted {
+			return c.ttl
+		}
+		return c.negativeTTL
+	})
+}
+
+// InvalidateBucket removes the cached info of the bucket and the cached permissions on it, e.g. after its owner or
+// policies changed
+func (c *GnfdCache) InvalidateBucket(bucket string) {
+	c.buckets.Delete(bucket)
+	c.permissions.DeletePrefix(permissionKey(bucket, ""))
+}
+
+// permissionKey returns the key of the permissions of the account on the bucket, bucket names cannot contain "/"
+func permissionKey(bucket string, account string) string {
+	return bucket + "/" + account
+}
@@ -1,14 +1,18 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
+	"os/signal"
+	"syscall"
 
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
 
 	"github.com/node-real/greenfield-bundle-service/bundler"
 	"github.com/node-real/greenfield-bundle-service/database"
+	"github.com/node-real/greenfield-bundle-service/metrics"
 	"github.com/node-real/greenfield-bundle-service/util"
 )
 
@@ -42,6 +46,7 @@ func main() {
 	config := util.ParseServerConfigFromFile(configFilePath)
 
 	util.InitLogger(config.LogConfig)
+	metrics.StartServer(config.MetricsConfig)
 
 	db, err := database.ConnectDBWithConfig(config.DBConfig)
 	if err != nil {
@@ -54,5 +59,9 @@ func main() {
 		util.Logger.Errorf("new bundler error, err=%s", err.Error())
 		return
 	}
-	bundler.Run()
+
+	// stop the bundler gracefully on SIGINT and SIGTERM
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+	bundler.Run(ctx)
 }
@@ -2,6 +2,7 @@ package dao
 
 import (
 	"errors"
+	"strings"
 	"time"
 
 	"gorm.io/gorm"
@@ -12,6 +13,13 @@ import (
 	"github.com/node-real/greenfield-bundle-service/database"
 )
 
+var (
+	// ErrBundleChanged is returned when a bundle changed since it was read, and the operation should be retried
+	ErrBundleChanged = errors.New("bundle changed")
+	// ErrBundleExists is returned when a bundle is imported with the name of an existing bundle
+	ErrBundleExists = errors.New("bundle already exists")
+)
+
 type BundleDao interface {
 	CreateBundleIfNotBundlingExist(newBundle database.Bundle) (database.Bundle, error)
 	QueryBundleWithMaxNonce(bucket string) (*database.Bundle, error)
@@ -22,7 +30,24 @@ type BundleDao interface {
 	GetBundlingBundles() ([]*database.Bundle, error)
 	GetFinalizedBundlesByBundlerAccount(account string) ([]*database.Bundle, error)
 	GetCreatedOnChainBundlesByBundlerAccount(account string) ([]*database.Bundle, error)
-	InsertObjectsInOneTransaction(bundle database.Bundle, objects []database.Object) (database.Bundle, error)
+	GetCompactableBundlesByBundlerAccount(account string, threshold float64) ([]*database.Bundle, error)
+	GetCompactedBundlesByBundlerAccount(account string) ([]*database.Bundle, error)
+	CompactBundle(bundle database.Bundle, newBundle *database.Bundle, objects []*database.Object) error
+	CreateImportingBundle(bundle database.Bundle) (database.Bundle, error)
+	GetImportingBundlesByBundlerAccount(account string) ([]*database.Bundle, error)
+	CompleteBundleImport(bundle database.Bundle, objects []database.Object) error
+	InsertObjectsInOneTransaction(bundle database.Bundle, objects []database.Object, storeFile func() error) (database.Bundle, error)
+	CountBundlesByStatus() (map[database.BundleStatus]int64, error)
+	ListBundles(bucket string, filter BundleFilter, afterId int64, limit int) ([]*database.Bundle, error)
+}
+
+// BundleFilter filters the bundles listed by ListBundles, the zero value of a field disables its filter
+type BundleFilter struct {
+	Status        *database.BundleStatus
+	Owner         string
+	CreatedAfter  time.Time // inclusive
+	CreatedBefore time.Time // exclusive
+	NamePrefix    string
 }
 
 type dbBundleDao struct {
@@ -52,6 +77,12 @@ func (s *dbBundleDao) DeleteBundle(bucket string, name string) error {
 		// todo(igor): This function can be optimized by a offline job if there is performance issue. we can mark the
 		// bundle as deleted and delete the bundle and related objects in the offline job
 
+		// Delete the tags of the objects before the objects themselves
+		objectIds := tx.Model(&database.Object{}).Select("id").Where("bucket = ? AND bundle_name = ?", bucket, name)
+		if err := tx.Where("object_id IN (?)", objectIds).Delete(&database.ObjectTag{}).Error; err != nil {
+			return err
+		}
+
 		// Delete the Object records that have the specified bucket and bundleName
 		if err := tx.Where("bucket = ? AND bundle_name = ?", bucket, name).Delete(&database.Object{}).Error; err != nil {
 			return err
@@ -142,6 +173,59 @@ func (s *dbBundleDao) GetBundlingBundles() ([]*database.Bundle, error) {
 	return bundles, nil
 }
 
+// CountBundlesByStatus returns the number of bundles of every status
+func (s *dbBundleDao) CountBundlesByStatus() (map[database.BundleStatus]int64, error) {
+	var rows []struct {
+		Status database.BundleStatus
+		Count  int64
+	}
+	err := s.db.Model(&database.Bundle{}).Select("status, count(*) as count").Group("status").Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[database.BundleStatus]int64, len(rows))
+	for _, row := range rows {
+		counts[row.Status] = row.Count
+	}
+	return counts, nil
+}
+
+// ListBundles returns at most limit bundles of the bucket matching the filter with an id greater than afterId,
+// ordered by id, which is the creation order of the bundles
+func (s *dbBundleDao) ListBundles(bucket string, filter BundleFilter, afterId int64, limit int) ([]*database.Bundle, error) {
+	query := s.db.Where("bucket = ? AND id > ?", bucket, afterId)
+	if filter.Status != nil {
+		query = query.Where("status = ?", *filter.Status)
+	}
+	if filter.Owner != "" {
+		query = query.Where("owner = ?", filter.Owner)
+	}
+	if !filter.CreatedAfter.IsZero() {
+		query = query.Where("created_at >= ?", filter.CreatedAfter)
+	}
+	if !filter.CreatedBefore.IsZero() {
+		query = query.Where("created_at < ?", filter.CreatedBefore)
+	}
+	if filter.NamePrefix != "" {
+		query = query.Where("name LIKE ? ESCAPE '!'", escapeLike(filter.NamePrefix)+"%")
+	}
+
+	var bundles []*database.Bundle
+	err := query.Order("id asc").Limit(limit).Find(&bundles).Error
+	if err != nil {
+		util.Logger.Errorf("list bundles error, bucket=%s, err=%s", bucket, err.Error())
+		return nil, err
+	}
+	return bundles, nil
+}
+
+// escapeLike escapes the wildcards of a LIKE pattern with "!", which unlike backslash is not special in the string
+// literals of either MySQL or SQLite
+func escapeLike(s string) string {
+	return strings.NewReplacer("!", "!!", "%", "!%", "_", "!_").Replace(s)
+}
+
 func (s *dbBundleDao) GetFinalizedBundlesByBundlerAccount(account string) ([]*database.Bundle, error) {
 	var bundles []*database.Bundle
 	err := s.db.Where("status = ? AND bundler_account = ?", database.BundleStatusFinalized, account).Find(&bundles).Error
@@ -160,28 +244,188 @@ func (s *dbBundleDao) GetCreatedOnChainBundlesByBundlerAccount(account string) (
 	return bundles, nil
 }
 
-// InsertObjectsInOneTransaction inserts objects in one transaction
-func (s *dbBundleDao) InsertObjectsInOneTransaction(bundle database.Bundle, objects []database.Object) (database.Bundle, error) {
+// GetCompactableBundlesByBundlerAccount returns the sealed bundles whose tombstoned objects take at least the threshold
+// fraction of their size, or which have no surviving objects
+func (s *dbBundleDao) GetCompactableBundlesByBundlerAccount(account string, threshold float64) ([]*database.Bundle, error) {
+	var bundles []*database.Bundle
+	err := s.db.Where("status = ? AND bundler_account = ? AND tombstoned_files > 0", database.BundleStatusSealedOnChain, account).
+		Where("tombstoned_size >= size * ? OR tombstoned_files >= files", threshold).
+		Find(&bundles).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+	return bundles, nil
+}
+
+// GetCompactedBundlesByBundlerAccount returns the compacted bundles whose objects on Greenfield are to be deleted
+func (s *dbBundleDao) GetCompactedBundlesByBundlerAccount(account string) ([]*database.Bundle, error) {
+	var bundles []*database.Bundle
+	err := s.db.Where("status = ? AND bundler_account = ?", database.BundleStatusCompacted, account).Find(&bundles).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+	return bundles, nil
+}
+
+// CompactBundle moves the surviving objects of a sealed bundle to the new bundle, with their offsets in the new bundle,
+// deletes the tombstones and marks the bundle as compacted to the new bundle. newBundle is nil if no object survives.
+//
+// It returns ErrBundleChanged if the bundle is not sealed anymore, or objects have been tombstoned since the
+// surviving objects were read.
+func (s *dbBundleDao) CompactBundle(bundle database.Bundle, newBundle *database.Bundle, objects []*database.Object) error {
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		var sealedBundle database.Bundle
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", bundle.Id).First(&sealedBundle).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrBundleChanged
+		}
+		if err != nil {
+			return err
+		}
+		if sealedBundle.Status != database.BundleStatusSealedOnChain || sealedBundle.TombstonedFiles != bundle.TombstonedFiles {
+			return ErrBundleChanged
+		}
+
+		if newBundle != nil {
+			if err := tx.Create(newBundle).Error; err != nil {
+				return err
+			}
+			sealedBundle.CompactedTo = newBundle.Name
+		}
+
+		for _, object := range objects {
+			if err := tx.Model(&database.Object{}).Where("id = ?", object.Id).Updates(map[string]interface{}{
+				"bundle_name":      object.BundleName,
+				"offset_in_bundle": object.OffsetInBundle,
+			}).Error; err != nil {
+				return err
+			}
+		}
+
+		if err := tx.Where("bucket = ? AND bundle_name = ? AND tombstone = ?", sealedBundle.Bucket, sealedBundle.Name, true).Delete(&database.Object{}).Error; err != nil {
+			return err
+		}
+
+		sealedBundle.Status = database.BundleStatusCompacted
+		sealedBundle.UpdatedAt = time.Now()
+		return tx.Save(&sealedBundle).Error
+	})
+}
+
+// CreateImportingBundle creates the record of a bundle on Greenfield whose objects are to be imported, an import which
+// failed is restarted. It returns ErrBundleExists if there is another bundle with the name.
+func (s *dbBundleDao) CreateImportingBundle(bundle database.Bundle) (database.Bundle, error) {
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		var existing database.Bundle
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("bucket = ? AND name = ?", bundle.Bucket, bundle.Name).First(&existing).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			bundle.Status = database.BundleStatusImporting
+			return tx.Create(&bundle).Error
+		}
+		if err != nil {
+			return err
+		}
+		if existing.Status != database.BundleStatusImportFailed {
+			return ErrBundleExists
+		}
+
+		existing.Status = database.BundleStatusImporting
+		existing.BundlerAccount = bundle.BundlerAccount
+		existing.ObjectId = bundle.ObjectId
+		existing.Size = bundle.Size
+		existing.RetryCounter = 0
+		existing.ErrMessage = ""
+		existing.UpdatedAt = time.Now()
+		bundle = existing
+		return tx.Save(&bundle).Error
+	})
+	if err != nil {
+		return database.Bundle{}, err
+	}
+	return bundle, nil
+}
+
+func (s *dbBundleDao) GetImportingBundlesByBundlerAccount(account string) ([]*database.Bundle, error) {
+	var bundles []*database.Bundle
+	err := s.db.Where("status = ? AND bundler_account = ?", database.BundleStatusImporting, account).Find(&bundles).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+	return bundles, nil
+}
+
+// CompleteBundleImport creates the objects of an importing bundle and marks the bundle as sealed, with the files, size
+// and object id of the bundle set by the caller. It returns ErrBundleChanged if the bundle is not importing anymore.
+func (s *dbBundleDao) CompleteBundleImport(bundle database.Bundle, objects []database.Object) error {
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		var importingBundle database.Bundle
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", bundle.Id).First(&importingBundle).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrBundleChanged
+		}
+		if err != nil {
+			return err
+		}
+		if importingBundle.Status != database.BundleStatusImporting {
+			return ErrBundleChanged
+		}
+
+		if len(objects) > 0 {
+			if err := tx.CreateInBatches(objects, objectBatchSize).Error; err != nil {
+				return err
+			}
+			if err := createObjectTags(tx, objects); err != nil {
+				return err
+			}
+		}
+
+		bundle.Status = database.BundleStatusSealedOnChain
+		bundle.RetryCounter = 0
+		bundle.ErrMessage = ""
+		bundle.UpdatedAt = time.Now()
+		return tx.Save(&bundle).Error
+	})
+}
+
+// InsertObjectsInOneTransaction inserts objects in one transaction. If storeFile is not nil, it stores the bundle file
+// after the records are inserted, while the new bundle row keeps other requests from creating the same bundle, so the
+// file is only stored if the bundle is created.
+func (s *dbBundleDao) InsertObjectsInOneTransaction(bundle database.Bundle, objects []database.Object, storeFile func() error) (database.Bundle, error) {
 	err := s.db.Transaction(func(tx *gorm.DB) error {
 		// Insert the bundle into the database
 		if err := tx.Create(&bundle).Error; err != nil {
 			return err
 		}
 
-		sql := "INSERT INTO objects (bucket, bundle_name, object_name, content_type, hash_algo, hash, owner, size, offset_in_bundle, tags, created_at, updated_at) VALUES "
+		sql := "INSERT INTO objects (bucket, bundle_name, object_name, content_type, hash_algo, hash, owner, uploader, size, offset_in_bundle, tags, created_at, updated_at) VALUES "
 		var values []interface{}
 
 		for _, object := range objects {
 			object.CreatedAt = time.Now()
 			object.UpdatedAt = time.Now()
-			sql += "(?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?),"
-			values = append(values, object.Bucket, object.BundleName, object.ObjectName, object.ContentType, object.HashAlgo, object.Hash, object.Owner, object.Size, object.OffsetInBundle, object.Tags, object.CreatedAt, object.UpdatedAt)
+			sql += "(?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?),"
+			values = append(values, object.Bucket, object.BundleName, object.ObjectName, object.ContentType, object.HashAlgo, object.Hash, object.Owner, object.Uploader, object.Size, object.OffsetInBundle, object.Tags, object.CreatedAt, object.UpdatedAt)
 		}
 
 		// trim the last ","
 		sql = sql[0 : len(sql)-1]
 
-		return tx.Exec(sql, values...).Error
+		if err := tx.Exec(sql, values...).Error; err != nil {
+			return err
+		}
+
+		// the raw insert does not return the ids of the objects, which the tag records refer to
+		var created []database.Object
+		if err := tx.Where("bucket = ? AND bundle_name = ?", bundle.Bucket, bundle.Name).Find(&created).Error; err != nil {
+			return err
+		}
+		if err := createObjectTags(tx, created); err != nil {
+			return err
+		}
+		if storeFile == nil {
+			return nil
+		}
+		return storeFile()
 	})
 
 	if err != nil {
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"gorm.io/gorm"
 
 	"github.com/node-real/greenfield-bundle-service/dao"
@@ -183,3 +184,108 @@ func TestInsertObjectsInOne(t *testing.T) {
 
 	assert.LessOrEqual(t, timeCost, int64(10000), "Inserting 1000 objects should take less than 10000 milliseconds")
 }
+
+func TestListBundles(t *testing.T) {
+	db := newTestDB(t)
+
+	bundleDao := dao.NewBundleDao(db)
+	base := time.Now().Add(-time.Hour).Truncate(time.Second)
+	names := []string{"bundle-0", "bundle-1", "bundle_2", "custom-0", "custom-1"}
+	for i, name := range names {
+		owner := "owner-a"
+		if i%2 == 1 {
+			owner = "owner-b"
+		}
+		require.NoError(t, db.Create(&database.Bundle{
+			Bucket:    "bucket",
+			Name:      name,
+			Owner:     owner,
+			Status:    database.BundleStatus(i % 3),
+			CreatedAt: base.Add(time.Duration(i) * time.Minute),
+		}).Error)
+	}
+	require.NoError(t, db.Create(&database.Bundle{Bucket: "other", Name: "bundle-0"}).Error)
+
+	listNames := func(filter dao.BundleFilter, afterId int64, limit int) []string {
+		bundles, err := bundleDao.ListBundles("bucket", filter, afterId, limit)
+		require.NoError(t, err)
+		var result []string
+		for _, bundle := range bundles {
+			result = append(result, bundle.Name)
+		}
+		return result
+	}
+
+	assert.Equal(t, names, listNames(dao.BundleFilter{}, 0, 10))
+	assert.Equal(t, names[:2], listNames(dao.BundleFilter{}, 0, 2))
+	assert.Equal(t, names[2:4], listNames(dao.BundleFilter{}, 2, 2))
+
+	status := database.BundleStatusFinalized
+	assert.Equal(t, []string{"bundle-1", "custom-1"}, listNames(dao.BundleFilter{Status: &status}, 0, 10))
+	assert.Equal(t, []string{"bundle-1", "custom-0"}, listNames(dao.BundleFilter{Owner: "owner-b"}, 0, 10))
+	assert.Equal(t, names[1:3], listNames(dao.BundleFilter{
+		CreatedAfter:  base.Add(time.Minute),
+		CreatedBefore: base.Add(3 * time.Minute),
+	}, 0, 10))
+
+	// the wildcards of the prefix are matched literally
+	assert.Equal(t, []string{"bundle_2"}, listNames(dao.BundleFilter{NamePrefix: "bundle_"}, 0, 10))
+	assert.Equal(t, []string{"custom-0", "custom-1"}, listNames(dao.BundleFilter{NamePrefix: "custom"}, 0, 10))
+	assert.Empty(t, listNames(dao.BundleFilter{NamePrefix: "%bundle"}, 0, 10))
+}
+
+func TestImportBundle(t *testing.T) {
+	db := newTestDB(t)
+
+	bundleDao := dao.NewBundleDao(db)
+	objectDao := dao.NewObjectDao(db)
+
+	_, err := bundleDao.CreateBundleIfNotBundlingExist(database.Bundle{Bucket: "bucket", Name: "created", Status: database.BundleStatusBundling})
+	require.NoError(t, err)
+	_, err = bundleDao.CreateImportingBundle(database.Bundle{Bucket: "bucket", Name: "created", BundlerAccount: "bundler"})
+	assert.ErrorIs(t, err, dao.ErrBundleExists)
+
+	imported, err := bundleDao.CreateImportingBundle(database.Bundle{Bucket: "bucket", Name: "imported", BundlerAccount: "bundler", ObjectId: 100, Size: 64})
+	require.NoError(t, err)
+	assert.Equal(t, database.BundleStatusImporting, imported.Status)
+	_, err = bundleDao.CreateImportingBundle(database.Bundle{Bucket: "bucket", Name: "imported", BundlerAccount: "bundler"})
+	assert.ErrorIs(t, err, dao.ErrBundleExists)
+
+	// a failed import is restarted
+	imported.Status = database.BundleStatusImportFailed
+	imported.RetryCounter = 2
+	imported.ErrMessage = "invalid bundle version 1"
+	_, err = bundleDao.UpdateBundle(imported)
+	require.NoError(t, err)
+	restarted, err := bundleDao.CreateImportingBundle(database.Bundle{Bucket: "bucket", Name: "imported", BundlerAccount: "bundler", ObjectId: 101, Size: 64})
+	require.NoError(t, err)
+	assert.Equal(t, imported.Id, restarted.Id)
+	assert.Equal(t, database.BundleStatusImporting, restarted.Status)
+	assert.Equal(t, uint64(101), restarted.ObjectId)
+	assert.Zero(t, restarted.RetryCounter)
+	assert.Empty(t, restarted.ErrMessage)
+
+	bundles, err := bundleDao.GetImportingBundlesByBundlerAccount("bundler")
+	require.NoError(t, err)
+	require.Len(t, bundles, 1)
+
+	bundles[0].Files = 2
+	require.NoError(t, bundleDao.CompleteBundleImport(*bundles[0], []database.Object{
+		{Bucket: "bucket", BundleName: "imported", ObjectName: "a.txt", Size: 10, Tags: `{"k":"v"}`},
+		{Bucket: "bucket", BundleName: "imported", ObjectName: "b.txt", OffsetInBundle: 10, Size: 20, Tags: "null"},
+	}))
+
+	sealed, err := bundleDao.QueryBundle("bucket", "imported")
+	require.NoError(t, err)
+	assert.Equal(t, database.BundleStatusSealedOnChain, sealed.Status)
+	assert.Equal(t, int64(2), sealed.Files)
+	object, err := objectDao.GetObject("bucket", "imported", "b.txt")
+	require.NoError(t, err)
+	assert.Equal(t, int64(10), object.OffsetInBundle)
+
+	// the import is completed once
+	bundles, err = bundleDao.GetImportingBundlesByBundlerAccount("bundler")
+	require.NoError(t, err)
+	assert.Empty(t, bundles)
+	assert.ErrorIs(t, bundleDao.CompleteBundleImport(*sealed, nil), dao.ErrBundleChanged)
+}
@@ -0,0 +1,151 @@
+package dao
+
+import (
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/node-real/greenfield-bundle-service/database"
+)
+
+// ErrBundleUploadNotUploading is returned when a chunk is saved to a bundle upload which is completing or completed
+var ErrBundleUploadNotUploading = errors.New("bundle upload is not uploading")
+
+type BundleUploadDao interface {
+	CreateBundleUpload(upload database.BundleUpload) (database.BundleUpload, error)
+	GetBundleUpload(uploadId string) (database.BundleUpload, error)
+	SaveBundleUploadChunk(chunk database.BundleUploadChunk, storeChunk func() error) error
+	GetBundleUploadChunks(uploadId string) ([]*database.BundleUploadChunk, error)
+	UpdateBundleUploadStatus(uploadId string, from database.BundleUploadStatus, to database.BundleUploadStatus) (bool, error)
+	GetBundleUploadsUpdatedBefore(before time.Time, limit int) ([]*database.BundleUpload, error)
+	RequeueBundleUploadsCompletingBefore(before time.Time) (int64, error)
+	DeleteExpiredBundleUpload(uploadId string, status database.BundleUploadStatus, before time.Time) (bool, error)
+}
+
+type dbBundleUploadDao struct {
+	db *gorm.DB
+}
+
+// NewBundleUploadDao returns a new BundleUploadDao
+func NewBundleUploadDao(db *gorm.DB) BundleUploadDao {
+	return &dbBundleUploadDao{
+		db: db,
+	}
+}
+
+func (s *dbBundleUploadDao) CreateBundleUpload(upload database.BundleUpload) (database.BundleUpload, error) {
+	upload.Status = database.BundleUploadStatusUploading
+	if err := s.db.Create(&upload).Error; err != nil {
+		return database.BundleUpload{}, err
+	}
+	return upload, nil
+}
+
+// GetBundleUpload gets a bundle upload, the id of the returned upload is 0 if it does not exist
+func (s *dbBundleUploadDao) GetBundleUpload(uploadId string) (database.BundleUpload, error) {
+	var upload database.BundleUpload
+	err := s.db.Where("upload_id = ?", uploadId).First(&upload).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return upload, err
+	}
+	return upload, nil
+}
+
+// SaveBundleUploadChunk creates or replaces the chunk of an uploading bundle upload, and refreshes the update time of
+// the upload, which keeps the upload from being garbage collected. storeChunk stores the file of the chunk after the
+// upload is checked to be uploading, while the row of the upload is locked, so the upload can not be completed before
+// the chunk is saved.
+func (s *dbBundleUploadDao) SaveBundleUploadChunk(chunk database.BundleUploadChunk, storeChunk func() error) error {
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&database.BundleUpload{}).
+			Where("upload_id = ? AND status = ?", chunk.UploadId, database.BundleUploadStatusUploading).
+			Update("updated_at", time.Now())
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrBundleUploadNotUploading
+		}
+		if err := storeChunk(); err != nil {
+			return err
+		}
+
+		return tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "upload_id"}, {Name: "number"}},
+			DoUpdates: clause.AssignmentColumns([]string{"size", "sha256", "updated_at"}),
+		}).Create(&chunk).Error
+	})
+}
+
+// GetBundleUploadChunks gets the uploaded chunks of a bundle upload ordered by number
+func (s *dbBundleUploadDao) GetBundleUploadChunks(uploadId string) ([]*database.BundleUploadChunk, error) {
+	var chunks []*database.BundleUploadChunk
+	err := s.db.Where("upload_id = ?", uploadId).Order("number asc").Find(&chunks).Error
+	if err != nil {
+		return nil, err
+	}
+	return chunks, nil
+}
+
+// UpdateBundleUploadStatus moves a bundle upload from one status to another, it returns false if the upload is not in
+// the from status, so only one request can move it
+func (s *dbBundleUploadDao) UpdateBundleUploadStatus(uploadId string, from database.BundleUploadStatus, to database.BundleUploadStatus) (bool, error) {
+	result := s.db.Model(&database.BundleUpload{}).
+		Where("upload_id = ? AND status = ?", uploadId, from).
+		Updates(map[string]interface{}{
+			"status":     to,
+			"updated_at": time.Now(),
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// GetBundleUploadsUpdatedBefore gets at most limit bundle uploads which have not been updated since before
+func (s *dbBundleUploadDao) GetBundleUploadsUpdatedBefore(before time.Time, limit int) ([]*database.BundleUpload, error) {
+	var uploads []*database.BundleUpload
+	err := s.db.Where("updated_at < ?", before).Order("updated_at asc").Limit(limit).Find(&uploads).Error
+	if err != nil {
+		return nil, err
+	}
+	return uploads, nil
+}
+
+// RequeueBundleUploadsCompletingBefore moves the bundle uploads which have been completing since before back to
+// uploading, so the completions interrupted e.g. by a restart can be retried, it returns the number of moved uploads
+func (s *dbBundleUploadDao) RequeueBundleUploadsCompletingBefore(before time.Time) (int64, error) {
+	result := s.db.Model(&database.BundleUpload{}).
+		Where("status = ? AND updated_at < ?", database.BundleUploadStatusCompleting, before).
+		Updates(map[string]interface{}{
+			"status":     database.BundleUploadStatusUploading,
+			"updated_at": time.Now(),
+		})
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	return result.RowsAffected, nil
+}
+
+// DeleteExpiredBundleUpload deletes a bundle upload and the records of its chunks if it is still in the status and
+// not updated since before, it returns false if the upload is moved or updated concurrently, e.g. by a chunk upload
+func (s *dbBundleUploadDao) DeleteExpiredBundleUpload(uploadId string, status database.BundleUploadStatus, before time.Time) (bool, error) {
+	deleted := false
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("upload_id = ? AND status = ? AND updated_at < ?", uploadId, status, before).Delete(&database.BundleUpload{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		deleted = true
+		return tx.Where("upload_id = ?", uploadId).Delete(&database.BundleUploadChunk{}).Error
+	})
+	if err != nil {
+		return false, err
+	}
+	return deleted, nil
+}
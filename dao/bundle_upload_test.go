@@ -0,0 +1,115 @@
+package dao_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/node-real/greenfield-bundle-service/dao"
+	"github.com/node-real/greenfield-bundle-service/database"
+)
+
+func TestBundleUpload(t *testing.T) {
+	db := newTestDB(t)
+
+	uploadDao := dao.NewBundleUploadDao(db)
+	upload, err := uploadDao.CreateBundleUpload(database.BundleUpload{
+		UploadId:   "upload-1",
+		Owner:      "owner",
+		Bucket:     "bucket",
+		BundleName: "bundle",
+		Size:       25,
+		ChunkSize:  10,
+		Sha256:     "sha256",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, database.BundleUploadStatusUploading, upload.Status)
+	assert.Equal(t, int64(3), upload.Chunks())
+	assert.Equal(t, int64(10), upload.ChunkLength(1))
+	assert.Equal(t, int64(5), upload.ChunkLength(3))
+	assert.Equal(t, int64(0), upload.ChunkLength(4))
+
+	missing, err := uploadDao.GetBundleUpload("missing")
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), missing.Id)
+
+	stored := 0
+	storeChunk := func() error {
+		stored++
+		return nil
+	}
+
+	// uploading a chunk again replaces it
+	require.NoError(t, uploadDao.SaveBundleUploadChunk(database.BundleUploadChunk{UploadId: "upload-1", Number: 2, Size: 10, Sha256: "b"}, storeChunk))
+	require.NoError(t, uploadDao.SaveBundleUploadChunk(database.BundleUploadChunk{UploadId: "upload-1", Number: 1, Size: 10, Sha256: "a"}, storeChunk))
+	require.NoError(t, uploadDao.SaveBundleUploadChunk(database.BundleUploadChunk{UploadId: "upload-1", Number: 2, Size: 10, Sha256: "c"}, storeChunk))
+	chunks, err := uploadDao.GetBundleUploadChunks("upload-1")
+	require.NoError(t, err)
+	require.Len(t, chunks, 2)
+	assert.Equal(t, int64(1), chunks[0].Number)
+	assert.Equal(t, int64(2), chunks[1].Number)
+	assert.Equal(t, "c", chunks[1].Sha256)
+	assert.Equal(t, 3, stored)
+
+	// the chunk is not saved if its file is not stored
+	err = uploadDao.SaveBundleUploadChunk(database.BundleUploadChunk{UploadId: "upload-1", Number: 3, Size: 5, Sha256: "d"}, func() error {
+		return errors.New("store failed")
+	})
+	assert.Error(t, err)
+	chunks, err = uploadDao.GetBundleUploadChunks("upload-1")
+	require.NoError(t, err)
+	assert.Len(t, chunks, 2)
+
+	// only one request moves the upload to completing, and chunks can not be saved until it moves back
+	moved, err := uploadDao.UpdateBundleUploadStatus("upload-1", database.BundleUploadStatusUploading, database.BundleUploadStatusCompleting)
+	require.NoError(t, err)
+	assert.True(t, moved)
+	moved, err = uploadDao.UpdateBundleUploadStatus("upload-1", database.BundleUploadStatusUploading, database.BundleUploadStatusCompleting)
+	require.NoError(t, err)
+	assert.False(t, moved)
+	err = uploadDao.SaveBundleUploadChunk(database.BundleUploadChunk{UploadId: "upload-1", Number: 3, Size: 5, Sha256: "d"}, storeChunk)
+	assert.ErrorIs(t, err, dao.ErrBundleUploadNotUploading)
+	assert.Equal(t, 3, stored)
+
+	// the upload expires once it is not updated
+	expired, err := uploadDao.GetBundleUploadsUpdatedBefore(time.Now().Add(-time.Hour), 10)
+	require.NoError(t, err)
+	assert.Empty(t, expired)
+	expired, err = uploadDao.GetBundleUploadsUpdatedBefore(time.Now().Add(time.Hour), 10)
+	require.NoError(t, err)
+	require.Len(t, expired, 1)
+	assert.Equal(t, "upload-1", expired[0].UploadId)
+
+	// a stale completion is moved back to uploading
+	requeued, err := uploadDao.RequeueBundleUploadsCompletingBefore(time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), requeued)
+	requeued, err = uploadDao.RequeueBundleUploadsCompletingBefore(time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), requeued)
+	requeued, err = uploadDao.RequeueBundleUploadsCompletingBefore(time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), requeued)
+	require.NoError(t, uploadDao.SaveBundleUploadChunk(database.BundleUploadChunk{UploadId: "upload-1", Number: 3, Size: 5, Sha256: "d"}, storeChunk))
+
+	// an upload is only deleted if it is still in the status and expired
+	deleted, err := uploadDao.DeleteExpiredBundleUpload("upload-1", database.BundleUploadStatusCompleting, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.False(t, deleted)
+	deleted, err = uploadDao.DeleteExpiredBundleUpload("upload-1", database.BundleUploadStatusUploading, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	assert.False(t, deleted)
+	deleted, err = uploadDao.DeleteExpiredBundleUpload("upload-1", database.BundleUploadStatusUploading, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.True(t, deleted)
+
+	missing, err = uploadDao.GetBundleUpload("upload-1")
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), missing.Id)
+	chunks, err = uploadDao.GetBundleUploadChunks("upload-1")
+	require.NoError(t, err)
+	assert.Empty(t, chunks)
+}
@@ -0,0 +1,22 @@
+package dao_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/node-real/greenfield-bundle-service/database"
+	"github.com/node-real/greenfield-bundle-service/util"
+)
+
+// newTestDB returns a migrated sqlite database in the temp dir of the test
+func newTestDB(t *testing.T) *gorm.DB {
+	db, err := database.ConnectDBWithConfig(&util.DBConfig{
+		DBDialect: "sqlite3",
+		DBPath:    filepath.Join(t.TempDir(), "test.sqlite3"),
+	})
+	require.NoError(t, err)
+	return db
+}
@@ -0,0 +1,126 @@
+package dao
+
+import (
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/node-real/greenfield-bundle-service/database"
+	"github.com/node-real/greenfield-bundle-service/util"
+)
+
+type LeaseDao interface {
+	AcquireLease(name string, holder string, duration time.Duration) (bool, error)
+	ReleaseLease(name string, holder string) error
+	GetLease(name string) (database.Lease, error)
+}
+
+type dbLeaseDao struct {
+	db *gorm.DB
+}
+
+// NewLeaseDao returns a new LeaseDao
+func NewLeaseDao(db *gorm.DB) LeaseDao {
+	return &dbLeaseDao{
+		db: db,
+	}
+}
+
+// AcquireLease acquires or renews the lease for the holder, it returns true if the holder owns the lease until
+// now + duration. A lease held by another holder can only be taken over once it is expired. The time is taken from
+// the database, so the replicas agree on the expiry of the leases even if their clocks drift.
+func (s *dbLeaseDao) AcquireLease(name string, holder string, duration time.Duration) (bool, error) {
+	now, err := s.dbNow()
+	if err != nil {
+		util.Logger.Errorf("get database time error, name=%s, err=%s", name, err.Error())
+		return false, err
+	}
+
+	result := s.db.Model(&database.Lease{}).
+		Where("name = ? AND (holder = ? OR expire_at < ?)", name, holder, now).
+		Updates(map[string]interface{}{
+			"holder":     holder,
+			"expire_at":  now.Add(duration),
+			"updated_at": now,
+		})
+	if result.Error != nil {
+		util.Logger.Errorf("update lease error, name=%s, err=%s", name, result.Error.Error())
+		return false, result.Error
+	}
+	if result.RowsAffected > 0 {
+		return true, nil
+	}
+
+	// the lease is held by another holder or does not exist yet
+	lease, err := s.GetLease(name)
+	if err != nil {
+		return false, err
+	}
+	if lease.Id != 0 {
+		return false, nil
+	}
+
+	err = s.db.Create(&database.Lease{
+		Name:     name,
+		Holder:   holder,
+		ExpireAt: now.Add(duration),
+	}).Error
+	if err != nil {
+		// another holder may have created the lease concurrently, which violates the unique index
+		lease, queryErr := s.GetLease(name)
+		if queryErr == nil && lease.Id != 0 {
+			return false, nil
+		}
+		util.Logger.Errorf("create lease error, name=%s, err=%s", name, err.Error())
+		return false, err
+	}
+	return true, nil
+}
+
+// ReleaseLease releases the lease if it is held by the holder, so other holders can take it over immediately
+func (s *dbLeaseDao) ReleaseLease(name string, holder string) error {
+	now, err := s.dbNow()
+	if err != nil {
+		util.Logger.Errorf("get database time error, name=%s, err=%s", name, err.Error())
+		return err
+	}
+
+	err = s.db.Model(&database.Lease{}).
+		Where("name = ? AND holder = ?", name, holder).
+		Updates(map[string]interface{}{
+			"holder":     "",
+			"expire_at":  now,
+			"updated_at": now,
+		}).Error
+	if err != nil {
+		util.Logger.Errorf("release lease error, name=%s, err=%s", name, err.Error())
+		return err
+	}
+	return nil
+}
+
+// GetLease returns the lease with the name
+func (s *dbLeaseDao) GetLease(name string) (database.Lease, error) {
+	var lease database.Lease
+	err := s.db.Where("name = ?", name).Take(&lease).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		util.Logger.Errorf("get lease error, name=%s, err=%s", name, err.Error())
+		return database.Lease{}, err
+	}
+	return lease, nil
+}
+
+// dbNow returns the current time of the database
+func (s *dbLeaseDao) dbNow() (time.Time, error) {
+	query := "SELECT UNIX_TIMESTAMP(NOW(6))"
+	if s.db.Dialector.Name() == "sqlite" {
+		query = "SELECT (julianday('now') - 2440587.5) * 86400.0"
+	}
+
+	var seconds float64
+	if err := s.db.Raw(query).Scan(&seconds).Error; err != nil {
+		return time.Time{}, err
+	}
+	return time.UnixMicro(int64(seconds * 1e6)), nil
+}
@@ -0,0 +1,56 @@
+package dao_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/node-real/greenfield-bundle-service/dao"
+)
+
+func TestLease(t *testing.T) {
+	db := newTestDB(t)
+
+	leaseDao := dao.NewLeaseDao(db)
+	leaseName := "bundler/finalizer"
+
+	// the first holder creates the lease
+	acquired, err := leaseDao.AcquireLease(leaseName, "replica-1", time.Minute)
+	require.NoError(t, err)
+	assert.True(t, acquired)
+
+	// another holder can not take a lease which is not expired
+	acquired, err = leaseDao.AcquireLease(leaseName, "replica-2", time.Minute)
+	require.NoError(t, err)
+	assert.False(t, acquired)
+
+	// the holder renews its lease, and lets it expire
+	acquired, err = leaseDao.AcquireLease(leaseName, "replica-1", -time.Second)
+	require.NoError(t, err)
+	assert.True(t, acquired)
+
+	// the expired lease is taken over
+	acquired, err = leaseDao.AcquireLease(leaseName, "replica-2", time.Minute)
+	require.NoError(t, err)
+	assert.True(t, acquired)
+
+	lease, err := leaseDao.GetLease(leaseName)
+	require.NoError(t, err)
+	assert.Equal(t, "replica-2", lease.Holder)
+	assert.WithinDuration(t, time.Now().Add(time.Minute), lease.ExpireAt, 5*time.Second)
+
+	// releasing a lease held by another holder is a no-op
+	require.NoError(t, leaseDao.ReleaseLease(leaseName, "replica-1"))
+	acquired, err = leaseDao.AcquireLease(leaseName, "replica-1", time.Minute)
+	require.NoError(t, err)
+	assert.False(t, acquired)
+
+	// the released lease is taken over immediately
+	require.NoError(t, leaseDao.ReleaseLease(leaseName, "replica-2"))
+	time.Sleep(10 * time.Millisecond)
+	acquired, err = leaseDao.AcquireLease(leaseName, "replica-1", time.Minute)
+	require.NoError(t, err)
+	assert.True(t, acquired)
+}
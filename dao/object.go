@@ -7,13 +7,48 @@ import (
 	"gorm.io/gorm/clause"
 
 	"github.com/node-real/greenfield-bundle-service/database"
+	"github.com/node-real/greenfield-bundle-service/util"
 )
 
+// objectTagBatchSize is the number of tag records inserted in one statement
+const objectTagBatchSize = 100
+
+// objectBatchSize is the number of objects inserted in one statement
+const objectBatchSize = 100
+
+// ErrBundlingBundleChanged is returned when the bundling bundle of a bucket is not the expected one anymore, or the
+// objects do not fit in it anymore
+var ErrBundlingBundleChanged = errors.New("bundling bundle changed")
+
+// ErrBundleNotSealed is returned when an object is tombstoned in a bundle which is not sealed on Greenfield
+var ErrBundleNotSealed = errors.New("bundle is not sealed")
+
+// ErrBundleNotBundling is returned when an object is deleted from a named bundle which is not bundling
+var ErrBundleNotBundling = errors.New("bundle is not bundling")
+
 type ObjectDao interface {
-	CreateObjectForBundling(object database.Object) (database.Object, error)
+	CreateObjectForBundling(object database.Object, swapFile func() error) (database.Object, error)
+	CreateObjectsForBundling(bundlingBundle database.Bundle, newBundles []database.Bundle, objects []database.Object, swapFiles func() error) error
+	ReplaceObjectForBundling(object database.Object, swapFile func() error) (database.Object, error)
+	DeleteObjectForBundling(bucket string, bundle string, object string) (database.Object, error)
+	TombstoneObject(bucket string, bundle string, object string) (database.Object, error)
 	UpdateObject(object database.Object) (*database.Object, error)
 	GetObject(bucket string, bundle string, object string) (database.Object, error)
+	GetObjectById(id int64) (database.Object, error)
+	GetLatestObject(bucket string, object string) (database.Object, error)
+	GetLatestObjects(bucket string, objects []string) ([]*database.Object, error)
 	GetBundleObjects(bucket string, bundle string) ([]*database.Object, error)
+	ListObjects(bucket string, filter ObjectFilter, afterId int64, limit int) ([]*database.Object, error)
+	BackfillObjectTags(batchSize int) (int, error)
+}
+
+// ObjectFilter filters the objects listed by ListObjects, the zero value of a field disables its filter
+type ObjectFilter struct {
+	BundleName  string
+	NamePrefix  string
+	ContentType string
+	TagKey      string
+	TagValue    *string // only used with TagKey, nil matches any value of the tag
 }
 
 type dbObjectDao struct {
@@ -35,27 +70,73 @@ func (s *dbObjectDao) UpdateObject(object database.Object) (*database.Object, er
 	return &object, nil
 }
 
-// GetObject gets an object
+// GetObject gets an object, the id of the returned object is 0 if it does not exist or is a tombstone
 func (s *dbObjectDao) GetObject(bucket string, bundle string, object string) (database.Object, error) {
 	var obj database.Object
-	err := s.db.Where("bucket = ? AND bundle_name = ? AND object_name = ?", bucket, bundle, object).First(&obj).Error
+	err := s.db.Where("bucket = ? AND bundle_name = ? AND object_name = ? AND tombstone = ?", bucket, bundle, object, false).First(&obj).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return obj, err
+	}
+	return obj, nil
+}
+
+// GetObjectById gets an object by its id, the id of the returned object is 0 if it does not exist or is a tombstone
+func (s *dbObjectDao) GetObjectById(id int64) (database.Object, error) {
+	var obj database.Object
+	err := s.db.Where("id = ? AND tombstone = ?", id, false).First(&obj).Error
 	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
 		return obj, err
 	}
 	return obj, nil
 }
 
+// GetLatestObject gets the latest object with the name across the bundles of the bucket
+func (s *dbObjectDao) GetLatestObject(bucket string, object string) (database.Object, error) {
+	var obj database.Object
+	err := s.db.Where("bucket = ? AND object_name = ? AND tombstone = ?", bucket, object, false).Order("id desc").First(&obj).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return obj, err
+	}
+	return obj, nil
+}
+
+// GetLatestObjects gets the objects with any of the names across the bundles of the bucket, only the latest object
+// of every name is returned
+func (s *dbObjectDao) GetLatestObjects(bucket string, objects []string) ([]*database.Object, error) {
+	if len(objects) == 0 {
+		return nil, nil
+	}
+
+	var objs []*database.Object
+	err := s.db.Where("bucket = ? AND object_name IN ? AND tombstone = ?", bucket, objects, false).Order("id desc").Find(&objs).Error
+	if err != nil {
+		return nil, err
+	}
+
+	latest := make([]*database.Object, 0, len(objs))
+	seen := make(map[string]bool, len(objs))
+	for _, obj := range objs {
+		if !seen[obj.ObjectName] {
+			seen[obj.ObjectName] = true
+			latest = append(latest, obj)
+		}
+	}
+	return latest, nil
+}
+
+// GetBundleObjects gets the objects of a bundle ordered by id, tombstones are skipped
 func (s *dbObjectDao) GetBundleObjects(bucket string, bundle string) ([]*database.Object, error) {
 	var objs []*database.Object
-	err := s.db.Where("bucket = ? AND bundle_name = ?", bucket, bundle).Find(&objs).Error
+	err := s.db.Where("bucket = ? AND bundle_name = ? AND tombstone = ?", bucket, bundle, false).Order("id asc").Find(&objs).Error
 	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
 		return nil, err
 	}
 	return objs, nil
 }
 
-// CreateObjectForBundling creates a new object for bundling
-func (s *dbObjectDao) CreateObjectForBundling(object database.Object) (database.Object, error) {
+// CreateObjectForBundling creates a new object for bundling. The staged object file is swapped in by swapFile under
+// the lock of the bundle after the records are created, so the file is only in place if the object is created.
+func (s *dbObjectDao) CreateObjectForBundling(object database.Object, swapFile func() error) (database.Object, error) {
 	err := s.db.Transaction(func(tx *gorm.DB) error {
 		// find and lock the bundle with the specified bucket name and status BundleStatusBundling
 		var bundle database.Bundle
@@ -77,7 +158,14 @@ func (s *dbObjectDao) CreateObjectForBundling(object database.Object) (database.
 			return err
 		}
 
-		return tx.Create(&object).Error
+		if err := tx.Create(&object).Error; err != nil {
+			return err
+		}
+
+		if err := createObjectTags(tx, []database.Object{object}); err != nil {
+			return err
+		}
+		return swapFile()
 	})
 
 	if err != nil {
@@ -86,3 +174,309 @@ func (s *dbObjectDao) CreateObjectForBundling(object database.Object) (database.
 
 	return object, nil
 }
+
+// ReplaceObjectForBundling replaces the object with the same name in the bundling bundle, or creates it if there is
+// none. The staged object file is swapped in by swapFile under the lock of the bundle after the records are updated,
+// so the bundle can not be finalized while its staged file is replaced, and swapFile is not called if the bundle is
+// not the bundling bundle. The replaced object is deleted and the object is created with a new id, so it is the latest
+// object with the name.
+func (s *dbObjectDao) ReplaceObjectForBundling(object database.Object, swapFile func() error) (database.Object, error) {
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		// find and lock the bundle with the specified bucket name and status BundleStatusBundling
+		var bundle database.Bundle
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("bucket = ? AND status = ?", object.Bucket, database.BundleStatusBundling).First(&bundle).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrBundlingBundleChanged
+		}
+		if err != nil {
+			return err
+		}
+		if bundle.Name != object.BundleName {
+			return ErrBundlingBundleChanged
+		}
+
+		var replaced database.Object
+		err = tx.Where("bucket = ? AND bundle_name = ? AND object_name = ?", object.Bucket, object.BundleName, object.ObjectName).First(&replaced).Error
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+
+		if replaced.Id != 0 {
+			if err := deleteObject(tx, replaced); err != nil {
+				return err
+			}
+			bundle.Size -= replaced.Size
+		} else {
+			bundle.Files++
+		}
+		bundle.Size += object.Size
+
+		if err := tx.Save(&bundle).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Create(&object).Error; err != nil {
+			return err
+		}
+
+		if err := createObjectTags(tx, []database.Object{object}); err != nil {
+			return err
+		}
+
+		return swapFile()
+	})
+
+	if err != nil {
+		return database.Object{}, err
+	}
+
+	return object, nil
+}
+
+// DeleteObjectForBundling deletes the object with the name from the bundling bundle of the bucket and updates the
+// files and size of the bundle, it returns the deleted object, whose id is 0 if the bundling bundle has no such object.
+// If the bundle is named, it returns ErrBundleNotBundling unless the named bundle is the bundling bundle while it is
+// locked, so the object is not deleted from another bundle if the named one is finalized meanwhile.
+func (s *dbObjectDao) DeleteObjectForBundling(bucket string, bundleName string, object string) (database.Object, error) {
+	var deleted database.Object
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		// find and lock the bundle with the specified bucket name and status BundleStatusBundling
+		var bundle database.Bundle
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("bucket = ? AND status = ?", bucket, database.BundleStatusBundling).First(&bundle).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			if bundleName != "" {
+				return ErrBundleNotBundling
+			}
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if bundleName != "" && bundle.Name != bundleName {
+			return ErrBundleNotBundling
+		}
+
+		err = tx.Where("bucket = ? AND bundle_name = ? AND object_name = ?", bucket, bundle.Name, object).First(&deleted).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := deleteObject(tx, deleted); err != nil {
+			return err
+		}
+
+		bundle.Files--
+		bundle.Size -= deleted.Size
+		return tx.Save(&bundle).Error
+	})
+
+	if err != nil {
+		return database.Object{}, err
+	}
+
+	return deleted, nil
+}
+
+// TombstoneObject marks an object of a sealed bundle as deleted, which hides it from the object queries until the
+// bundle is compacted. The tags of the object are dropped and the tombstoned files and size of the bundle are updated.
+// It returns ErrBundleNotSealed if the bundle is not sealed, and an object with id 0 if the bundle has no such object.
+func (s *dbObjectDao) TombstoneObject(bucket string, bundle string, object string) (database.Object, error) {
+	var tombstoned database.Object
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		var sealedBundle database.Bundle
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("bucket = ? AND name = ?", bucket, bundle).First(&sealedBundle).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if sealedBundle.Status != database.BundleStatusSealedOnChain {
+			return ErrBundleNotSealed
+		}
+
+		err = tx.Where("bucket = ? AND bundle_name = ? AND object_name = ? AND tombstone = ?", bucket, bundle, object, false).First(&tombstoned).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := tx.Where("object_id = ?", tombstoned.Id).Delete(&database.ObjectTag{}).Error; err != nil {
+			return err
+		}
+		tombstoned.Tombstone = true
+		tombstoned.Tags = ""
+		if err := tx.Model(&database.Object{}).Where("id = ?", tombstoned.Id).Updates(map[string]interface{}{
+			"tombstone": true,
+			"tags":      "",
+		}).Error; err != nil {
+			return err
+		}
+
+		sealedBundle.TombstonedFiles++
+		sealedBundle.TombstonedSize += tombstoned.Size
+		return tx.Save(&sealedBundle).Error
+	})
+
+	if err != nil {
+		return database.Object{}, err
+	}
+
+	return tombstoned, nil
+}
+
+// CreateObjectsForBundling creates the objects in one transaction. The objects are added to the bundles named in
+// them, which are the bundling bundle of the bucket followed by the new bundles in order. When the objects roll over
+// to a new bundle, the previous bundle is finalized, so only the last bundle stays bundling.
+//
+// The staged object files are swapped in by swapFiles under the lock of the bundling bundle after the records are
+// created, so no file is in place unless the objects are created with it.
+//
+// It returns ErrBundlingBundleChanged if bundlingBundle is not the bundling bundle of the bucket anymore, or other
+// objects have been added to it since it was read and the objects do not fit in its limits anymore.
+func (s *dbObjectDao) CreateObjectsForBundling(bundlingBundle database.Bundle, newBundles []database.Bundle, objects []database.Object, swapFiles func() error) error {
+	files := make(map[string]int64)
+	sizes := make(map[string]int64)
+	for _, object := range objects {
+		files[object.BundleName]++
+		sizes[object.BundleName] += object.Size
+	}
+
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		// find and lock the bundling bundle of the bucket
+		var bundle database.Bundle
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("bucket = ? AND status = ?", bundlingBundle.Bucket, database.BundleStatusBundling).First(&bundle).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrBundlingBundleChanged
+		}
+		if err != nil {
+			return err
+		}
+		if bundle.Id != bundlingBundle.Id {
+			return ErrBundlingBundleChanged
+		}
+
+		unchanged := bundle.Files == bundlingBundle.Files && bundle.Size == bundlingBundle.Size
+		bundle.Files += files[bundle.Name]
+		bundle.Size += sizes[bundle.Name]
+		if !unchanged && (bundle.Files > bundle.MaxFiles || bundle.Size > bundle.MaxSize) {
+			return ErrBundlingBundleChanged
+		}
+		if len(newBundles) > 0 {
+			bundle.Status = database.BundleStatusFinalized
+		}
+		if err := tx.Save(&bundle).Error; err != nil {
+			return err
+		}
+
+		for i, newBundle := range newBundles {
+			newBundle.Files = files[newBundle.Name]
+			newBundle.Size = sizes[newBundle.Name]
+			newBundle.Status = database.BundleStatusFinalized
+			if i == len(newBundles)-1 {
+				newBundle.Status = database.BundleStatusBundling
+			}
+			if err := tx.Create(&newBundle).Error; err != nil {
+				return err
+			}
+		}
+
+		if err := tx.CreateInBatches(objects, objectBatchSize).Error; err != nil {
+			return err
+		}
+		if err := createObjectTags(tx, objects); err != nil {
+			return err
+		}
+
+		return swapFiles()
+	})
+}
+
+// ListObjects returns at most limit objects of the bucket matching the filter with an id greater than afterId,
+// ordered by id
+func (s *dbObjectDao) ListObjects(bucket string, filter ObjectFilter, afterId int64, limit int) ([]*database.Object, error) {
+	query := s.db.Where("bucket = ? AND id > ? AND tombstone = ?", bucket, afterId, false)
+	if filter.BundleName != "" {
+		query = query.Where("bundle_name = ?", filter.BundleName)
+	}
+	if filter.NamePrefix != "" {
+		query = query.Where("object_name LIKE ? ESCAPE '!'", escapeLike(filter.NamePrefix)+"%")
+	}
+	if filter.ContentType != "" {
+		query = query.Where("content_type = ?", filter.ContentType)
+	}
+	if filter.TagKey != "" {
+		tagQuery := s.db.Model(&database.ObjectTag{}).Select("object_id").
+			Where("bucket = ? AND tag_key = ?", bucket, filter.TagKey)
+		if filter.TagValue != nil {
+			tagQuery = tagQuery.Where("tag_value = ?", *filter.TagValue)
+		}
+		query = query.Where("id IN (?)", tagQuery)
+	}
+
+	var objs []*database.Object
+	err := query.Order("id asc").Limit(limit).Find(&objs).Error
+	if err != nil {
+		util.Logger.Errorf("list objects error, bucket=%s, err=%s", bucket, err.Error())
+		return nil, err
+	}
+	return objs, nil
+}
+
+// BackfillObjectTags creates the missing tag records of the objects created before tags were indexed, at most
+// batchSize objects are processed per query. It returns the number of objects whose tags are indexed.
+func (s *dbObjectDao) BackfillObjectTags(batchSize int) (int, error) {
+	var (
+		indexed int
+		afterId int64
+	)
+	for {
+		var objs []database.Object
+		err := s.db.Where("id > ? AND tags NOT IN ('', 'null', '{}')", afterId).
+			Where("NOT EXISTS (SELECT 1 FROM object_tags WHERE object_tags.object_id = objects.id)").
+			Order("id asc").Limit(batchSize).Find(&objs).Error
+		if err != nil {
+			return indexed, err
+		}
+		if len(objs) == 0 {
+			return indexed, nil
+		}
+
+		if err := createObjectTags(s.db, objs); err != nil {
+			return indexed, err
+		}
+		indexed += len(objs)
+		afterId = objs[len(objs)-1].Id
+	}
+}
+
+// deleteObject deletes the object and its indexed tags
+func deleteObject(tx *gorm.DB, object database.Object) error {
+	if err := tx.Where("object_id = ?", object.Id).Delete(&database.ObjectTag{}).Error; err != nil {
+		return err
+	}
+	return tx.Delete(&database.Object{}, object.Id).Error
+}
+
+// createObjectTags creates the tag records of the created objects, objects with invalid tags are not indexed
+func createObjectTags(tx *gorm.DB, objects []database.Object) error {
+	var tags []database.ObjectTag
+	for _, object := range objects {
+		objectTags, err := database.NewObjectTags(object)
+		if err != nil {
+			util.Logger.Warnf("parse object tags failed, bucket=%s, object=%s, err=%s", object.Bucket, object.ObjectName, err.Error())
+			continue
+		}
+		tags = append(tags, objectTags...)
+	}
+	if len(tags) == 0 {
+		return nil
+	}
+
+	return tx.CreateInBatches(tags, objectTagBatchSize).Error
+}
@@ -0,0 +1,448 @@
+package dao_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/node-real/greenfield-bundle-service/dao"
+	"github.com/node-real/greenfield-bundle-service/database"
+)
+
+func TestListObjects(t *testing.T) {
+	db := newTestDB(t)
+
+	bundleDao := dao.NewBundleDao(db)
+	objectDao := dao.NewObjectDao(db)
+
+	// objects uploaded one by one into a bundling bundle
+	_, err := bundleDao.CreateBundleIfNotBundlingExist(database.Bundle{Bucket: "bucket", Name: "bundle-0"})
+	require.NoError(t, err)
+	for _, object := range []database.Object{
+		{ObjectName: "images/a.png", ContentType: "image/png", Tags: `{"kind":"image","team":"a"}`},
+		{ObjectName: "images/b.png", ContentType: "image/png", Tags: `{"kind":"image","team":"b"}`},
+		{ObjectName: "docs/c.txt", ContentType: "text/plain"},
+	} {
+		object.Bucket = "bucket"
+		object.BundleName = "bundle-0"
+		_, err = objectDao.CreateObjectForBundling(object, func() error { return nil })
+		require.NoError(t, err)
+	}
+
+	// objects of an uploaded bundle
+	_, err = bundleDao.InsertObjectsInOneTransaction(database.Bundle{Bucket: "bucket", Name: "bundle-1", Status: database.BundleStatusFinalized}, []database.Object{
+		{Bucket: "bucket", BundleName: "bundle-1", ObjectName: "images/d.jpg", ContentType: "image/jpeg", Tags: `{"kind":"image","team":"a"}`},
+		{Bucket: "bucket", BundleName: "bundle-1", ObjectName: "docs/e.txt", ContentType: "text/plain", Tags: `{"kind":"doc"}`},
+	}, nil)
+	require.NoError(t, err)
+
+	listNames := func(filter dao.ObjectFilter) []string {
+		objects, err := objectDao.ListObjects("bucket", filter, 0, 10)
+		require.NoError(t, err)
+		var result []string
+		for _, object := range objects {
+			result = append(result, object.ObjectName)
+		}
+		return result
+	}
+
+	teamA := "a"
+	assert.Len(t, listNames(dao.ObjectFilter{}), 5)
+	assert.Equal(t, []string{"docs/e.txt"}, listNames(dao.ObjectFilter{BundleName: "bundle-1", NamePrefix: "docs/"}))
+	assert.Equal(t, []string{"images/a.png", "images/b.png"}, listNames(dao.ObjectFilter{ContentType: "image/png"}))
+	assert.Equal(t, []string{"images/a.png", "images/b.png", "images/d.jpg", "docs/e.txt"}, listNames(dao.ObjectFilter{TagKey: "kind"}))
+	assert.Equal(t, []string{"images/a.png", "images/d.jpg"}, listNames(dao.ObjectFilter{TagKey: "team", TagValue: &teamA}))
+	assert.Empty(t, listNames(dao.ObjectFilter{TagKey: "missing"}))
+
+	// the tags of objects created without tag records are backfilled
+	require.NoError(t, db.Where("1 = 1").Delete(&database.ObjectTag{}).Error)
+	assert.Empty(t, listNames(dao.ObjectFilter{TagKey: "kind"}))
+	indexed, err := objectDao.BackfillObjectTags(2)
+	require.NoError(t, err)
+	assert.Equal(t, 4, indexed)
+	assert.Equal(t, []string{"images/a.png", "images/d.jpg"}, listNames(dao.ObjectFilter{TagKey: "team", TagValue: &teamA}))
+	indexed, err = objectDao.BackfillObjectTags(2)
+	require.NoError(t, err)
+	assert.Zero(t, indexed)
+
+	// the tags are deleted with the bundle
+	require.NoError(t, bundleDao.DeleteBundle("bucket", "bundle-1"))
+	assert.Equal(t, []string{"images/a.png"}, listNames(dao.ObjectFilter{TagKey: "team", TagValue: &teamA}))
+	var tagCount int64
+	require.NoError(t, db.Model(&database.ObjectTag{}).Count(&tagCount).Error)
+	assert.Equal(t, int64(4), tagCount)
+}
+
+func TestGetLatestObject(t *testing.T) {
+	db := newTestDB(t)
+
+	bundleDao := dao.NewBundleDao(db)
+	objectDao := dao.NewObjectDao(db)
+
+	// objects with the same name in several bundles of the bucket
+	for _, bundleName := range []string{"bundle-0", "bundle-1"} {
+		_, err := bundleDao.InsertObjectsInOneTransaction(database.Bundle{Bucket: "bucket", Name: bundleName, Status: database.BundleStatusFinalized}, []database.Object{
+			{Bucket: "bucket", BundleName: bundleName, ObjectName: "a.txt"},
+			{Bucket: "bucket", BundleName: bundleName, ObjectName: bundleName + ".txt"},
+		}, nil)
+		require.NoError(t, err)
+	}
+
+	object, err := objectDao.GetLatestObject("bucket", "a.txt")
+	require.NoError(t, err)
+	assert.Equal(t, "bundle-1", object.BundleName)
+
+	object, err = objectDao.GetLatestObject("bucket", "missing.txt")
+	require.NoError(t, err)
+	assert.Zero(t, object.Id)
+
+	objects, err := objectDao.GetLatestObjects("bucket", []string{"a.txt", "bundle-0.txt", "missing.txt"})
+	require.NoError(t, err)
+	bundles := map[string]string{}
+	for _, object := range objects {
+		bundles[object.ObjectName] = object.BundleName
+	}
+	assert.Equal(t, map[string]string{"a.txt": "bundle-1", "bundle-0.txt": "bundle-0"}, bundles)
+}
+
+func TestCreateObjectsForBundling(t *testing.T) {
+	db := newTestDB(t)
+
+	bundleDao := dao.NewBundleDao(db)
+	objectDao := dao.NewObjectDao(db)
+
+	bundlingBundle, err := bundleDao.CreateBundleIfNotBundlingExist(database.Bundle{Bucket: "bucket", Name: "bundle-0", MaxFiles: 2, MaxSize: 100})
+	require.NoError(t, err)
+
+	// the last object rolls over to a new bundle
+	newBundles := []database.Bundle{{Bucket: "bucket", Name: "bundle-1", MaxFiles: 2, MaxSize: 100, Nonce: 1}}
+	objects := []database.Object{
+		{Bucket: "bucket", BundleName: "bundle-0", ObjectName: "a.txt", Size: 10, Tags: `{"kind":"doc"}`},
+		{Bucket: "bucket", BundleName: "bundle-0", ObjectName: "b.txt", Size: 20},
+		{Bucket: "bucket", BundleName: "bundle-1", ObjectName: "c.txt", Size: 30, Tags: `{"kind":"doc"}`},
+	}
+	swapped := false
+	require.NoError(t, objectDao.CreateObjectsForBundling(bundlingBundle, newBundles, objects, func() error {
+		swapped = true
+		return nil
+	}))
+	assert.True(t, swapped)
+
+	finalizedBundle, err := bundleDao.QueryBundle("bucket", "bundle-0")
+	require.NoError(t, err)
+	assert.Equal(t, database.BundleStatusFinalized, finalizedBundle.Status)
+	assert.Equal(t, int64(2), finalizedBundle.Files)
+	assert.Equal(t, int64(30), finalizedBundle.Size)
+
+	bundlingBundle, err = bundleDao.GetBundlingBundle("bucket")
+	require.NoError(t, err)
+	assert.Equal(t, "bundle-1", bundlingBundle.Name)
+	assert.Equal(t, int64(1), bundlingBundle.Files)
+	assert.Equal(t, int64(30), bundlingBundle.Size)
+
+	tagged, err := objectDao.ListObjects("bucket", dao.ObjectFilter{TagKey: "kind"}, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, tagged, 2)
+	assert.Equal(t, "a.txt", tagged[0].ObjectName)
+	assert.Equal(t, "c.txt", tagged[1].ObjectName)
+
+	// the objects are not created and their files are not swapped in if the bundling bundle changed
+	swapped = false
+	err = objectDao.CreateObjectsForBundling(*finalizedBundle, nil, []database.Object{
+		{Bucket: "bucket", BundleName: "bundle-0", ObjectName: "d.txt", Size: 10},
+	}, func() error {
+		swapped = true
+		return nil
+	})
+	assert.ErrorIs(t, err, dao.ErrBundlingBundleChanged)
+	assert.False(t, swapped)
+
+	// objects added since the bundling bundle was read are fine as long as the limits are kept
+	_, err = objectDao.CreateObjectForBundling(database.Object{Bucket: "bucket", BundleName: "bundle-1", ObjectName: "e.txt", Size: 10}, func() error { return nil })
+	require.NoError(t, err)
+	err = objectDao.CreateObjectsForBundling(bundlingBundle, nil, []database.Object{
+		{Bucket: "bucket", BundleName: "bundle-1", ObjectName: "f.txt", Size: 10},
+	}, func() error { return nil })
+	assert.ErrorIs(t, err, dao.ErrBundlingBundleChanged)
+
+	object, err := objectDao.GetLatestObject("bucket", "f.txt")
+	require.NoError(t, err)
+	assert.Zero(t, object.Id)
+
+	// the objects are rolled back if their files fail to be swapped in
+	bundlingBundle, err = bundleDao.GetBundlingBundle("bucket")
+	require.NoError(t, err)
+	err = objectDao.CreateObjectsForBundling(bundlingBundle, nil, []database.Object{
+		{Bucket: "bucket", BundleName: "bundle-1", ObjectName: "g.txt", Size: 10},
+	}, func() error { return errors.New("swap failed") })
+	assert.Error(t, err)
+
+	object, err = objectDao.GetLatestObject("bucket", "g.txt")
+	require.NoError(t, err)
+	assert.Zero(t, object.Id)
+
+	_, err = objectDao.CreateObjectForBundling(database.Object{Bucket: "bucket", BundleName: "bundle-1", ObjectName: "h.txt", Size: 10}, func() error {
+		return errors.New("swap failed")
+	})
+	assert.Error(t, err)
+
+	object, err = objectDao.GetLatestObject("bucket", "h.txt")
+	require.NoError(t, err)
+	assert.Zero(t, object.Id)
+
+	// the uploaded bundle is rolled back if its file fails to be stored
+	_, err = bundleDao.InsertObjectsInOneTransaction(database.Bundle{Bucket: "bucket", Name: "uploaded", Status: database.BundleStatusFinalized}, []database.Object{
+		{Bucket: "bucket", BundleName: "uploaded", ObjectName: "i.txt", Size: 10},
+	}, func() error { return errors.New("store failed") })
+	assert.Error(t, err)
+
+	uploaded, err := bundleDao.QueryBundle("bucket", "uploaded")
+	require.NoError(t, err)
+	assert.Zero(t, uploaded.Id)
+	object, err = objectDao.GetLatestObject("bucket", "i.txt")
+	require.NoError(t, err)
+	assert.Zero(t, object.Id)
+}
+
+func TestReplaceAndDeleteObjectForBundling(t *testing.T) {
+	db := newTestDB(t)
+
+	bundleDao := dao.NewBundleDao(db)
+	objectDao := dao.NewObjectDao(db)
+
+	_, err := bundleDao.CreateBundleIfNotBundlingExist(database.Bundle{Bucket: "bucket", Name: "bundle-0", MaxFiles: 10, MaxSize: 100})
+	require.NoError(t, err)
+	original, err := objectDao.CreateObjectForBundling(database.Object{Bucket: "bucket", BundleName: "bundle-0", ObjectName: "a.txt", Size: 10, Tags: `{"kind":"doc"}`}, func() error { return nil })
+	require.NoError(t, err)
+	_, err = objectDao.CreateObjectForBundling(database.Object{Bucket: "bucket", BundleName: "bundle-0", ObjectName: "b.txt", Size: 20}, func() error { return nil })
+	require.NoError(t, err)
+
+	// the replaced object gets a new id, and the files of the bundle stay the same
+	swapped := false
+	replaced, err := objectDao.ReplaceObjectForBundling(database.Object{Bucket: "bucket", BundleName: "bundle-0", ObjectName: "a.txt", Size: 15, Tags: `{"kind":"image"}`}, func() error {
+		swapped = true
+		return nil
+	})
+	require.NoError(t, err)
+	assert.True(t, swapped)
+	assert.NotEqual(t, original.Id, replaced.Id)
+	assert.Equal(t, int64(15), replaced.Size)
+
+	bundlingBundle, err := bundleDao.GetBundlingBundle("bucket")
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), bundlingBundle.Files)
+	assert.Equal(t, int64(35), bundlingBundle.Size)
+
+	tagged, err := objectDao.ListObjects("bucket", dao.ObjectFilter{TagKey: "kind"}, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, tagged, 1)
+	assert.Equal(t, replaced.Id, tagged[0].Id)
+	assert.Equal(t, `{"kind":"image"}`, tagged[0].Tags)
+
+	// replacing a missing object creates it
+	_, err = objectDao.ReplaceObjectForBundling(database.Object{Bucket: "bucket", BundleName: "bundle-0", ObjectName: "c.txt", Size: 5}, func() error {
+		return nil
+	})
+	require.NoError(t, err)
+
+	// nothing is swapped if the bundle is not the bundling bundle
+	_, err = objectDao.ReplaceObjectForBundling(database.Object{Bucket: "bucket", BundleName: "bundle-1", ObjectName: "a.txt"}, func() error {
+		t.Fatal("the file should not be swapped")
+		return nil
+	})
+	assert.ErrorIs(t, err, dao.ErrBundlingBundleChanged)
+
+	// the records are rolled back if the file fails to be swapped
+	_, err = objectDao.ReplaceObjectForBundling(database.Object{Bucket: "bucket", BundleName: "bundle-0", ObjectName: "b.txt", Size: 50}, func() error {
+		return errors.New("swap failed")
+	})
+	assert.EqualError(t, err, "swap failed")
+	kept, err := objectDao.GetObject("bucket", "bundle-0", "b.txt")
+	require.NoError(t, err)
+	assert.Equal(t, int64(20), kept.Size)
+
+	deleted, err := objectDao.DeleteObjectForBundling("bucket", "", "a.txt")
+	require.NoError(t, err)
+	assert.Equal(t, replaced.Id, deleted.Id)
+	deleted, err = objectDao.DeleteObjectForBundling("bucket", "", "a.txt")
+	require.NoError(t, err)
+	assert.Zero(t, deleted.Id)
+
+	// an object is only deleted from the named bundle while it is the bundling bundle
+	_, err = objectDao.DeleteObjectForBundling("bucket", "bundle-1", "b.txt")
+	assert.ErrorIs(t, err, dao.ErrBundleNotBundling)
+	deleted, err = objectDao.DeleteObjectForBundling("bucket", "bundle-0", "c.txt")
+	require.NoError(t, err)
+	assert.NotZero(t, deleted.Id)
+
+	bundlingBundle, err = bundleDao.GetBundlingBundle("bucket")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), bundlingBundle.Files)
+	assert.Equal(t, int64(20), bundlingBundle.Size)
+
+	tagged, err = objectDao.ListObjects("bucket", dao.ObjectFilter{TagKey: "kind"}, 0, 10)
+	require.NoError(t, err)
+	assert.Empty(t, tagged)
+}
+
+func TestTombstoneAndCompactBundle(t *testing.T) {
+	db := newTestDB(t)
+
+	bundleDao := dao.NewBundleDao(db)
+	objectDao := dao.NewObjectDao(db)
+
+	sealedBundle, err := bundleDao.InsertObjectsInOneTransaction(database.Bundle{Bucket: "bucket", Name: "bundle-0", BundlerAccount: "bundler", Status: database.BundleStatusSealedOnChain, Files: 3, Size: 60}, []database.Object{
+		{Bucket: "bucket", BundleName: "bundle-0", ObjectName: "a.txt", Size: 10, Tags: `{"kind":"doc"}`},
+		{Bucket: "bucket", BundleName: "bundle-0", ObjectName: "b.txt", Size: 20, OffsetInBundle: 10},
+		{Bucket: "bucket", BundleName: "bundle-0", ObjectName: "c.txt", Size: 30, OffsetInBundle: 30},
+	}, nil)
+	require.NoError(t, err)
+	_, err = bundleDao.InsertObjectsInOneTransaction(database.Bundle{Bucket: "bucket", Name: "bundle-1", BundlerAccount: "bundler", Status: database.BundleStatusFinalized}, []database.Object{
+		{Bucket: "bucket", BundleName: "bundle-1", ObjectName: "d.txt", Size: 10},
+	}, nil)
+	require.NoError(t, err)
+
+	// only the objects of sealed bundles can be tombstoned
+	_, err = objectDao.TombstoneObject("bucket", "bundle-1", "d.txt")
+	assert.ErrorIs(t, err, dao.ErrBundleNotSealed)
+
+	tombstoned, err := objectDao.TombstoneObject("bucket", "bundle-0", "a.txt")
+	require.NoError(t, err)
+	assert.True(t, tombstoned.Tombstone)
+	tombstoned, err = objectDao.TombstoneObject("bucket", "bundle-0", "a.txt")
+	require.NoError(t, err)
+	assert.Zero(t, tombstoned.Id)
+
+	// the tombstoned object is hidden right away
+	object, err := objectDao.GetObject("bucket", "bundle-0", "a.txt")
+	require.NoError(t, err)
+	assert.Zero(t, object.Id)
+	tagged, err := objectDao.ListObjects("bucket", dao.ObjectFilter{TagKey: "kind"}, 0, 10)
+	require.NoError(t, err)
+	assert.Empty(t, tagged)
+
+	// the bundle is compactable once its tombstones take the threshold of its size
+	compactable, err := bundleDao.GetCompactableBundlesByBundlerAccount("bundler", 0.5)
+	require.NoError(t, err)
+	assert.Empty(t, compactable)
+	_, err = objectDao.TombstoneObject("bucket", "bundle-0", "b.txt")
+	require.NoError(t, err)
+	compactable, err = bundleDao.GetCompactableBundlesByBundlerAccount("bundler", 0.5)
+	require.NoError(t, err)
+	require.Len(t, compactable, 1)
+	assert.Equal(t, sealedBundle.Id, compactable[0].Id)
+	assert.Equal(t, int64(2), compactable[0].TombstonedFiles)
+	assert.Equal(t, int64(30), compactable[0].TombstonedSize)
+
+	objects, err := objectDao.GetBundleObjects("bucket", "bundle-0")
+	require.NoError(t, err)
+	require.Len(t, objects, 1)
+	objects[0].BundleName = "bundle-2"
+	objects[0].OffsetInBundle = 0
+	newBundle := &database.Bundle{Bucket: "bucket", Name: "bundle-2", BundlerAccount: "bundler", Status: database.BundleStatusFinalized, Files: 1, Size: 30, Nonce: 2}
+
+	// the bundle is not compacted if objects are tombstoned meanwhile
+	stale := *compactable[0]
+	stale.TombstonedFiles = 1
+	assert.ErrorIs(t, bundleDao.CompactBundle(stale, newBundle, objects), dao.ErrBundleChanged)
+
+	require.NoError(t, bundleDao.CompactBundle(*compactable[0], newBundle, objects))
+
+	compactedBundle, err := bundleDao.QueryBundle("bucket", "bundle-0")
+	require.NoError(t, err)
+	assert.Equal(t, database.BundleStatusCompacted, compactedBundle.Status)
+	assert.Equal(t, "bundle-2", compactedBundle.CompactedTo)
+	compacted, err := bundleDao.GetCompactedBundlesByBundlerAccount("bundler")
+	require.NoError(t, err)
+	require.Len(t, compacted, 1)
+	compactable, err = bundleDao.GetCompactableBundlesByBundlerAccount("bundler", 0.5)
+	require.NoError(t, err)
+	assert.Empty(t, compactable)
+
+	moved, err := objectDao.GetObject("bucket", "bundle-2", "c.txt")
+	require.NoError(t, err)
+	assert.Equal(t, objects[0].Id, moved.Id)
+	assert.Equal(t, int64(0), moved.OffsetInBundle)
+	remaining, err := objectDao.GetBundleObjects("bucket", "bundle-0")
+	require.NoError(t, err)
+	assert.Empty(t, remaining)
+}
+
+func TestUnbundleJob(t *testing.T) {
+	db := newTestDB(t)
+
+	bundleDao := dao.NewBundleDao(db)
+	objectDao := dao.NewObjectDao(db)
+	unbundleJobDao := dao.NewUnbundleJobDao(db)
+
+	_, err := bundleDao.InsertObjectsInOneTransaction(database.Bundle{Bucket: "bucket", Name: "bundle-0", BundlerAccount: "bundler", Status: database.BundleStatusSealedOnChain}, []database.Object{
+		{Bucket: "bucket", BundleName: "bundle-0", ObjectName: "a.txt", Size: 10},
+	}, nil)
+	require.NoError(t, err)
+	object, err := objectDao.GetObject("bucket", "bundle-0", "a.txt")
+	require.NoError(t, err)
+
+	job, err := unbundleJobDao.CreateUnbundleJob(database.UnbundleJob{ObjectId: object.Id, Bucket: "bucket", BundleName: "bundle-0", ObjectName: "a.txt", BundlerAccount: "bundler"})
+	require.NoError(t, err)
+	assert.Equal(t, database.UnbundleJobStatusPending, job.Status)
+
+	// an object has one job, which is restarted once it failed
+	job.Status = database.UnbundleJobStatusFailed
+	job.RetryCounter = 3
+	job.ErrMessage = "object already exists on Greenfield"
+	require.NoError(t, unbundleJobDao.UpdateUnbundleJob(job))
+	jobs, err := unbundleJobDao.GetActiveUnbundleJobsByBundlerAccount("bundler")
+	require.NoError(t, err)
+	assert.Empty(t, jobs)
+
+	restarted, err := unbundleJobDao.CreateUnbundleJob(database.UnbundleJob{ObjectId: object.Id, Bucket: "bucket", BundleName: "bundle-0", ObjectName: "a.txt", BundlerAccount: "bundler"})
+	require.NoError(t, err)
+	assert.Equal(t, job.Id, restarted.Id)
+	assert.Equal(t, database.UnbundleJobStatusPending, restarted.Status)
+	assert.Zero(t, restarted.RetryCounter)
+	assert.Empty(t, restarted.ErrMessage)
+
+	jobs, err = unbundleJobDao.GetActiveUnbundleJobsByBundlerAccount("bundler")
+	require.NoError(t, err)
+	require.Len(t, jobs, 1)
+
+	// the object is migrated once the job is completed
+	jobs[0].Status = database.UnbundleJobStatusCreatedOnChain
+	jobs[0].GnfdObjectId = 100
+	require.NoError(t, unbundleJobDao.CompleteUnbundleJob(*jobs[0]))
+
+	completed, err := unbundleJobDao.GetUnbundleJob(object.Id)
+	require.NoError(t, err)
+	assert.Equal(t, database.UnbundleJobStatusCompleted, completed.Status)
+	assert.Equal(t, uint64(100), completed.GnfdObjectId)
+	migrated, err := objectDao.GetObjectById(object.Id)
+	require.NoError(t, err)
+	assert.True(t, migrated.Migrated)
+
+	jobs, err = unbundleJobDao.GetActiveUnbundleJobsByBundlerAccount("bundler")
+	require.NoError(t, err)
+	assert.Empty(t, jobs)
+	missing, err := unbundleJobDao.GetUnbundleJob(object.Id + 1)
+	require.NoError(t, err)
+	assert.Zero(t, missing.Id)
+}
+
+func TestInsertObjectsRecordsUploader(t *testing.T) {
+	db := newTestDB(t)
+
+	bundleDao := dao.NewBundleDao(db)
+	objectDao := dao.NewObjectDao(db)
+
+	// the objects of a bundle uploaded by a delegate are owned by the owner of the bucket
+	_, err := bundleDao.InsertObjectsInOneTransaction(database.Bundle{Bucket: "bucket", Name: "bundle", Owner: "owner", Status: database.BundleStatusFinalized}, []database.Object{
+		{Bucket: "bucket", BundleName: "bundle", ObjectName: "a.txt", Owner: "owner", Uploader: "delegate"},
+	}, nil)
+	require.NoError(t, err)
+
+	object, err := objectDao.GetObject("bucket", "bundle", "a.txt")
+	require.NoError(t, err)
+	assert.Equal(t, "owner", object.Owner)
+	assert.Equal(t, "delegate", object.Uploader)
+}
@@ -0,0 +1,60 @@
+package dao
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/node-real/greenfield-bundle-service/database"
+)
+
+type RequestNonceDao interface {
+	UseNonce(signer string, nonce string, expireAt time.Time) (bool, error)
+	DeleteExpiredNonces(before time.Time) (int64, error)
+}
+
+type dbRequestNonceDao struct {
+	db *gorm.DB
+}
+
+// NewRequestNonceDao returns a new RequestNonceDao
+func NewRequestNonceDao(db *gorm.DB) RequestNonceDao {
+	return &dbRequestNonceDao{
+		db: db,
+	}
+}
+
+// UseNonce records the nonce of the signer until expireAt, it returns false if the nonce is already recorded for a
+// request which has not expired. The expired record of the nonce is replaced, so it does not wait for the garbage
+// collection.
+func (s *dbRequestNonceDao) UseNonce(signer string, nonce string, expireAt time.Time) (bool, error) {
+	used := false
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("signer = ? AND nonce = ? AND expire_at < ?", signer, nonce, time.Now()).Delete(&database.RequestNonce{}).Error; err != nil {
+			return err
+		}
+
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&database.RequestNonce{
+			Signer:   signer,
+			Nonce:    nonce,
+			ExpireAt: expireAt,
+		})
+		if result.Error != nil {
+			return result.Error
+		}
+		used = result.RowsAffected == 1
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return used, nil
+}
+
+// DeleteExpiredNonces deletes the records of the nonces which expired before the time, it returns the number of
+// deleted records
+func (s *dbRequestNonceDao) DeleteExpiredNonces(before time.Time) (int64, error) {
+	result := s.db.Where("expire_at < ?", before).Delete(&database.RequestNonce{})
+	return result.RowsAffected, result.Error
+}
@@ -0,0 +1,42 @@
+package dao_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/node-real/greenfield-bundle-service/dao"
+)
+
+func TestUseNonce(t *testing.T) {
+	db := newTestDB(t)
+	nonceDao := dao.NewRequestNonceDao(db)
+
+	ok, err := nonceDao.UseNonce("signer", "nonce", time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	// the nonce is used until the request expires, by the signer only
+	ok, err = nonceDao.UseNonce("signer", "nonce", time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.False(t, ok)
+	ok, err = nonceDao.UseNonce("another signer", "nonce", time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	// the nonce of an expired request can be used again before it is deleted
+	ok, err = nonceDao.UseNonce("signer", "expired nonce", time.Now().Add(-time.Minute))
+	require.NoError(t, err)
+	assert.True(t, ok)
+	ok, err = nonceDao.UseNonce("signer", "expired nonce", time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	_, err = nonceDao.UseNonce("signer", "another expired nonce", time.Now().Add(-time.Minute))
+	require.NoError(t, err)
+	deleted, err := nonceDao.DeleteExpiredNonces(time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+}
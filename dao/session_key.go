@@ -0,0 +1,92 @@
+package dao
+
+import (
+	"errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/node-real/greenfield-bundle-service/database"
+)
+
+var (
+	// ErrSessionKeyExists is returned when a session key is registered again
+	ErrSessionKeyExists = errors.New("session key already exists")
+	// ErrSessionKeyQuotaExceeded is returned when a session key is used beyond its quotas or after it is revoked
+	ErrSessionKeyQuotaExceeded = errors.New("session key quota exceeded")
+)
+
+type SessionKeyDao interface {
+	CreateSessionKey(sessionKey database.SessionKey) (database.SessionKey, error)
+	GetSessionKey(address string) (database.SessionKey, error)
+	RevokeSessionKey(owner string, address string) (bool, error)
+	UseSessionKey(action database.SessionKeyAction) error
+}
+
+type dbSessionKeyDao struct {
+	db *gorm.DB
+}
+
+// NewSessionKeyDao returns a new SessionKeyDao
+func NewSessionKeyDao(db *gorm.DB) SessionKeyDao {
+	return &dbSessionKeyDao{
+		db: db,
+	}
+}
+
+// CreateSessionKey creates a session key, it returns ErrSessionKeyExists if the address is already registered
+func (s *dbSessionKeyDao) CreateSessionKey(sessionKey database.SessionKey) (database.SessionKey, error) {
+	result := s.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&sessionKey)
+	if result.Error != nil {
+		return database.SessionKey{}, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return database.SessionKey{}, ErrSessionKeyExists
+	}
+	return sessionKey, nil
+}
+
+// GetSessionKey gets a session key by its address, the id of the returned session key is 0 if it does not exist
+func (s *dbSessionKeyDao) GetSessionKey(address string) (database.SessionKey, error) {
+	var sessionKey database.SessionKey
+	err := s.db.Where("address = ?", address).First(&sessionKey).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return sessionKey, err
+	}
+	return sessionKey, nil
+}
+
+// RevokeSessionKey revokes the session key of the owner, it returns false if the owner has no such session key
+func (s *dbSessionKeyDao) RevokeSessionKey(owner string, address string) (bool, error) {
+	result := s.db.Model(&database.SessionKey{}).
+		Where("address = ? AND owner = ?", address, owner).
+		Update("revoked", true)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// UseSessionKey consumes a request and the size of the action from the quotas of the session key, and records the
+// action in the same transaction. It returns ErrSessionKeyQuotaExceeded if the quotas are used up or the session key
+// is revoked.
+func (s *dbSessionKeyDao) UseSessionKey(action database.SessionKeyAction) error {
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&database.SessionKey{}).
+			Where("address = ? AND revoked = ?", action.SessionKey, false).
+			Where("max_requests = 0 OR used_requests < max_requests").
+			Where("max_upload_size = 0 OR used_upload_size + ? <= max_upload_size", action.Size).
+			Updates(map[string]interface{}{
+				"used_requests":    gorm.Expr("used_requests + 1"),
+				"used_upload_size": gorm.Expr("used_upload_size + ?", action.Size),
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrSessionKeyQuotaExceeded
+		}
+
+		return tx.Create(&action).Error
+	})
+}
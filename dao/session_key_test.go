@@ -0,0 +1,77 @@
+package dao_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/node-real/greenfield-bundle-service/dao"
+	"github.com/node-real/greenfield-bundle-service/database"
+)
+
+func TestUseSessionKey(t *testing.T) {
+	db := newTestDB(t)
+	sessionKeyDao := dao.NewSessionKeyDao(db)
+
+	sessionKey := database.SessionKey{
+		Address:       "session-key",
+		Owner:         "owner",
+		Buckets:       "bucket",
+		Scopes:        "upload",
+		ExpireAt:      time.Now().Add(time.Hour),
+		MaxRequests:   3,
+		MaxUploadSize: 100,
+	}
+	_, err := sessionKeyDao.CreateSessionKey(sessionKey)
+	require.NoError(t, err)
+	_, err = sessionKeyDao.CreateSessionKey(sessionKey)
+	assert.ErrorIs(t, err, dao.ErrSessionKeyExists)
+
+	use := func(size int64) error {
+		return sessionKeyDao.UseSessionKey(database.SessionKeyAction{
+			SessionKey: "session-key",
+			Owner:      "owner",
+			Bucket:     "bucket",
+			Operation:  "uploadObject",
+			Size:       size,
+		})
+	}
+
+	// the quotas of requests and upload size are consumed by the actions
+	require.NoError(t, use(60))
+	assert.ErrorIs(t, use(50), dao.ErrSessionKeyQuotaExceeded)
+	require.NoError(t, use(40))
+	require.NoError(t, use(0))
+	assert.ErrorIs(t, use(0), dao.ErrSessionKeyQuotaExceeded)
+
+	key, err := sessionKeyDao.GetSessionKey("session-key")
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), key.UsedRequests)
+	assert.Equal(t, int64(100), key.UsedUploadSize)
+
+	// the actions are recorded for audit
+	var actions int64
+	require.NoError(t, db.Model(&database.SessionKeyAction{}).Where("session_key = ?", "session-key").Count(&actions).Error)
+	assert.Equal(t, int64(3), actions)
+
+	// the session key can only be revoked by its owner
+	revoked, err := sessionKeyDao.RevokeSessionKey("another owner", "session-key")
+	require.NoError(t, err)
+	assert.False(t, revoked)
+	revoked, err = sessionKeyDao.RevokeSessionKey("owner", "session-key")
+	require.NoError(t, err)
+	assert.True(t, revoked)
+	sessionKey.Address, sessionKey.MaxRequests, sessionKey.MaxUploadSize = "revoked-session-key", 0, 0
+	_, err = sessionKeyDao.CreateSessionKey(sessionKey)
+	require.NoError(t, err)
+	_, err = sessionKeyDao.RevokeSessionKey("owner", "revoked-session-key")
+	require.NoError(t, err)
+	err = sessionKeyDao.UseSessionKey(database.SessionKeyAction{SessionKey: "revoked-session-key"})
+	assert.ErrorIs(t, err, dao.ErrSessionKeyQuotaExceeded, "the revoked session key should not be used")
+
+	key, err = sessionKeyDao.GetSessionKey("missing")
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), key.Id)
+}
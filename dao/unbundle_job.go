@@ -0,0 +1,106 @@
+package dao
+
+import (
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/node-real/greenfield-bundle-service/database"
+)
+
+type UnbundleJobDao interface {
+	CreateUnbundleJob(job database.UnbundleJob) (database.UnbundleJob, error)
+	GetUnbundleJob(objectId int64) (database.UnbundleJob, error)
+	GetActiveUnbundleJobsByBundlerAccount(account string) ([]*database.UnbundleJob, error)
+	UpdateUnbundleJob(job database.UnbundleJob) error
+	CompleteUnbundleJob(job database.UnbundleJob) error
+}
+
+type dbUnbundleJobDao struct {
+	db *gorm.DB
+}
+
+// NewUnbundleJobDao returns a new UnbundleJobDao
+func NewUnbundleJobDao(db *gorm.DB) UnbundleJobDao {
+	return &dbUnbundleJobDao{
+		db: db,
+	}
+}
+
+// CreateUnbundleJob creates a pending unbundle job for the object, and returns it. If the object already has a job, the
+// existing job is returned instead, and restarted if it failed.
+func (s *dbUnbundleJobDao) CreateUnbundleJob(job database.UnbundleJob) (database.UnbundleJob, error) {
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		var existing database.UnbundleJob
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("object_id = ?", job.ObjectId).First(&existing).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			job.Status = database.UnbundleJobStatusPending
+			return tx.Create(&job).Error
+		}
+		if err != nil {
+			return err
+		}
+
+		if existing.Status == database.UnbundleJobStatusFailed {
+			existing.Status = database.UnbundleJobStatusPending
+			existing.RetryCounter = 0
+			existing.ErrMessage = ""
+			existing.UpdatedAt = time.Now()
+			if err := tx.Save(&existing).Error; err != nil {
+				return err
+			}
+		}
+		job = existing
+		return nil
+	})
+	if err != nil {
+		return database.UnbundleJob{}, err
+	}
+	return job, nil
+}
+
+// GetUnbundleJob gets the unbundle job of an object, the id of the returned job is 0 if it does not exist
+func (s *dbUnbundleJobDao) GetUnbundleJob(objectId int64) (database.UnbundleJob, error) {
+	var job database.UnbundleJob
+	err := s.db.Where("object_id = ?", objectId).First(&job).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return job, err
+	}
+	return job, nil
+}
+
+// GetActiveUnbundleJobsByBundlerAccount returns the unbundle jobs of the bundler account which are not completed or
+// failed, ordered by id
+func (s *dbUnbundleJobDao) GetActiveUnbundleJobsByBundlerAccount(account string) ([]*database.UnbundleJob, error) {
+	var jobs []*database.UnbundleJob
+	err := s.db.Where("bundler_account = ? AND status IN ?", account, []database.UnbundleJobStatus{
+		database.UnbundleJobStatusPending,
+		database.UnbundleJobStatusCreatedOnChain,
+	}).Order("id asc").Find(&jobs).Error
+	if err != nil {
+		return nil, err
+	}
+	return jobs, nil
+}
+
+func (s *dbUnbundleJobDao) UpdateUnbundleJob(job database.UnbundleJob) error {
+	job.UpdatedAt = time.Now()
+	return s.db.Save(&job).Error
+}
+
+// CompleteUnbundleJob marks the unbundle job as completed and its object as migrated in one transaction
+func (s *dbUnbundleJobDao) CompleteUnbundleJob(job database.UnbundleJob) error {
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&database.Object{}).Where("id = ?", job.ObjectId).Update("migrated", true).Error; err != nil {
+			return err
+		}
+
+		job.Status = database.UnbundleJobStatusCompleted
+		job.RetryCounter = 0
+		job.ErrMessage = ""
+		job.UpdatedAt = time.Now()
+		return tx.Save(&job).Error
+	})
+}
@@ -10,8 +10,34 @@ const (
 	BundleStatusCreatedOnChain BundleStatus = 2
 	BundleStatusSealedOnChain  BundleStatus = 3
 	BundleStatusExpired        BundleStatus = 4
+	BundleStatusCompacted      BundleStatus = 5 // the surviving objects are moved to another bundle, the bundle on Greenfield is to be deleted
+	BundleStatusImporting      BundleStatus = 6 // the objects of a bundle sealed on Greenfield by another tool are to be indexed
+	BundleStatusImportFailed   BundleStatus = 7
 )
 
+var bundleStatusNames = map[BundleStatus]string{
+	BundleStatusBundling:       "bundling",
+	BundleStatusFinalized:      "finalized",
+	BundleStatusCreatedOnChain: "created_on_chain",
+	BundleStatusSealedOnChain:  "sealed_on_chain",
+	BundleStatusExpired:        "expired",
+	BundleStatusCompacted:      "compacted",
+	BundleStatusImporting:      "importing",
+	BundleStatusImportFailed:   "import_failed",
+}
+
+// AllBundleStatuses returns all the bundle statuses in order
+func AllBundleStatuses() []BundleStatus {
+	return []BundleStatus{BundleStatusBundling, BundleStatusFinalized, BundleStatusCreatedOnChain, BundleStatusSealedOnChain, BundleStatusExpired, BundleStatusCompacted, BundleStatusImporting, BundleStatusImportFailed}
+}
+
+func (s BundleStatus) String() string {
+	if name, ok := bundleStatusNames[s]; ok {
+		return name
+	}
+	return "unknown"
+}
+
 var (
 	maxRetryInterval = 2 * time.Hour
 	retryIntervals   = []time.Duration{time.Minute, 10 * time.Minute, 30 * time.Minute, time.Hour, maxRetryInterval}
@@ -35,6 +61,9 @@ type Bundle struct {
 	TxHash          string       `json:"tx_hash"`   // tx_hash is used to record the tx hash on Greenfield
 	RetryCounter    int          `json:"retry_counter"`
 	ErrMessage      string       `json:"err_message"`
+	TombstonedFiles int64        `json:"tombstoned_files"`             // number of the deleted objects of a sealed bundle
+	TombstonedSize  int64        `json:"tombstoned_size"`              // size of the deleted objects of a sealed bundle
+	CompactedTo     string       `json:"compacted_to" gorm:"size:128"` // name of the bundle the surviving objects are compacted to
 	CreatedAt       time.Time    `json:"created_at" gorm:"NOT NULL;type:TIMESTAMP;default:CURRENT_TIMESTAMP;<-:create"`
 	UpdatedAt       time.Time    `json:"updated_at" gorm:"NOT NULL;type:TIMESTAMP;default:CURRENT_TIMESTAMP"`
 }
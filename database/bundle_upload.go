@@ -0,0 +1,70 @@
+package database
+
+import "time"
+
+type BundleUploadStatus uint
+
+const (
+	BundleUploadStatusUploading  BundleUploadStatus = 0
+	BundleUploadStatusCompleting BundleUploadStatus = 1
+	BundleUploadStatusCompleted  BundleUploadStatus = 2
+)
+
+var bundleUploadStatusNames = map[BundleUploadStatus]string{
+	BundleUploadStatusUploading:  "uploading",
+	BundleUploadStatusCompleting: "completing",
+	BundleUploadStatusCompleted:  "completed",
+}
+
+func (s BundleUploadStatus) String() string {
+	if name, ok := bundleUploadStatusNames[s]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// BundleUpload is used to store the state of a resumable bundle upload, the bundle file is uploaded in chunks of
+// ChunkSize bytes, only the last chunk may be smaller
+type BundleUpload struct {
+	Id         int64              `json:"id" gorm:"primaryKey"`
+	UploadId   string             `json:"upload_id" gorm:"size:64;index:idx_bundle_upload_id,unique"`
+	Owner      string             `json:"owner" gorm:"size:64"`
+	Bucket     string             `json:"bucket" gorm:"size:64"`
+	BundleName string             `json:"bundle_name" gorm:"size:128"`
+	Size       int64              `json:"size"`
+	ChunkSize  int64              `json:"chunk_size"`
+	Sha256     string             `json:"sha256" gorm:"size:64"`
+	Status     BundleUploadStatus `json:"status"`
+	CreatedAt  time.Time          `json:"created_at" gorm:"NOT NULL;type:TIMESTAMP;default:CURRENT_TIMESTAMP;<-:create"`
+	UpdatedAt  time.Time          `json:"updated_at" gorm:"NOT NULL;type:TIMESTAMP;default:CURRENT_TIMESTAMP;index:idx_bundle_upload_updated_at"`
+}
+
+// Chunks returns the number of chunks of the upload
+func (u *BundleUpload) Chunks() int64 {
+	if u.ChunkSize <= 0 {
+		return 0
+	}
+	return (u.Size + u.ChunkSize - 1) / u.ChunkSize
+}
+
+// ChunkLength returns the expected size of the chunk with the number, chunks are numbered from 1
+func (u *BundleUpload) ChunkLength(number int64) int64 {
+	if number < 1 || number > u.Chunks() {
+		return 0
+	}
+	if number < u.Chunks() {
+		return u.ChunkSize
+	}
+	return u.Size - (number-1)*u.ChunkSize
+}
+
+// BundleUploadChunk is used to store a chunk uploaded to a resumable bundle upload, uploading a chunk again replaces it
+type BundleUploadChunk struct {
+	Id        int64     `json:"id" gorm:"primaryKey"`
+	UploadId  string    `json:"upload_id" gorm:"size:64;index:idx_bundle_upload_chunk,priority:1,unique"`
+	Number    int64     `json:"number" gorm:"index:idx_bundle_upload_chunk,priority:2,unique"`
+	Size      int64     `json:"size"`
+	Sha256    string    `json:"sha256" gorm:"size:64"`
+	CreatedAt time.Time `json:"created_at" gorm:"NOT NULL;type:TIMESTAMP;default:CURRENT_TIMESTAMP;<-:create"`
+	UpdatedAt time.Time `json:"updated_at" gorm:"NOT NULL;type:TIMESTAMP;default:CURRENT_TIMESTAMP"`
+}
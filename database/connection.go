@@ -31,6 +31,30 @@ func ConnectDBWithConfig(config *util.DBConfig) (*gorm.DB, error) {
 		if err = db.AutoMigrate(&UserBundlerAccount{}); err != nil {
 			panic(err)
 		}
+		if err = db.AutoMigrate(&Lease{}); err != nil {
+			panic(err)
+		}
+		if err = db.AutoMigrate(&ObjectTag{}); err != nil {
+			panic(err)
+		}
+		if err = db.AutoMigrate(&BundleUpload{}); err != nil {
+			panic(err)
+		}
+		if err = db.AutoMigrate(&BundleUploadChunk{}); err != nil {
+			panic(err)
+		}
+		if err = db.AutoMigrate(&UnbundleJob{}); err != nil {
+			panic(err)
+		}
+		if err = db.AutoMigrate(&RequestNonce{}); err != nil {
+			panic(err)
+		}
+		if err = db.AutoMigrate(&SessionKey{}); err != nil {
+			panic(err)
+		}
+		if err = db.AutoMigrate(&SessionKeyAction{}); err != nil {
+			panic(err)
+		}
 
 		return db.Debug(), err
 	} else if config.DBDialect == "mysql" {
@@ -62,6 +86,30 @@ func ConnectDBWithConfig(config *util.DBConfig) (*gorm.DB, error) {
 		if err = db.AutoMigrate(&UserBundlerAccount{}); err != nil {
 			panic(err)
 		}
+		if err = db.AutoMigrate(&Lease{}); err != nil {
+			panic(err)
+		}
+		if err = db.AutoMigrate(&ObjectTag{}); err != nil {
+			panic(err)
+		}
+		if err = db.AutoMigrate(&BundleUpload{}); err != nil {
+			panic(err)
+		}
+		if err = db.AutoMigrate(&BundleUploadChunk{}); err != nil {
+			panic(err)
+		}
+		if err = db.AutoMigrate(&UnbundleJob{}); err != nil {
+			panic(err)
+		}
+		if err = db.AutoMigrate(&RequestNonce{}); err != nil {
+			panic(err)
+		}
+		if err = db.AutoMigrate(&SessionKey{}); err != nil {
+			panic(err)
+		}
+		if err = db.AutoMigrate(&SessionKeyAction{}); err != nil {
+			panic(err)
+		}
 		return db.Debug(), nil
 	} else {
 		return nil, fmt.Errorf("dialect %s not supported", config.DBDialect)
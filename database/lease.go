@@ -0,0 +1,13 @@
+package database
+
+import "time"
+
+// Lease is used to coordinate the bundler replicas, a lease is held by one replica until it expires
+type Lease struct {
+	Id        int64     `json:"id" gorm:"primaryKey"`
+	Name      string    `json:"name" gorm:"size:128;index:idx_lease_name,unique"`
+	Holder    string    `json:"holder" gorm:"size:128"`
+	ExpireAt  time.Time `json:"expire_at" gorm:"NOT NULL;type:TIMESTAMP;default:CURRENT_TIMESTAMP"`
+	CreatedAt time.Time `json:"created_at" gorm:"NOT NULL;type:TIMESTAMP;default:CURRENT_TIMESTAMP;<-:create"`
+	UpdatedAt time.Time `json:"updated_at" gorm:"NOT NULL;type:TIMESTAMP;default:CURRENT_TIMESTAMP"`
+}
@@ -6,19 +6,27 @@ import (
 	"github.com/bnb-chain/greenfield-bundle-sdk/types"
 )
 
-// Object is used to store the object information
+// Object is used to store the object information, the objects of a bucket are also indexed by name across the
+// bundles of the bucket, see idx_object_bucket_name. A deleted object of a sealed bundle is kept as a tombstone until
+// the bundle is compacted, tombstones are hidden from the object queries. A migrated object has been unbundled to a
+// standalone object with the same name on Greenfield, which is served instead. The owner of an object is the owner of
+// its bucket, and the uploader is the signer who uploaded it, which is the owner or a delegate granted to create
+// objects in the bucket.
 type Object struct {
 	Id             int64          `json:"id" gorm:"primaryKey"`
-	Bucket         string         `json:"bucket" gorm:"size:64;index:idx_object_name,priority:1,unique"`
+	Bucket         string         `json:"bucket" gorm:"size:64;index:idx_object_name,priority:1,unique;index:idx_object_bucket_name,priority:1"`
 	BundleName     string         `json:"bundle_name" gorm:"size:128;index:idx_object_name,priority:2,unique"`
-	ObjectName     string         `json:"object_name" gorm:"size:512;index:idx_object_name,priority:3,unique"`
+	ObjectName     string         `json:"object_name" gorm:"size:512;index:idx_object_name,priority:3,unique;index:idx_object_bucket_name,priority:2"`
 	ContentType    string         `json:"content_type" gorm:"size:64"`
 	HashAlgo       types.HashAlgo `json:"hash_algo"`
 	Hash           []byte         `json:"hash"`
 	Owner          string         `json:"owner" gorm:"size:64"`
+	Uploader       string         `json:"uploader" gorm:"size:64"`
 	Size           int64          `json:"size"`
 	OffsetInBundle int64          `json:"offset_in_bundle"`
 	Tags           string         `json:"tags"`
+	Tombstone      bool           `json:"tombstone" gorm:"NOT NULL;default:false"`
+	Migrated       bool           `json:"migrated" gorm:"NOT NULL;default:false"`
 	CreatedAt      time.Time      `json:"created_at" gorm:"NOT NULL;type:TIMESTAMP;default:CURRENT_TIMESTAMP;<-:create"`
 	UpdatedAt      time.Time      `json:"updated_at" gorm:"NOT NULL;type:TIMESTAMP;default:CURRENT_TIMESTAMP"`
 }
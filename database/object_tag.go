@@ -0,0 +1,54 @@
+package database
+
+import (
+	"encoding/json"
+	"sort"
+)
+
+// ObjectTag is used to index the tags of the objects, there is a record for every tag in the json encoded tags of
+// an object, so the objects can be queried by tag
+type ObjectTag struct {
+	Id       int64  `json:"id" gorm:"primaryKey"`
+	ObjectId int64  `json:"object_id" gorm:"index:idx_object_tag_object"`
+	Bucket   string `json:"bucket" gorm:"size:64;index:idx_object_tag_key,priority:1"`
+	TagKey   string `json:"tag_key" gorm:"size:256;index:idx_object_tag_key,priority:2"`
+	TagValue string `json:"tag_value" gorm:"size:1024"`
+}
+
+// ParseObjectTags parses the json encoded tags of an object, empty tags are parsed as nil
+func ParseObjectTags(tags string) (map[string]string, error) {
+	if tags == "" {
+		return nil, nil
+	}
+
+	var parsed map[string]string
+	if err := json.Unmarshal([]byte(tags), &parsed); err != nil {
+		return nil, err
+	}
+	return parsed, nil
+}
+
+// NewObjectTags returns the tag records of the object ordered by key, the object must have been created
+func NewObjectTags(object Object) ([]ObjectTag, error) {
+	tags, err := ParseObjectTags(object.Tags)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(tags))
+	for key := range tags {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	objectTags := make([]ObjectTag, 0, len(keys))
+	for _, key := range keys {
+		objectTags = append(objectTags, ObjectTag{
+			ObjectId: object.Id,
+			Bucket:   object.Bucket,
+			TagKey:   key,
+			TagValue: tags[key],
+		})
+	}
+	return objectTags, nil
+}
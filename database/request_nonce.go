@@ -0,0 +1,13 @@
+package database
+
+import "time"
+
+// RequestNonce records the nonce of a signed request of the signer until the request expires, so a request with the
+// same nonce is rejected as a replay. The nonce can be used again after the expired record is deleted.
+type RequestNonce struct {
+	Id        int64     `json:"id" gorm:"primaryKey"`
+	Signer    string    `json:"signer" gorm:"size:64;index:idx_request_nonce,priority:1,unique"`
+	Nonce     string    `json:"nonce" gorm:"size:128;index:idx_request_nonce,priority:2,unique"`
+	ExpireAt  time.Time `json:"expire_at" gorm:"NOT NULL;type:TIMESTAMP;default:CURRENT_TIMESTAMP;index:idx_request_nonce_expire_at"`
+	CreatedAt time.Time `json:"created_at" gorm:"NOT NULL;type:TIMESTAMP;default:CURRENT_TIMESTAMP;<-:create"`
+}
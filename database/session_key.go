@@ -0,0 +1,33 @@
+package database
+
+import "time"
+
+// SessionKey is an ephemeral key registered by the owner of buckets, which signs requests in place of the owner.
+// It is scoped to the buckets and the scopes of api operations, which are separated by commas, and it can be used
+// until it expires or is revoked, within its quotas of requests and uploaded bytes, a zero quota is unlimited.
+type SessionKey struct {
+	Id             int64     `json:"id" gorm:"primaryKey"`
+	Address        string    `json:"address" gorm:"size:64;index:idx_session_key_address,unique"`
+	Owner          string    `json:"owner" gorm:"size:64;index:idx_session_key_owner"`
+	Buckets        string    `json:"buckets" gorm:"size:8192"`
+	Scopes         string    `json:"scopes" gorm:"size:128"`
+	ExpireAt       time.Time `json:"expire_at" gorm:"NOT NULL;type:TIMESTAMP;default:CURRENT_TIMESTAMP"`
+	MaxRequests    int64     `json:"max_requests"`
+	MaxUploadSize  int64     `json:"max_upload_size"`
+	UsedRequests   int64     `json:"used_requests"`
+	UsedUploadSize int64     `json:"used_upload_size"`
+	Revoked        bool      `json:"revoked" gorm:"NOT NULL;default:false"`
+	CreatedAt      time.Time `json:"created_at" gorm:"NOT NULL;type:TIMESTAMP;default:CURRENT_TIMESTAMP;<-:create"`
+	UpdatedAt      time.Time `json:"updated_at" gorm:"NOT NULL;type:TIMESTAMP;default:CURRENT_TIMESTAMP"`
+}
+
+// SessionKeyAction is the audit record of a request signed by a session key
+type SessionKeyAction struct {
+	Id         int64     `json:"id" gorm:"primaryKey"`
+	SessionKey string    `json:"session_key" gorm:"size:64;index:idx_session_key_action_key"`
+	Owner      string    `json:"owner" gorm:"size:64"`
+	Bucket     string    `json:"bucket" gorm:"size:64"`
+	Operation  string    `json:"operation" gorm:"size:64"`
+	Size       int64     `json:"size"`
+	CreatedAt  time.Time `json:"created_at" gorm:"NOT NULL;type:TIMESTAMP;default:CURRENT_TIMESTAMP;<-:create"`
+}
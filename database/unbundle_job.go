@@ -0,0 +1,59 @@
+package database
+
+import "time"
+
+type UnbundleJobStatus uint
+
+const (
+	UnbundleJobStatusPending        UnbundleJobStatus = 0
+	UnbundleJobStatusCreatedOnChain UnbundleJobStatus = 1
+	UnbundleJobStatusCompleted      UnbundleJobStatus = 2
+	UnbundleJobStatusFailed         UnbundleJobStatus = 3
+)
+
+var unbundleJobStatusNames = map[UnbundleJobStatus]string{
+	UnbundleJobStatusPending:        "pending",
+	UnbundleJobStatusCreatedOnChain: "created_on_chain",
+	UnbundleJobStatusCompleted:      "completed",
+	UnbundleJobStatusFailed:         "failed",
+}
+
+func (s UnbundleJobStatus) String() string {
+	if name, ok := unbundleJobStatusNames[s]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// UnbundleJob is used to track the promotion of an object of a sealed bundle to a standalone object with the same name
+// in the bucket on Greenfield. The job refers to the object by its id, which is kept when the bundle is compacted, and
+// an object is unbundled at most once.
+type UnbundleJob struct {
+	Id             int64             `json:"id" gorm:"primaryKey"`
+	ObjectId       int64             `json:"object_id" gorm:"index:idx_unbundle_job_object_id,unique"`
+	Owner          string            `json:"owner" gorm:"size:64"`
+	Bucket         string            `json:"bucket" gorm:"size:64"`
+	BundleName     string            `json:"bundle_name" gorm:"size:128"` // the bundle of the object when the job is created
+	ObjectName     string            `json:"object_name" gorm:"size:512"`
+	BundlerAccount string            `json:"bundler_account" gorm:"size:64;index:idx_unbundle_job_bundler_account"`
+	Status         UnbundleJobStatus `json:"status"`
+	GnfdObjectId   uint64            `json:"gnfd_object_id"` // gnfd_object_id is used to record the standalone object id on Greenfield
+	TxHash         string            `json:"tx_hash"`
+	RetryCounter   int               `json:"retry_counter"`
+	ErrMessage     string            `json:"err_message"`
+	CreatedAt      time.Time         `json:"created_at" gorm:"NOT NULL;type:TIMESTAMP;default:CURRENT_TIMESTAMP;<-:create"`
+	UpdatedAt      time.Time         `json:"updated_at" gorm:"NOT NULL;type:TIMESTAMP;default:CURRENT_TIMESTAMP"`
+}
+
+func (j *UnbundleJob) IsTimeToRetry() bool {
+	if j.RetryCounter == 0 {
+		return true
+	}
+
+	index := j.RetryCounter - 1
+	if index >= len(retryIntervals) {
+		index = len(retryIntervals) - 1
+	}
+
+	return time.Since(j.UpdatedAt) >= retryIntervals[index]
+}
@@ -191,7 +191,7 @@ func TestBundler(t *testing.T) {
 		util.Logger.Fatalf("new bundler error, err=%s", err.Error())
 	}
 
-	go bundler.Run()
+	go bundler.Run(context.Background())
 
 	ticker := time.NewTicker(1 * time.Second)
 	defer ticker.Stop()
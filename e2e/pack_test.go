@@ -158,6 +158,7 @@ func TestUploadBundle(t *testing.T) {
 		"X-Bundle-Bucket-Name":      "bundle-test",
 		"X-Bundle-Name":             "mysite",
 		"X-Bundle-File-Sha256":      hashInHex,
+		"X-Bundle-Content-Sha256":   hashInHex,
 		"X-Bundle-Expiry-Timestamp": strconv.FormatInt(time.Now().Add(1*time.Hour).Unix(), 10),
 	}
 
@@ -0,0 +1,74 @@
+package metrics
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"github.com/node-real/greenfield-bundle-service/util"
+)
+
+// MetricsPath is the path the metrics are served on
+const MetricsPath = "/metrics"
+
+var startServerOnce sync.Once
+
+// StartServer serves the metrics on the configured port in the background if metrics are enabled, it only starts
+// the server once even if it is called multiple times
+func StartServer(config *util.MetricsConfig) {
+	if config == nil || !config.Enable {
+		return
+	}
+	startServerOnce.Do(func() {
+		startServer(config)
+	})
+}
+
+func startServer(config *util.MetricsConfig) {
+	mux := http.NewServeMux()
+	mux.Handle(MetricsPath, promhttp.Handler())
+	addr := fmt.Sprintf(":%d", config.Port)
+
+	go func() {
+		util.Logger.Infof("serve metrics on %s%s", addr, MetricsPath)
+		if err := http.ListenAndServe(addr, mux); err != nil {
+			util.Logger.Errorf("serve metrics error, addr=%s, err=%s", addr, err.Error())
+		}
+	}()
+}
+
+// ObserveHTTPRequest observes an http request of the api operation
+func ObserveHTTPRequest(operation string, code int, start time.Time) {
+	HTTPRequestsCounter.WithLabelValues(operation, strconv.Itoa(code)).Inc()
+	HTTPRequestSeconds.WithLabelValues(operation).Observe(time.Since(start).Seconds())
+}
+
+// InstrumentHandler observes the requests served by the handler as the api operation
+func InstrumentHandler(operation string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		recorder := &statusRecorder{ResponseWriter: w, code: http.StatusOK}
+		next.ServeHTTP(recorder, r)
+		ObserveHTTPRequest(operation, recorder.code, start)
+	})
+}
+
+type statusRecorder struct {
+	http.ResponseWriter
+	code int
+}
+
+func (r *statusRecorder) WriteHeader(code int) {
+	r.code = code
+	r.ResponseWriter.WriteHeader(code)
+}
+
+func (r *statusRecorder) Flush() {
+	if flusher, ok := r.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
@@ -0,0 +1,148 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/node-real/greenfield-bundle-service/database"
+)
+
+const (
+	namespace = "bundle_service"
+
+	ResultSuccess = "success"
+	ResultFailure = "failure"
+
+	// bundle operations of the bundler
+	OperationSubmit   = "submit"
+	OperationSeal     = "seal"
+	OperationCancel   = "cancel"
+	OperationCompact  = "compact"
+	OperationDelete   = "delete"
+	OperationUnbundle = "unbundle"
+	OperationImport   = "import"
+
+	// upload types
+	UploadTypeObject = "object"
+	UploadTypeBundle = "bundle"
+
+	// cache lookup results
+	CacheResultHit  = "hit"
+	CacheResultMiss = "miss"
+)
+
+var (
+	// BundlesGauge is the number of bundles per status
+	BundlesGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "bundles",
+		Help:      "Number of bundles per status.",
+	}, []string{"status"})
+
+	// BundleOperationsCounter counts the submit, seal, cancel, compact, delete, unbundle and import results per bundler account
+	BundleOperationsCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "bundle_operations_total",
+		Help:      "Number of bundle submit, seal and cancel operations per bundler account and result.",
+	}, []string{"operation", "account", "result"})
+
+	// BundleSealSeconds is the time from the creation of a bundle to it being sealed on Greenfield
+	BundleSealSeconds = promauto.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "bundle_seal_seconds",
+		Help:      "Time from the creation of a bundle to it being sealed on Greenfield.",
+		Buckets:   prometheus.ExponentialBuckets(60, 2, 12), // 1 minute to ~34 hours
+	})
+
+	// UploadSizeBytes is the size of the uploaded objects and bundles
+	UploadSizeBytes = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "upload_size_bytes",
+		Help:      "Size of the uploaded objects and bundles.",
+		Buckets:   prometheus.ExponentialBuckets(1024, 4, 11), // 1KB to 1TB
+	}, []string{"type"})
+
+	// StoreRequestSeconds is the latency of the object store calls
+	StoreRequestSeconds = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "store_request_seconds",
+		Help:      "Latency of the object store calls.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"backend", "method", "result"})
+
+	// GnfdRequestSeconds is the latency of the Greenfield calls
+	GnfdRequestSeconds = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "gnfd_request_seconds",
+		Help:      "Latency of the Greenfield calls.",
+		Buckets:   prometheus.ExponentialBuckets(0.05, 2, 12), // 50ms to ~100s
+	}, []string{"method", "result"})
+
+	// CacheRequestsCounter counts the lookups of the caches of the Greenfield queries per cache and result
+	CacheRequestsCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "cache_requests_total",
+		Help:      "Number of cache lookups per cache and result.",
+	}, []string{"cache", "result"})
+
+	// CacheEvictionsCounter counts the values evicted from the full caches per cache
+	CacheEvictionsCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "cache_evictions_total",
+		Help:      "Number of values evicted from the full caches per cache.",
+	}, []string{"cache"})
+
+	// CacheEntriesGauge is the number of values per cache
+	CacheEntriesGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "cache_entries",
+		Help:      "Number of values per cache.",
+	}, []string{"cache"})
+
+	// HTTPRequestsCounter counts the http requests per api operation and status code
+	HTTPRequestsCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "http_requests_total",
+		Help:      "Number of http requests per api operation and status code.",
+	}, []string{"operation", "code"})
+
+	// HTTPRequestSeconds is the latency of the http requests per api operation
+	HTTPRequestSeconds = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "http_request_seconds",
+		Help:      "Latency of the http requests per api operation.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"operation"})
+)
+
+// Result returns the result label of an operation
+func Result(err error) string {
+	if err != nil {
+		return ResultFailure
+	}
+	return ResultSuccess
+}
+
+// SetBundleCounts sets the bundles gauge, the statuses without bundles are set to zero
+func SetBundleCounts(counts map[database.BundleStatus]int64) {
+	for _, status := range database.AllBundleStatuses() {
+		BundlesGauge.WithLabelValues(status.String()).Set(float64(counts[status]))
+	}
+}
+
+// ObserveBundleOperation counts a bundle operation of the bundler account
+func ObserveBundleOperation(operation string, account string, err error) {
+	BundleOperationsCounter.WithLabelValues(operation, account, Result(err)).Inc()
+}
+
+// ObserveStoreRequest observes the latency of an object store call started at start
+func ObserveStoreRequest(backend string, method string, start time.Time, err error) {
+	StoreRequestSeconds.WithLabelValues(backend, method, Result(err)).Observe(time.Since(start).Seconds())
+}
+
+// ObserveGnfdRequest observes the latency of a Greenfield call started at start
+func ObserveGnfdRequest(method string, start time.Time, err error) {
+	GnfdRequestSeconds.WithLabelValues(method, Result(err)).Observe(time.Since(start).Seconds())
+}
@@ -0,0 +1,32 @@
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/node-real/greenfield-bundle-service/database"
+)
+
+func TestSetBundleCounts(t *testing.T) {
+	SetBundleCounts(map[database.BundleStatus]int64{
+		database.BundleStatusFinalized: 3,
+	})
+
+	assert.Equal(t, float64(3), testutil.ToFloat64(BundlesGauge.WithLabelValues("finalized")))
+	// statuses without bundles are reported as zero instead of keeping stale values
+	assert.Equal(t, float64(0), testutil.ToFloat64(BundlesGauge.WithLabelValues("created_on_chain")))
+}
+
+func TestInstrumentHandler(t *testing.T) {
+	handler := InstrumentHandler("queryBundle", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/queryBundle/bucket/bundle", nil))
+
+	assert.Equal(t, float64(1), testutil.ToFloat64(HTTPRequestsCounter.WithLabelValues("queryBundle", "404")))
+}
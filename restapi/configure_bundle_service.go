@@ -3,28 +3,35 @@
 package restapi
 
 import (
+	"context"
 	"crypto/tls"
 	"encoding/hex"
 	"fmt"
+	"net"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/bnb-chain/greenfield-go-sdk/client"
 	"github.com/bnb-chain/greenfield-go-sdk/types"
 	"github.com/gin-gonic/gin"
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/swag"
 
 	"github.com/node-real/greenfield-bundle-service/auth"
+	"github.com/node-real/greenfield-bundle-service/cache"
 	"github.com/node-real/greenfield-bundle-service/dao"
 	"github.com/node-real/greenfield-bundle-service/database"
+	"github.com/node-real/greenfield-bundle-service/metrics"
 	"github.com/node-real/greenfield-bundle-service/restapi/handlers"
 	"github.com/node-real/greenfield-bundle-service/restapi/operations"
 	"github.com/node-real/greenfield-bundle-service/restapi/operations/bundle"
 	"github.com/node-real/greenfield-bundle-service/restapi/operations/rule"
 	"github.com/node-real/greenfield-bundle-service/service"
 	"github.com/node-real/greenfield-bundle-service/storage"
+	btypes "github.com/node-real/greenfield-bundle-service/types"
 	"github.com/node-real/greenfield-bundle-service/util"
 )
 
@@ -34,6 +41,16 @@ var cliOpts = struct {
 	ConfigFilePath string `short:"c" long:"config-path" description:"Config path" default:"config/server/dev.json"`
 }{}
 
+// DefaultShutdownTimeout is the default time the in-flight requests are allowed to run after the shutdown starts
+const DefaultShutdownTimeout = 15 * time.Second
+
+var (
+	// baseCtx is the base context of all requests, it is canceled if the in-flight requests are not drained within
+	// the shutdown timeout, so the pending Greenfield and object store calls are aborted
+	baseCtx, cancelBaseCtx = context.WithCancel(context.Background())
+	shutdownTimeout        = DefaultShutdownTimeout
+)
+
 func configureFlags(api *operations.BundleServiceAPI) {
 	param := swag.CommandLineOptionsGroup{
 		ShortDescription: "config",
@@ -70,63 +87,127 @@ func configureAPI(api *operations.BundleServiceAPI) http.Handler {
 
 	api.BundleQueryBundlingBundleHandler = bundle.QueryBundlingBundleHandlerFunc(handlers.HandleQueryBundlingBundle())
 
+	api.BundleListBundlesHandler = bundle.ListBundlesHandlerFunc(handlers.HandleListBundles())
+
+	api.BundleListObjectsHandler = bundle.ListObjectsHandlerFunc(handlers.HandleListObjects())
+
 	api.BundleViewBundleObjectHandler = bundle.ViewBundleObjectHandlerFunc(handlers.HandleViewBundleObject())
 
 	api.BundleDownloadBundleObjectHandler = bundle.DownloadBundleObjectHandlerFunc(handlers.HandleDownloadBundleObject())
 
+	api.BundleViewObjectHandler = bundle.ViewObjectHandlerFunc(handlers.HandleViewObject())
+
+	api.BundleDownloadObjectHandler = bundle.DownloadObjectHandlerFunc(handlers.HandleDownloadObject())
+
 	api.BundleCreateBundleHandler = bundle.CreateBundleHandlerFunc(handlers.HandleCreateBundle())
 
 	api.BundleDeleteBundleHandler = bundle.DeleteBundleHandlerFunc(handlers.HandleDeleteBundle())
+	api.BundleInvalidateBucketCacheHandler = bundle.InvalidateBucketCacheHandlerFunc(handlers.HandleInvalidateBucketCache())
+
+	api.BundleCreateSessionKeyHandler = bundle.CreateSessionKeyHandlerFunc(handlers.HandleCreateSessionKey())
+	api.BundleRevokeSessionKeyHandler = bundle.RevokeSessionKeyHandlerFunc(handlers.HandleRevokeSessionKey())
+
+	api.BundlePresignHandler = bundle.PresignHandlerFunc(handlers.HandlePresign())
 
 	api.BundleFinalizeBundleHandler = bundle.FinalizeBundleHandlerFunc(handlers.HandleFinalizeBundle())
 
 	api.BundleUploadObjectHandler = bundle.UploadObjectHandlerFunc(handlers.HandleUploadObject())
 
+	api.BundleUploadObjectsHandler = bundle.UploadObjectsHandlerFunc(handlers.HandleUploadObjects())
+
+	api.BundleDeleteObjectHandler = bundle.DeleteObjectHandlerFunc(handlers.HandleDeleteObject())
+	api.BundleUnbundleObjectHandler = bundle.UnbundleObjectHandlerFunc(handlers.HandleUnbundleObject())
+	api.BundleImportBundleHandler = bundle.ImportBundleHandlerFunc(handlers.HandleImportBundle())
+
 	api.BundleUploadBundleHandler = bundle.UploadBundleHandlerFunc(handlers.HandleUploadBundle())
 
+	api.BundleInitiateBundleUploadHandler = bundle.InitiateBundleUploadHandlerFunc(handlers.HandleInitiateBundleUpload())
+
+	api.BundleUploadBundleChunkHandler = bundle.UploadBundleChunkHandlerFunc(handlers.HandleUploadBundleChunk())
+
+	api.BundleQueryBundleUploadHandler = bundle.QueryBundleUploadHandlerFunc(handlers.HandleQueryBundleUpload())
+
+	api.BundleCompleteBundleUploadHandler = bundle.CompleteBundleUploadHandlerFunc(handlers.HandleCompleteBundleUpload())
+
 	api.BundleBundlerAccountHandler = bundle.BundlerAccountHandlerFunc(handlers.HandleGetUserBundlerAccount())
 
-	api.PreServerShutdown = func() {}
+	api.PreServerShutdown = func() {
+		util.Logger.Infof("server shutting down, draining in-flight requests, timeout=%s", shutdownTimeout)
+		time.AfterFunc(shutdownTimeout, func() {
+			util.Logger.Warnf("in-flight requests not drained in %s, canceling them", shutdownTimeout)
+			cancelBaseCtx()
+		})
+	}
 
-	api.ServerShutdown = func() {}
+	api.ServerShutdown = func() {
+		cancelBaseCtx()
+		util.Logger.Infof("server shutdown")
+	}
 
 	router := gin.Default()
 
-	// Define the route
-	router.GET("/v1/view/:bucketName/:bundleName/*objectName", func(c *gin.Context) {
+	// Define the route, the object path is either {bundleName}/{objectName} of an object in a bundle, or the name of
+	// the latest object with the name in the bucket. The bundle form takes precedence for compatibility.
+	router.GET("/v1/view/:bucketName/*objectPath", func(c *gin.Context) {
+		start := time.Now()
 		bucketName := c.Param("bucketName")
-		bundleName := c.Param("bundleName")
-		objectName := c.Param("objectName")
-		objectName = strings.TrimPrefix(objectName, "/")
-
-		params := bundle.NewViewBundleObjectParams()
-		params.HTTPRequest = c.Request
-		params.BucketName = bucketName
-		params.BundleName = bundleName
-		params.ObjectName = objectName
+		objectPath := strings.TrimPrefix(c.Param("objectPath"), "/")
+
+		var operation string
+		var responder middleware.Responder
+		if bundleName, objectName, ok := handlers.SplitBundleObjectPath(bucketName, objectPath); ok {
+			params := bundle.NewViewBundleObjectParams()
+			params.HTTPRequest = c.Request
+			params.BucketName = bucketName
+			params.BundleName = bundleName
+			params.ObjectName = objectName
+
+			operation = "viewBundleObject"
+			responder = api.BundleViewBundleObjectHandler.Handle(params)
+		} else {
+			params := bundle.NewViewObjectParams()
+			params.HTTPRequest = c.Request
+			params.BucketName = bucketName
+			params.ObjectName = objectPath
 
-		responder := api.BundleViewBundleObjectHandler.Handle(params)
+			operation = "viewObject"
+			responder = api.BundleViewObjectHandler.Handle(params)
+		}
 
 		// Write the response
 		responder.WriteResponse(c.Writer, runtime.JSONProducer())
+		metrics.ObserveHTTPRequest(operation, c.Writer.Status(), start)
 	})
 
-	router.GET("/v1/download/:bucketName/:bundleName/*objectName", func(c *gin.Context) {
+	router.GET("/v1/download/:bucketName/*objectPath", func(c *gin.Context) {
+		start := time.Now()
 		bucketName := c.Param("bucketName")
-		bundleName := c.Param("bundleName")
-		objectName := c.Param("objectName")
-		objectName = strings.TrimPrefix(objectName, "/")
-
-		params := bundle.NewDownloadBundleObjectParams()
-		params.HTTPRequest = c.Request
-		params.BucketName = bucketName
-		params.BundleName = bundleName
-		params.ObjectName = objectName
+		objectPath := strings.TrimPrefix(c.Param("objectPath"), "/")
+
+		var operation string
+		var responder middleware.Responder
+		if bundleName, objectName, ok := handlers.SplitBundleObjectPath(bucketName, objectPath); ok {
+			params := bundle.NewDownloadBundleObjectParams()
+			params.HTTPRequest = c.Request
+			params.BucketName = bucketName
+			params.BundleName = bundleName
+			params.ObjectName = objectName
+
+			operation = "downloadBundleObject"
+			responder = api.BundleDownloadBundleObjectHandler.Handle(params)
+		} else {
+			params := bundle.NewDownloadObjectParams()
+			params.HTTPRequest = c.Request
+			params.BucketName = bucketName
+			params.ObjectName = objectPath
 
-		responder := api.BundleDownloadBundleObjectHandler.Handle(params)
+			operation = "downloadObject"
+			responder = api.BundleDownloadObjectHandler.Handle(params)
+		}
 
 		// Write the response
 		responder.WriteResponse(c.Writer, runtime.JSONProducer())
+		metrics.ObserveHTTPRequest(operation, c.Writer.Status(), start)
 	})
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
@@ -154,6 +235,15 @@ func configureServer(s *http.Server, scheme, addr string) {
 
 	util.InitLogger(config.LogConfig)
 
+	metrics.StartServer(config.MetricsConfig)
+
+	if config.BundleConfig.ShutdownTimeout > 0 {
+		shutdownTimeout = time.Duration(config.BundleConfig.ShutdownTimeout) * time.Second
+	}
+	s.BaseContext = func(net.Listener) context.Context {
+		return baseCtx
+	}
+
 	db, err := database.ConnectDBWithConfig(config.DBConfig)
 	if err != nil {
 		panic(err)
@@ -164,18 +254,51 @@ func configureServer(s *http.Server, scheme, addr string) {
 	objectDao := dao.NewObjectDao(db)
 	userBundlerAccountDao := dao.NewUserBundlerAccountDao(db)
 	bundlerAccountDao := dao.NewBundlerAccountDao(db)
+	bundleUploadDao := dao.NewBundleUploadDao(db)
+	unbundleJobDao := dao.NewUnbundleJobDao(db)
+	requestNonceDao := dao.NewRequestNonceDao(db)
+	sessionKeyDao := dao.NewSessionKeyDao(db)
 
 	gnfdClient, err := client.New(config.GnfdConfig.ChainId, config.GnfdConfig.RpcUrl, client.Option{})
 	if err != nil {
 		panic(fmt.Errorf("unable to new greenfield client, %v", err))
 	}
 
-	// set a random default account for server gnfd client
-	privkey, _, err := util.GenerateRandomAccount()
+	// the EIP-712 signatures of the requests are bound to the greenfield chain
+	chainId, err := config.GnfdConfig.EIP155ChainId()
 	if err != nil {
 		panic(err)
 	}
-	serverAccount, err := types.NewAccountFromPrivateKey("server-account", hex.EncodeToString(privkey))
+	btypes.SetEIP712ChainId(chainId)
+
+	// the requests of the sensitive api operations can be configured to expire sooner, and the presigned urls are
+	// signed with the configured secret if they are enabled
+	if config.AuthConfig != nil {
+		if err := btypes.SetMaxExpiryAges(config.AuthConfig.MaxExpiryAges); err != nil {
+			panic(err)
+		}
+		if config.AuthConfig.EnablePresign {
+			if config.AuthConfig.PresignSecret == "" {
+				panic("presign secret is required when presigned urls are enabled")
+			}
+			if err := btypes.SetPresignSecret([]byte(config.AuthConfig.PresignSecret)); err != nil {
+				panic(err)
+			}
+		}
+	}
+
+	// set the reader account as the default account of the server gnfd client, the bundles of the private buckets on
+	// greenfield can only be read if the owners of the buckets grant it ACTION_GET_OBJECT, the server account is a
+	// random one if no reader account is configured
+	readerPrivateKey := config.GnfdConfig.ReaderPrivateKey
+	if readerPrivateKey == "" {
+		privkey, _, err := util.GenerateRandomAccount()
+		if err != nil {
+			panic(err)
+		}
+		readerPrivateKey = hex.EncodeToString(privkey)
+	}
+	serverAccount, err := types.NewAccountFromPrivateKey("server-account", readerPrivateKey)
 	if err != nil {
 		panic(err)
 	}
@@ -183,21 +306,49 @@ func configureServer(s *http.Server, scheme, addr string) {
 	gnfdClient.SetDefaultAccount(serverAccount)
 
 	fileManager := storage.NewFileManager(config, objectDao, bundleDao, gnfdClient)
-	authManager := auth.NewAuthManager(gnfdClient)
+	gnfdCache := cache.NewGnfdCache(config.CacheConfig)
+	authManager := auth.NewAuthManager(gnfdClient, gnfdCache)
 
 	// init services
 	service.GnfdClient = gnfdClient
 
-	service.BundleSvc = service.NewBundleService(gnfdClient, authManager, bundleDao, bundleRuleDao, userBundlerAccountDao)
+	service.BundleSvc = service.NewBundleService(gnfdClient, gnfdCache, authManager, bundleDao, bundleRuleDao, userBundlerAccountDao)
 	service.BundleRuleSvc = service.NewBundleRuleService(bundleRuleDao)
 	service.ObjectSvc = service.NewObjectService(config, fileManager, bundleDao, objectDao, userBundlerAccountDao)
 	service.UserBundlerAccountSvc = service.NewUserBundlerAccountService(userBundlerAccountDao, bundlerAccountDao)
+	service.BundleUploadSvc = service.NewBundleUploadService(fileManager, bundleUploadDao)
+	service.UnbundleSvc = service.NewUnbundleService(gnfdClient, unbundleJobDao)
+	service.RequestNonceSvc = service.NewRequestNonceService(requestNonceDao)
+	service.SessionKeySvc = service.NewSessionKeyService(sessionKeyDao)
+
+	// the nonces of the signed requests are recorded until the requests expire, so they can not be replayed
+	btypes.SetNonceStore(service.RequestNonceSvc)
+	// the requests signed by the session keys act on behalf of their owners within the scopes and quotas
+	btypes.SetSessionKeyStore(service.SessionKeySvc)
+
+	// index the tags of the objects uploaded before the tags were indexed, it is retried on the next start if it fails
+	go func() {
+		_ = service.ObjectSvc.BackfillObjectTags()
+	}()
+
+	// garbage collect the abandoned resumable bundle uploads until the server shuts down
+	go service.BundleUploadSvc.RunBundleUploadGC(baseCtx)
+
+	// delete the nonces of the expired requests until the server shuts down
+	go service.RequestNonceSvc.RunRequestNonceGC(baseCtx)
 }
 
 // The middleware configuration is for the handler executors. These do not apply to the swagger.json document.
 // The middleware executes after routing but before authentication, binding and validation.
 func setupMiddlewares(handler http.Handler) http.Handler {
-	return handler
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// the route is matched before the middlewares are executed, so the operation is known here
+		operation := "unknown"
+		if route := middleware.MatchedRouteFrom(r); route != nil && route.Operation != nil {
+			operation = route.Operation.ID
+		}
+		metrics.InstrumentHandler(operation, handler).ServeHTTP(w, r)
+	})
 }
 
 // The middleware configuration happens before anything, this middleware also applies to serving the swagger.json document.
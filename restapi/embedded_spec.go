@@ -75,40 +75,30 @@ func init() {
         }
       }
     },
-    "/createBundle": {
+    "/completeBundleUpload/{uploadId}": {
       "post": {
-        "description": "Initiates a new bundle, requiring details like bucket name and bundle name.\n",
-        "consumes": [
-          "application/json"
-        ],
+        "description": "Assembles the chunks of a resumable bundle upload and creates the bundle from the bundle file like uploadBundle does. If the bundle file is invalid, the upload stays open, so chunks can be uploaded again. Completing a completed upload again succeeds.\n",
         "produces": [
           "application/json"
         ],
         "tags": [
           "Bundle"
         ],
-        "summary": "Start a New Bundle",
-        "operationId": "createBundle",
+        "summary": "Complete a resumable bundle upload",
+        "operationId": "completeBundleUpload",
         "parameters": [
           {
             "type": "string",
-            "description": "User's digital signature for authorization",
+            "description": "User's digital signature for authentication",
             "name": "Authorization",
             "in": "header",
             "required": true
           },
           {
             "type": "string",
-            "description": "The name of the bucket",
-            "name": "X-Bundle-Bucket-Name",
-            "in": "header",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "The name of the bundle to be created",
-            "name": "X-Bundle-Name",
-            "in": "header",
+            "description": "The id of the bundle upload",
+            "name": "uploadId",
+            "in": "path",
             "required": true
           },
           {
@@ -122,10 +112,25 @@ func init() {
         ],
         "responses": {
           "200": {
-            "description": "Successfully managed bundle"
+            "description": "Successfully completed the bundle upload",
+            "schema": {
+              "$ref": "#/definitions/BundleUploadInfo"
+            }
           },
           "400": {
-            "description": "Invalid request or parameters",
+            "description": "Invalid request or file format",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Bundle upload not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "The bundle upload is not uploading anymore",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -139,9 +144,9 @@ func init() {
         }
       }
     },
-    "/deleteBundle": {
+    "/createBundle": {
       "post": {
-        "description": "Delete an bundle after object deletion on Greenfield\n",
+        "description": "Initiates a new bundle, requiring details like bucket name and bundle name.\n",
         "consumes": [
           "application/json"
         ],
@@ -151,8 +156,8 @@ func init() {
         "tags": [
           "Bundle"
         ],
-        "summary": "Delete an bundle after object deletion on Greenfield",
-        "operationId": "deleteBundle",
+        "summary": "Start a New Bundle",
+        "operationId": "createBundle",
         "parameters": [
           {
             "type": "string",
@@ -170,7 +175,7 @@ func init() {
           },
           {
             "type": "string",
-            "description": "The name of the bundle to be finalized",
+            "description": "The name of the bundle to be created",
             "name": "X-Bundle-Name",
             "in": "header",
             "required": true
@@ -186,7 +191,7 @@ func init() {
         ],
         "responses": {
           "200": {
-            "description": "Successfully deleted bundle"
+            "description": "Successfully managed bundle"
           },
           "400": {
             "description": "Invalid request or parameters",
@@ -203,55 +208,95 @@ func init() {
         }
       }
     },
-    "/download/{bucketName}/{bundleName}/{objectName}": {
-      "get": {
-        "description": "Download a specific object from a given bundle and returns it as a file.\n",
+    "/createSessionKey": {
+      "post": {
+        "description": "Register an ephemeral key which signs the requests of the signer in place of the signer's key, e.g. for batch jobs. The session key is scoped to buckets owned by the signer and to scopes of operations, and it can be used until it expires or is revoked, within its quotas.\n",
+        "consumes": [
+          "application/json"
+        ],
         "produces": [
-          "application/octet-stream"
+          "application/json"
         ],
         "tags": [
           "Bundle"
         ],
-        "summary": "Download an object as a file from a bundle",
-        "operationId": "downloadBundleObject",
+        "summary": "Register a session key",
+        "operationId": "createSessionKey",
         "parameters": [
           {
             "type": "string",
-            "description": "The bucketName of the bundle",
-            "name": "bucketName",
-            "in": "path",
+            "description": "User's digital signature for authorization",
+            "name": "Authorization",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Expiry timestamp of the request",
+            "name": "X-Bundle-Expiry-Timestamp",
+            "in": "header",
             "required": true
           },
           {
             "type": "string",
-            "description": "The name of the bundle",
-            "name": "bundleName",
-            "in": "path",
+            "description": "The address of the session key",
+            "name": "X-Bundle-Session-Key-Address",
+            "in": "header",
             "required": true
           },
           {
             "type": "string",
-            "description": "The name of the object within the bundle",
-            "name": "objectName",
-            "in": "path",
+            "description": "The buckets which the session key can access, separated by commas, the buckets should be owned by the signer",
+            "name": "X-Bundle-Session-Key-Buckets",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The scopes of the operations which the session key can perform, separated by commas, one of upload, finalize and rules",
+            "name": "X-Bundle-Session-Key-Scopes",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "The timestamp when the session key expires, at most 30 days ahead",
+            "name": "X-Bundle-Session-Key-Expiry",
+            "in": "header",
             "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "The max number of requests signed by the session key, unlimited if it is 0",
+            "name": "X-Bundle-Session-Key-Max-Requests",
+            "in": "header"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "The max total size of the requests signed by the session key, unlimited if it is 0",
+            "name": "X-Bundle-Session-Key-Max-Upload-Size",
+            "in": "header"
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully retrieved file",
+            "description": "Successfully registered the session key",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/SessionKeyInfo"
             }
           },
           "400": {
-            "description": "Invalid request or file format",
+            "description": "Invalid request or parameters",
             "schema": {
               "$ref": "#/definitions/Error"
             }
           },
-          "404": {
-            "description": "Bundle or object not found",
+          "409": {
+            "description": "The session key is already registered",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -265,9 +310,9 @@ func init() {
         }
       }
     },
-    "/finalizeBundle": {
+    "/deleteBundle": {
       "post": {
-        "description": "Completes the lifecycle of an existing bundle, requiring the bundle name for authorization.\n",
+        "description": "Delete an bundle after object deletion on Greenfield\n",
         "consumes": [
           "application/json"
         ],
@@ -277,8 +322,8 @@ func init() {
         "tags": [
           "Bundle"
         ],
-        "summary": "Finalize an Existing Bundle",
-        "operationId": "finalizeBundle",
+        "summary": "Delete an bundle after object deletion on Greenfield",
+        "operationId": "deleteBundle",
         "parameters": [
           {
             "type": "string",
@@ -312,7 +357,7 @@ func init() {
         ],
         "responses": {
           "200": {
-            "description": "Successfully managed bundle"
+            "description": "Successfully deleted bundle"
           },
           "400": {
             "description": "Invalid request or parameters",
@@ -329,48 +374,66 @@ func init() {
         }
       }
     },
-    "/queryBundle/{bucketName}/{bundleName}": {
-      "get": {
-        "description": "Queries the bundle information of a given bundle.\n",
+    "/deleteObject": {
+      "post": {
+        "description": "Deletes an object from the bundling bundle of a bucket before the bundle is finalized. If X-Bundle-Name names a bundle sealed on Greenfield, the object is tombstoned instead, it is hidden from reads and listings right away and its data is removed when the bundler compacts the bundle. Objects of finalized bundles which are not sealed yet can not be deleted.\n",
         "produces": [
-          "application/octet-stream"
+          "application/json"
         ],
         "tags": [
           "Bundle"
         ],
-        "summary": "Query bundle information",
-        "operationId": "queryBundle",
+        "summary": "Delete an object from the bundling bundle or a sealed bundle",
+        "operationId": "deleteObject",
         "parameters": [
           {
             "type": "string",
-            "description": "The bucketName of the bundle",
-            "name": "bucketName",
-            "in": "path",
+            "description": "User's digital signature for authentication",
+            "name": "Authorization",
+            "in": "header",
             "required": true
           },
           {
             "type": "string",
-            "description": "The name of the bundle",
-            "name": "bundleName",
-            "in": "path",
+            "description": "The name of the bucket",
+            "name": "X-Bundle-Bucket-Name",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The name of the object to be deleted",
+            "name": "X-Bundle-File-Name",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The name of the bundle of the object, the bundling bundle of the bucket if not set",
+            "name": "X-Bundle-Name",
+            "in": "header"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Expiry timestamp of the request",
+            "name": "X-Bundle-Expiry-Timestamp",
+            "in": "header",
             "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully queried bundle",
-            "schema": {
-              "$ref": "#/definitions/QueryBundleResponse"
-            }
+            "description": "Successfully deleted object"
           },
           "400": {
-            "description": "Invalid request or file format",
+            "description": "Invalid request or parameters",
             "schema": {
               "$ref": "#/definitions/Error"
             }
           },
           "404": {
-            "description": "Bundle not found",
+            "description": "Object or bundle not found",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -384,17 +447,17 @@ func init() {
         }
       }
     },
-    "/queryBundlingBundle/{bucketName}": {
+    "/download/{bucketName}/{bundleName}/{objectName}": {
       "get": {
-        "description": "Queries the bundling bundle information of a given bucket.\n",
+        "description": "Download a specific object from a given bundle and returns it as a file. Single byte range requests (Range, If-Range) and conditional requests (If-None-Match, If-Modified-Since) are supported using the ETag and Last-Modified of the object.\n",
         "produces": [
           "application/octet-stream"
         ],
         "tags": [
           "Bundle"
         ],
-        "summary": "Query bundling bundle information of a bucket",
-        "operationId": "queryBundlingBundle",
+        "summary": "Download an object as a file from a bundle",
+        "operationId": "downloadBundleObject",
         "parameters": [
           {
             "type": "string",
@@ -402,21 +465,97 @@ func init() {
             "name": "bucketName",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "description": "The name of the bundle",
+            "name": "bundleName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The name of the object within the bundle",
+            "name": "objectName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Digital signature of an account which can get objects in the bucket, required to read a private bucket without a presigned url",
+            "name": "Authorization",
+            "in": "header"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Expiry timestamp of the signed request",
+            "name": "X-Bundle-Expiry-Timestamp",
+            "in": "header"
+          },
+          {
+            "type": "string",
+            "description": "The account which requested the presigned url",
+            "name": "presignSigner",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The bundle of the object of the presigned url, the latest object with the name is read if it is empty",
+            "name": "presignBundleName",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "The expiry timestamp of the presigned url",
+            "name": "presignExpiry",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The signature of the presigned url",
+            "name": "presignSignature",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully queried bundle",
+            "description": "Successfully retrieved file",
             "schema": {
-              "$ref": "#/definitions/QueryBundleResponse"
+              "type": "file"
+            }
+          },
+          "206": {
+            "description": "Successfully retrieved the requested range of the file",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "304": {
+            "description": "The file is not modified since the version in the conditional request headers"
+          },
+          "400": {
+            "description": "Invalid request or file format",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "The bucket is private and the request is not signed by an account which can get objects in it",
+            "schema": {
+              "$ref": "#/definitions/Error"
             }
           },
           "404": {
-            "description": "Bundle not found",
+            "description": "Bundle or object not found",
             "schema": {
               "$ref": "#/definitions/Error"
             }
           },
+          "416": {
+            "description": "The requested range is not satisfiable"
+          },
           "500": {
             "description": "Internal server error",
             "schema": {
@@ -426,56 +565,150 @@ func init() {
         }
       }
     },
-    "/setBundleRule": {
-      "post": {
-        "description": "Set new rules or replace old rules for bundling, including constraints like maximum size and number of files.\n",
-        "consumes": [
-          "application/json"
-        ],
+    "/download/{bucketName}/{objectName}": {
+      "get": {
+        "description": "Download an object of a given bucket by its name without the name of its bundle and returns it as a file. If several bundles of the bucket contain an object with the name, the latest uploaded object is returned. Single byte range requests (Range, If-Range) and conditional requests (If-None-Match, If-Modified-Since) are supported using the ETag and Last-Modified of the object.\n",
         "produces": [
-          "application/json"
+          "application/octet-stream"
         ],
         "tags": [
-          "Rule"
+          "Bundle"
         ],
-        "summary": "Set New Bundling Rules",
-        "operationId": "setBundleRule",
+        "summary": "Download an object as a file by its name",
+        "operationId": "downloadObject",
         "parameters": [
           {
             "type": "string",
-            "description": "User's digital signature for authorization",
-            "name": "Authorization",
-            "in": "header",
+            "description": "The bucketName of the object",
+            "name": "bucketName",
+            "in": "path",
             "required": true
           },
           {
             "type": "string",
-            "description": "Name of the bucket for which the rule applies",
-            "name": "X-Bundle-Bucket-Name",
-            "in": "header",
+            "description": "The name of the object",
+            "name": "objectName",
+            "in": "path",
             "required": true
           },
+          {
+            "type": "string",
+            "description": "Digital signature of an account which can get objects in the bucket, required to read a private bucket without a presigned url",
+            "name": "Authorization",
+            "in": "header"
+          },
           {
             "type": "integer",
             "format": "int64",
-            "description": "Maximum size of a bundle in bytes",
-            "name": "X-Bundle-Max-Bundle-Size",
-            "in": "header",
-            "required": true
+            "description": "Expiry timestamp of the signed request",
+            "name": "X-Bundle-Expiry-Timestamp",
+            "in": "header"
+          },
+          {
+            "type": "string",
+            "description": "The account which requested the presigned url",
+            "name": "presignSigner",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The bundle of the object of the presigned url, the latest object with the name is read if it is empty",
+            "name": "presignBundleName",
+            "in": "query"
           },
           {
             "type": "integer",
             "format": "int64",
-            "description": "Maximum number of files in a bundle",
-            "name": "X-Bundle-Max-Bundle-Files",
+            "description": "The expiry timestamp of the presigned url",
+            "name": "presignExpiry",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The signature of the presigned url",
+            "name": "presignSignature",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully retrieved file",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "206": {
+            "description": "Successfully retrieved the requested range of the file",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "304": {
+            "description": "The file is not modified since the version in the conditional request headers"
+          },
+          "400": {
+            "description": "Invalid request or file format",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "The bucket is private and the request is not signed by an account which can get objects in it",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Object not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "416": {
+            "description": "The requested range is not satisfiable"
+          },
+          "500": {
+            "description": "Internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/finalizeBundle": {
+      "post": {
+        "description": "Completes the lifecycle of an existing bundle, requiring the bundle name for authorization.\n",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "Bundle"
+        ],
+        "summary": "Finalize an Existing Bundle",
+        "operationId": "finalizeBundle",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "User's digital signature for authorization",
+            "name": "Authorization",
             "in": "header",
             "required": true
           },
           {
-            "type": "integer",
-            "format": "int64",
-            "description": "Maximum time in seconds before a bundle must be finalized",
-            "name": "X-Bundle-Max-Finalize-Time",
+            "type": "string",
+            "description": "The name of the bucket",
+            "name": "X-Bundle-Bucket-Name",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The name of the bundle to be finalized",
+            "name": "X-Bundle-Name",
             "in": "header",
             "required": true
           },
@@ -490,7 +723,7 @@ func init() {
         ],
         "responses": {
           "200": {
-            "description": "Successfully added bundle rule"
+            "description": "Successfully managed bundle"
           },
           "400": {
             "description": "Invalid request or parameters",
@@ -507,24 +740,21 @@ func init() {
         }
       }
     },
-    "/uploadBundle": {
+    "/importBundle": {
       "post": {
-        "description": "Uploads a bundle of objects, requiring details like bucket name, bundle name, and etc.\n",
-        "consumes": [
-          "multipart/form-data"
-        ],
+        "description": "Indexes a bundle which is already sealed on Greenfield but was not created by the service. The bundle is recorded as importing and the bundler reads its metadata from Greenfield, then creates its objects, so that they can be viewed and downloaded like the objects of the bundles created by the service. The status of the import is returned by queryBundle, a failed import can be requested again.\n",
         "produces": [
           "application/json"
         ],
         "tags": [
           "Bundle"
         ],
-        "summary": "Upload a bundle",
-        "operationId": "uploadBundle",
+        "summary": "Import a bundle sealed on Greenfield by another tool",
+        "operationId": "importBundle",
         "parameters": [
           {
             "type": "string",
-            "description": "User's digital signature for authentication",
+            "description": "User's digital signature for authorization",
             "name": "Authorization",
             "in": "header",
             "required": true
@@ -538,18 +768,11 @@ func init() {
           },
           {
             "type": "string",
-            "description": "The name of the bundle to be created",
+            "description": "The name of the bundle object on Greenfield",
             "name": "X-Bundle-Name",
             "in": "header",
             "required": true
           },
-          {
-            "type": "string",
-            "description": "SHA256 hash of the file",
-            "name": "X-Bundle-File-Sha256",
-            "in": "header",
-            "required": true
-          },
           {
             "type": "integer",
             "format": "int64",
@@ -557,24 +780,29 @@ func init() {
             "name": "X-Bundle-Expiry-Timestamp",
             "in": "header",
             "required": true
-          },
-          {
-            "type": "file",
-            "description": "The file to be uploaded",
-            "name": "file",
-            "in": "formData",
-            "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully uploaded file",
+            "description": "The imported bundle",
             "schema": {
-              "$ref": "#/definitions/UploadObjectResponse"
+              "$ref": "#/definitions/BundleInfo"
             }
           },
           "400": {
-            "description": "Invalid request or file format",
+            "description": "Invalid request or parameters",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Bundle object not found on Greenfield",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "The bundle already exists",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -588,20 +816,17 @@ func init() {
         }
       }
     },
-    "/uploadObject": {
+    "/initiateBundleUpload": {
       "post": {
-        "description": "Uploads a single object to a bundle, requiring details like bucket name, file name, and etc.\n",
-        "consumes": [
-          "multipart/form-data"
-        ],
+        "description": "Initiates a resumable upload of a bundle file, which is then uploaded in chunks of the returned chunk size by uploadBundleChunk and completed by completeBundleUpload. Uploads which are not updated for a day are garbage collected.\n",
         "produces": [
           "application/json"
         ],
         "tags": [
           "Bundle"
         ],
-        "summary": "Upload a single object to a bundle",
-        "operationId": "uploadObject",
+        "summary": "Initiate a resumable bundle upload",
+        "operationId": "initiateBundleUpload",
         "parameters": [
           {
             "type": "string",
@@ -619,31 +844,26 @@ func init() {
           },
           {
             "type": "string",
-            "description": "The name of the file to be uploaded",
-            "name": "X-Bundle-File-Name",
+            "description": "The name of the bundle to be created",
+            "name": "X-Bundle-Name",
             "in": "header",
             "required": true
           },
           {
-            "type": "string",
-            "description": "Content type of the file",
-            "name": "X-Bundle-Content-Type",
+            "type": "integer",
+            "format": "int64",
+            "description": "Size of the bundle file",
+            "name": "X-Bundle-File-Size",
             "in": "header",
             "required": true
           },
           {
             "type": "string",
-            "description": "SHA256 hash of the file",
+            "description": "SHA256 hash of the bundle file",
             "name": "X-Bundle-File-Sha256",
             "in": "header",
             "required": true
           },
-          {
-            "type": "string",
-            "description": "Tags of the file",
-            "name": "X-Bundle-Tags",
-            "in": "header"
-          },
           {
             "type": "integer",
             "format": "int64",
@@ -651,20 +871,13 @@ func init() {
             "name": "X-Bundle-Expiry-Timestamp",
             "in": "header",
             "required": true
-          },
-          {
-            "type": "file",
-            "description": "The file to be uploaded",
-            "name": "file",
-            "in": "formData",
-            "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully uploaded file",
+            "description": "Successfully initiated the bundle upload",
             "schema": {
-              "$ref": "#/definitions/UploadObjectResponse"
+              "$ref": "#/definitions/BundleUploadInfo"
             }
           },
           "400": {
@@ -682,55 +895,50 @@ func init() {
         }
       }
     },
-    "/view/{bucketName}/{bundleName}/{objectName}": {
-      "get": {
-        "description": "Fetches a specific object from a given bundle and returns it as a file.\n",
+    "/invalidateBucketCache": {
+      "post": {
+        "description": "Invalidate the cached info of the bucket and the cached permissions of the bundler accounts on it, so they are queried from Greenfield again, e.g. after the owner or the policies of the bucket changed on Greenfield. The signer should be the owner of the bucket on Greenfield.\n",
+        "consumes": [
+          "application/json"
+        ],
         "produces": [
-          "application/octet-stream"
+          "application/json"
         ],
         "tags": [
           "Bundle"
         ],
-        "summary": "Retrieve an object as a file from a bundle",
-        "operationId": "viewBundleObject",
+        "summary": "Invalidate the cached info of a bucket",
+        "operationId": "invalidateBucketCache",
         "parameters": [
           {
             "type": "string",
-            "description": "The bucketName of the bundle",
-            "name": "bucketName",
-            "in": "path",
+            "description": "User's digital signature for authorization",
+            "name": "Authorization",
+            "in": "header",
             "required": true
           },
           {
             "type": "string",
-            "description": "The name of the bundle",
-            "name": "bundleName",
-            "in": "path",
+            "description": "The name of the bucket",
+            "name": "X-Bundle-Bucket-Name",
+            "in": "header",
             "required": true
           },
           {
-            "type": "string",
-            "description": "The name of the object within the bundle",
-            "name": "objectName",
-            "in": "path",
+            "type": "integer",
+            "format": "int64",
+            "description": "Expiry timestamp of the request",
+            "name": "X-Bundle-Expiry-Timestamp",
+            "in": "header",
             "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully retrieved file",
-            "schema": {
-              "type": "file"
-            }
+            "description": "Successfully invalidated the cached info of the bucket"
           },
           "400": {
-            "description": "Invalid request or file format",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          },
-          "404": {
-            "description": "Bundle or object not found",
+            "description": "Invalid request or parameters",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -743,130 +951,93 @@ func init() {
           }
         }
       }
-    }
-  },
-  "definitions": {
-    "BundlerAccount": {
-      "type": "object",
-      "properties": {
-        "address": {
-          "description": "The address of the bundler",
-          "type": "string",
-          "x-omitempty": false
-        }
-      }
-    },
-    "Error": {
-      "type": "object",
-      "properties": {
-        "code": {
-          "description": "HTTP error code",
-          "type": "integer",
-          "format": "int64",
-          "x-omitempty": false,
-          "example": "400/500"
-        },
-        "message": {
-          "description": "Error message",
-          "type": "string",
-          "x-omitempty": false,
-          "example": "Bad request/Internal server error"
-        }
-      }
     },
-    "QueryBundleResponse": {
-      "type": "object",
-      "properties": {
-        "bucketName": {
-          "description": "The name of the bucket where the file has been uploaded",
-          "type": "string",
-          "x-omitempty": false
-        },
-        "bundleName": {
-          "description": "The name of the bundle where the file has been uploaded",
-          "type": "string",
-          "x-omitempty": false
-        },
-        "createdTimestamp": {
-          "description": "The creation timestamp of the bundle",
-          "type": "integer",
-          "x-omitempty": false
-        },
-        "errorMessage": {
-          "description": "The error message of the object",
-          "type": "string",
-          "x-omitempty": false
-        },
-        "files": {
-          "description": "The number of files in the bundle",
-          "type": "integer",
-          "x-omitempty": false
-        },
-        "size": {
-          "description": "The size of the bundle",
-          "type": "integer",
-          "x-omitempty": false
-        },
-        "status": {
-          "description": "The status of the object",
-          "type": "integer",
-          "x-omitempty": false
-        }
-      }
-    },
-    "UploadObjectResponse": {
-      "type": "object",
-      "properties": {
-        "bundleName": {
-          "description": "The name of the bundle where the file has been uploaded",
-          "type": "string",
-          "x-omitempty": false
-        }
-      }
-    }
-  }
-}`))
-	FlatSwaggerJSON = json.RawMessage([]byte(`{
-  "schemes": [
-    "http"
-  ],
-  "swagger": "2.0",
-  "info": {
-    "description": "API for handling file bundling and querying objects in the Bundle Service.",
-    "title": "Bundle Service API",
-    "version": "1.0.0"
-  },
-  "host": "gnfd-testnet-bundle.nodereal.io",
-  "basePath": "/v1",
-  "paths": {
-    "/bundlerAccount/{userAddress}": {
-      "post": {
-        "description": "Returns the bundler account for a given user.\n",
-        "consumes": [
-          "application/json"
-        ],
+    "/listBundles/{bucketName}": {
+      "get": {
+        "description": "Lists the bundles of a given bucket ordered by creation, optionally filtered by status, owner, creation time and name prefix. Pass the nextCursor of a page as the cursor to get the next page.\n",
         "produces": [
-          "application/json"
+          "application/octet-stream"
         ],
         "tags": [
           "Bundle"
         ],
-        "summary": "Get Bundler Account for a User",
-        "operationId": "bundlerAccount",
+        "summary": "List bundles of a bucket",
+        "operationId": "listBundles",
         "parameters": [
           {
             "type": "string",
-            "description": "The address of the user",
-            "name": "userAddress",
+            "description": "The bucketName of the bundles",
+            "name": "bucketName",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "integer",
+            "description": "Only list the bundles with the status",
+            "name": "status",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only list the bundles owned by the address",
+            "name": "owner",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Only list the bundles created at or after the unix timestamp",
+            "name": "createdAfter",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Only list the bundles created before the unix timestamp",
+            "name": "createdBefore",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only list the bundles whose name starts with the prefix",
+            "name": "namePrefix",
+            "in": "query"
+          },
+          {
+            "maximum": 100,
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "default": 20,
+            "description": "The maximum number of bundles to return",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The cursor returned by the previous page",
+            "name": "cursor",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Digital signature of an account which can get objects in the bucket, required to query a private bucket",
+            "name": "Authorization",
+            "in": "header"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Expiry timestamp of the signed request",
+            "name": "X-Bundle-Expiry-Timestamp",
+            "in": "header"
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully retrieved bundler account",
+            "description": "Successfully listed bundles",
             "schema": {
-              "$ref": "#/definitions/BundlerAccount"
+              "$ref": "#/definitions/ListBundlesResponse"
             }
           },
           "400": {
@@ -875,6 +1046,12 @@ func init() {
               "$ref": "#/definitions/Error"
             }
           },
+          "403": {
+            "description": "The bucket is private and the request is not signed by an account which can get objects in it",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
           "500": {
             "description": "Internal server error",
             "schema": {
@@ -884,54 +1061,91 @@ func init() {
         }
       }
     },
-    "/createBundle": {
-      "post": {
-        "description": "Initiates a new bundle, requiring details like bucket name and bundle name.\n",
-        "consumes": [
-          "application/json"
-        ],
+    "/listObjects/{bucketName}": {
+      "get": {
+        "description": "Lists the objects in the bundles of a given bucket ordered by creation, optionally filtered by bundle name, object name prefix, content type and tag. Pass the nextCursor of a page as the cursor to get the next page.\n",
         "produces": [
-          "application/json"
+          "application/octet-stream"
         ],
         "tags": [
           "Bundle"
         ],
-        "summary": "Start a New Bundle",
-        "operationId": "createBundle",
+        "summary": "List objects of a bucket",
+        "operationId": "listObjects",
         "parameters": [
           {
             "type": "string",
-            "description": "User's digital signature for authorization",
-            "name": "Authorization",
-            "in": "header",
+            "description": "The bucketName of the objects",
+            "name": "bucketName",
+            "in": "path",
             "required": true
           },
           {
             "type": "string",
-            "description": "The name of the bucket",
-            "name": "X-Bundle-Bucket-Name",
-            "in": "header",
-            "required": true
+            "description": "Only list the objects in the bundle",
+            "name": "bundleName",
+            "in": "query"
           },
           {
             "type": "string",
-            "description": "The name of the bundle to be created",
-            "name": "X-Bundle-Name",
-            "in": "header",
-            "required": true
+            "description": "Only list the objects whose name starts with the prefix",
+            "name": "namePrefix",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only list the objects with the content type",
+            "name": "contentType",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only list the objects with the tag",
+            "name": "tagKey",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only list the objects whose tag given by tagKey has the value",
+            "name": "tagValue",
+            "in": "query"
           },
           {
+            "maximum": 100,
+            "minimum": 1,
             "type": "integer",
             "format": "int64",
-            "description": "Expiry timestamp of the request",
+            "default": 20,
+            "description": "The maximum number of objects to return",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The cursor returned by the previous page",
+            "name": "cursor",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Digital signature of an account which can get objects in the bucket, required to query a private bucket",
+            "name": "Authorization",
+            "in": "header"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Expiry timestamp of the signed request",
             "name": "X-Bundle-Expiry-Timestamp",
-            "in": "header",
-            "required": true
+            "in": "header"
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully managed bundle"
+            "description": "Successfully listed objects",
+            "schema": {
+              "$ref": "#/definitions/ListObjectsResponse"
+            }
           },
           "400": {
             "description": "Invalid request or parameters",
@@ -939,6 +1153,12 @@ func init() {
               "$ref": "#/definitions/Error"
             }
           },
+          "403": {
+            "description": "The bucket is private and the request is not signed by an account which can get objects in it",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
           "500": {
             "description": "Internal server error",
             "schema": {
@@ -948,9 +1168,9 @@ func init() {
         }
       }
     },
-    "/deleteBundle": {
+    "/presign": {
       "post": {
-        "description": "Delete an bundle after object deletion on Greenfield\n",
+        "description": "Returns time-limited urls to view and download an object of a bucket, which can be read without a signature until they expire. The request is signed by an account which can get objects in the bucket.\n",
         "consumes": [
           "application/json"
         ],
@@ -960,8 +1180,8 @@ func init() {
         "tags": [
           "Bundle"
         ],
-        "summary": "Delete an bundle after object deletion on Greenfield",
-        "operationId": "deleteBundle",
+        "summary": "Presign the urls of an object",
+        "operationId": "presign",
         "parameters": [
           {
             "type": "string",
@@ -970,6 +1190,14 @@ func init() {
             "in": "header",
             "required": true
           },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Expiry timestamp of the request",
+            "name": "X-Bundle-Expiry-Timestamp",
+            "in": "header",
+            "required": true
+          },
           {
             "type": "string",
             "description": "The name of the bucket",
@@ -979,23 +1207,32 @@ func init() {
           },
           {
             "type": "string",
-            "description": "The name of the bundle to be finalized",
-            "name": "X-Bundle-Name",
+            "description": "The name of the object",
+            "name": "X-Bundle-File-Name",
             "in": "header",
             "required": true
           },
+          {
+            "type": "string",
+            "description": "The name of the bundle of the object, the latest object with the name in the bucket is read if it is not set",
+            "name": "X-Bundle-Name",
+            "in": "header"
+          },
           {
             "type": "integer",
             "format": "int64",
-            "description": "Expiry timestamp of the request",
-            "name": "X-Bundle-Expiry-Timestamp",
+            "description": "The timestamp when the presigned urls expire, at most 7 days ahead",
+            "name": "X-Bundle-Presign-Expiry",
             "in": "header",
             "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully deleted bundle"
+            "description": "Successfully presigned the urls",
+            "schema": {
+              "$ref": "#/definitions/PresignResponse"
+            }
           },
           "400": {
             "description": "Invalid request or parameters",
@@ -1003,6 +1240,12 @@ func init() {
               "$ref": "#/definitions/Error"
             }
           },
+          "403": {
+            "description": "The signer can not get objects in the bucket",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
           "500": {
             "description": "Internal server error",
             "schema": {
@@ -1012,17 +1255,17 @@ func init() {
         }
       }
     },
-    "/download/{bucketName}/{bundleName}/{objectName}": {
+    "/queryBundle/{bucketName}/{bundleName}": {
       "get": {
-        "description": "Download a specific object from a given bundle and returns it as a file.\n",
+        "description": "Queries the bundle information of a given bundle.\n",
         "produces": [
           "application/octet-stream"
         ],
         "tags": [
           "Bundle"
         ],
-        "summary": "Download an object as a file from a bundle",
-        "operationId": "downloadBundleObject",
+        "summary": "Query bundle information",
+        "operationId": "queryBundle",
         "parameters": [
           {
             "type": "string",
@@ -1040,17 +1283,23 @@ func init() {
           },
           {
             "type": "string",
-            "description": "The name of the object within the bundle",
-            "name": "objectName",
-            "in": "path",
-            "required": true
+            "description": "Digital signature of an account which can get objects in the bucket, required to query a private bucket",
+            "name": "Authorization",
+            "in": "header"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Expiry timestamp of the signed request",
+            "name": "X-Bundle-Expiry-Timestamp",
+            "in": "header"
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully retrieved file",
+            "description": "Successfully queried bundle",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/QueryBundleResponse"
             }
           },
           "400": {
@@ -1059,8 +1308,14 @@ func init() {
               "$ref": "#/definitions/Error"
             }
           },
+          "403": {
+            "description": "The bucket is private and the request is not signed by an account which can get objects in it",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
           "404": {
-            "description": "Bundle or object not found",
+            "description": "Bundle not found",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -1074,40 +1329,30 @@ func init() {
         }
       }
     },
-    "/finalizeBundle": {
-      "post": {
-        "description": "Completes the lifecycle of an existing bundle, requiring the bundle name for authorization.\n",
-        "consumes": [
-          "application/json"
-        ],
+    "/queryBundleUpload/{uploadId}": {
+      "get": {
+        "description": "Queries the status and the uploaded chunks of a resumable bundle upload, so an interrupted upload can be resumed from the missing chunks.\n",
         "produces": [
           "application/json"
         ],
         "tags": [
           "Bundle"
         ],
-        "summary": "Finalize an Existing Bundle",
-        "operationId": "finalizeBundle",
+        "summary": "Query a resumable bundle upload",
+        "operationId": "queryBundleUpload",
         "parameters": [
           {
             "type": "string",
-            "description": "User's digital signature for authorization",
+            "description": "User's digital signature for authentication",
             "name": "Authorization",
             "in": "header",
             "required": true
           },
           {
             "type": "string",
-            "description": "The name of the bucket",
-            "name": "X-Bundle-Bucket-Name",
-            "in": "header",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "The name of the bundle to be finalized",
-            "name": "X-Bundle-Name",
-            "in": "header",
+            "description": "The id of the bundle upload",
+            "name": "uploadId",
+            "in": "path",
             "required": true
           },
           {
@@ -1121,10 +1366,19 @@ func init() {
         ],
         "responses": {
           "200": {
-            "description": "Successfully managed bundle"
+            "description": "Successfully queried the bundle upload",
+            "schema": {
+              "$ref": "#/definitions/BundleUploadInfo"
+            }
           },
           "400": {
-            "description": "Invalid request or parameters",
+            "description": "Invalid request or file format",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Bundle upload not found",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -1138,17 +1392,17 @@ func init() {
         }
       }
     },
-    "/queryBundle/{bucketName}/{bundleName}": {
+    "/queryBundlingBundle/{bucketName}": {
       "get": {
-        "description": "Queries the bundle information of a given bundle.\n",
+        "description": "Queries the bundling bundle information of a given bucket.\n",
         "produces": [
           "application/octet-stream"
         ],
         "tags": [
           "Bundle"
         ],
-        "summary": "Query bundle information",
-        "operationId": "queryBundle",
+        "summary": "Query bundling bundle information of a bucket",
+        "operationId": "queryBundlingBundle",
         "parameters": [
           {
             "type": "string",
@@ -1159,11 +1413,17 @@ func init() {
           },
           {
             "type": "string",
-            "description": "The name of the bundle",
-            "name": "bundleName",
-            "in": "path",
-            "required": true
-          }
+            "description": "Digital signature of an account which can get objects in the bucket, required to query a private bucket",
+            "name": "Authorization",
+            "in": "header"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Expiry timestamp of the signed request",
+            "name": "X-Bundle-Expiry-Timestamp",
+            "in": "header"
+          }
         ],
         "responses": {
           "200": {
@@ -1173,7 +1433,13 @@ func init() {
             }
           },
           "400": {
-            "description": "Invalid request or file format",
+            "description": "Invalid request or signature",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "The bucket is private and the request is not signed by an account which can get objects in it",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -1193,35 +1459,56 @@ func init() {
         }
       }
     },
-    "/queryBundlingBundle/{bucketName}": {
-      "get": {
-        "description": "Queries the bundling bundle information of a given bucket.\n",
+    "/revokeSessionKey": {
+      "post": {
+        "description": "Revoke a session key of the signer, the requests signed by the session key are rejected afterwards\n",
+        "consumes": [
+          "application/json"
+        ],
         "produces": [
-          "application/octet-stream"
+          "application/json"
         ],
         "tags": [
           "Bundle"
         ],
-        "summary": "Query bundling bundle information of a bucket",
-        "operationId": "queryBundlingBundle",
+        "summary": "Revoke a session key",
+        "operationId": "revokeSessionKey",
         "parameters": [
           {
             "type": "string",
-            "description": "The bucketName of the bundle",
-            "name": "bucketName",
-            "in": "path",
+            "description": "User's digital signature for authorization",
+            "name": "Authorization",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Expiry timestamp of the request",
+            "name": "X-Bundle-Expiry-Timestamp",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The address of the session key",
+            "name": "X-Bundle-Session-Key-Address",
+            "in": "header",
             "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully queried bundle",
+            "description": "Successfully revoked the session key"
+          },
+          "400": {
+            "description": "Invalid request or parameters",
             "schema": {
-              "$ref": "#/definitions/QueryBundleResponse"
+              "$ref": "#/definitions/Error"
             }
           },
           "404": {
-            "description": "Bundle not found",
+            "description": "The session key is not registered by the signer",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -1316,6 +1603,89 @@ func init() {
         }
       }
     },
+    "/unbundleObject": {
+      "post": {
+        "description": "Creates the job which copies an object out of its bundle sealed on Greenfield into a standalone object with the same name in the bucket, which is created by the bundler account of the bundle. Once the job is completed, the object is marked as migrated and the view and download endpoints redirect to the standalone object on the primary storage provider of the bucket. Requesting an object again returns its job, a failed job is restarted.\n",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "Bundle"
+        ],
+        "summary": "Promote an object of a sealed bundle to a standalone object",
+        "operationId": "unbundleObject",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "User's digital signature for authentication",
+            "name": "Authorization",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The name of the bucket",
+            "name": "X-Bundle-Bucket-Name",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The name of the bundle of the object",
+            "name": "X-Bundle-Name",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The name of the object to be unbundled",
+            "name": "X-Bundle-File-Name",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Expiry timestamp of the request",
+            "name": "X-Bundle-Expiry-Timestamp",
+            "in": "header",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The unbundle job of the object",
+            "schema": {
+              "$ref": "#/definitions/UnbundleJobInfo"
+            }
+          },
+          "400": {
+            "description": "Invalid request or parameters",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Object or bundle not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "An object with the name already exists on Greenfield",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
     "/uploadBundle": {
       "post": {
         "description": "Uploads a bundle of objects, requiring details like bucket name, bundle name, and etc.\n",
@@ -1359,6 +1729,13 @@ func init() {
             "in": "header",
             "required": true
           },
+          {
+            "type": "string",
+            "description": "SHA256 hash of the content of the bundle file, which is verified while the bundle file is received",
+            "name": "X-Bundle-Content-Sha256",
+            "in": "header",
+            "required": true
+          },
           {
             "type": "integer",
             "format": "int64",
@@ -1397,9 +1774,100 @@ func init() {
         }
       }
     },
+    "/uploadBundleChunk/{uploadId}/{chunkNumber}": {
+      "put": {
+        "description": "Uploads the chunk with the number of a resumable bundle upload, chunks are numbered from 1 and every chunk but the last one has the chunk size of the upload. Uploading a chunk again replaces it.\n",
+        "consumes": [
+          "multipart/form-data"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "Bundle"
+        ],
+        "summary": "Upload a chunk of a resumable bundle upload",
+        "operationId": "uploadBundleChunk",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "User's digital signature for authentication",
+            "name": "Authorization",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The id of the bundle upload",
+            "name": "uploadId",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "The number of the chunk, starting from 1",
+            "name": "chunkNumber",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "SHA256 hash of the chunk",
+            "name": "X-Bundle-Chunk-Sha256",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Expiry timestamp of the request",
+            "name": "X-Bundle-Expiry-Timestamp",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "file",
+            "description": "The chunk to be uploaded",
+            "name": "chunk",
+            "in": "formData",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully uploaded the chunk"
+          },
+          "400": {
+            "description": "Invalid request or file format",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Bundle upload not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "The bundle upload is not uploading anymore",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
     "/uploadObject": {
       "post": {
-        "description": "Uploads a single object to a bundle, requiring details like bucket name, file name, and etc.\n",
+        "description": "Uploads a single object to a bundle, requiring details like bucket name, file name, and etc. An object with the same name in the bundling bundle is rejected, unless X-Bundle-Overwrite is true, which replaces it.\n",
         "consumes": [
           "multipart/form-data"
         ],
@@ -1453,6 +1921,12 @@ func init() {
             "name": "X-Bundle-Tags",
             "in": "header"
           },
+          {
+            "type": "boolean",
+            "description": "Replace the object with the same name in the bundling bundle of the bucket",
+            "name": "X-Bundle-Overwrite",
+            "in": "header"
+          },
           {
             "type": "integer",
             "format": "int64",
@@ -1482,6 +1956,12 @@ func init() {
               "$ref": "#/definitions/Error"
             }
           },
+          "409": {
+            "description": "The bundling bundle changed during the upload, the request can be retried",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
           "500": {
             "description": "Internal server error",
             "schema": {
@@ -1491,45 +1971,70 @@ func init() {
         }
       }
     },
-    "/view/{bucketName}/{bundleName}/{objectName}": {
-      "get": {
-        "description": "Fetches a specific object from a given bundle and returns it as a file.\n",
+    "/uploadObjects": {
+      "post": {
+        "description": "Uploads several objects to the bundling bundle of a bucket under a single signature. The manifest lists the name, SHA256 hash, content type and tags of every object in the order of the files, and its SHA256 hash is signed in the X-Bundle-Manifest-Sha256 header. The objects are validated and created atomically, if they exceed the max files or size of an auto generated bundling bundle, the bundle is finalized and the rest of the objects are uploaded to a new auto generated bundle.\n",
+        "consumes": [
+          "multipart/form-data"
+        ],
         "produces": [
-          "application/octet-stream"
+          "application/json"
         ],
         "tags": [
           "Bundle"
         ],
-        "summary": "Retrieve an object as a file from a bundle",
-        "operationId": "viewBundleObject",
+        "summary": "Upload several objects to the bundling bundle",
+        "operationId": "uploadObjects",
         "parameters": [
           {
             "type": "string",
-            "description": "The bucketName of the bundle",
-            "name": "bucketName",
-            "in": "path",
+            "description": "User's digital signature for authentication",
+            "name": "Authorization",
+            "in": "header",
             "required": true
           },
           {
             "type": "string",
-            "description": "The name of the bundle",
-            "name": "bundleName",
-            "in": "path",
+            "description": "The name of the bucket",
+            "name": "X-Bundle-Bucket-Name",
+            "in": "header",
             "required": true
           },
           {
             "type": "string",
-            "description": "The name of the object within the bundle",
-            "name": "objectName",
-            "in": "path",
+            "description": "SHA256 hash of the manifest",
+            "name": "X-Bundle-Manifest-Sha256",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Expiry timestamp of the request",
+            "name": "X-Bundle-Expiry-Timestamp",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "file",
+            "description": "JSON array of the objects to be uploaded, every object has a name, sha256, contentType and optional tags\n",
+            "name": "manifest",
+            "in": "formData",
+            "required": true
+          },
+          {
+            "type": "file",
+            "description": "The files to be uploaded, one part named files per object in the order of the manifest",
+            "name": "files",
+            "in": "formData",
             "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully retrieved file",
+            "description": "Successfully uploaded files",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/UploadObjectsResponse"
             }
           },
           "400": {
@@ -1538,23 +2043,3140 @@ func init() {
               "$ref": "#/definitions/Error"
             }
           },
-          "404": {
+          "409": {
+            "description": "The bundling bundle changed during the upload, the upload can be retried",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/view/{bucketName}/{bundleName}/{objectName}": {
+      "get": {
+        "description": "Fetches a specific object from a given bundle and returns it as a file. Single byte range requests (Range, If-Range) and conditional requests (If-None-Match, If-Modified-Since) are supported using the ETag and Last-Modified of the object.\n",
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "Bundle"
+        ],
+        "summary": "Retrieve an object as a file from a bundle",
+        "operationId": "viewBundleObject",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The bucketName of the bundle",
+            "name": "bucketName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The name of the bundle",
+            "name": "bundleName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The name of the object within the bundle",
+            "name": "objectName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Digital signature of an account which can get objects in the bucket, required to read a private bucket without a presigned url",
+            "name": "Authorization",
+            "in": "header"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Expiry timestamp of the signed request",
+            "name": "X-Bundle-Expiry-Timestamp",
+            "in": "header"
+          },
+          {
+            "type": "string",
+            "description": "The account which requested the presigned url",
+            "name": "presignSigner",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The bundle of the object of the presigned url, the latest object with the name is read if it is empty",
+            "name": "presignBundleName",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "The expiry timestamp of the presigned url",
+            "name": "presignExpiry",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The signature of the presigned url",
+            "name": "presignSignature",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully retrieved file",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "206": {
+            "description": "Successfully retrieved the requested range of the file",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "304": {
+            "description": "The file is not modified since the version in the conditional request headers"
+          },
+          "400": {
+            "description": "Invalid request or file format",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "The bucket is private and the request is not signed by an account which can get objects in it",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Bundle or object not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "416": {
+            "description": "The requested range is not satisfiable"
+          },
+          "500": {
+            "description": "Internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/view/{bucketName}/{objectName}": {
+      "get": {
+        "description": "Fetches an object of a given bucket by its name without the name of its bundle and returns it as a file. If several bundles of the bucket contain an object with the name, the latest uploaded object is returned. Single byte range requests (Range, If-Range) and conditional requests (If-None-Match, If-Modified-Since) are supported using the ETag and Last-Modified of the object.\n",
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "Bundle"
+        ],
+        "summary": "Retrieve an object as a file by its name",
+        "operationId": "viewObject",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The bucketName of the object",
+            "name": "bucketName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The name of the object",
+            "name": "objectName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Digital signature of an account which can get objects in the bucket, required to read a private bucket without a presigned url",
+            "name": "Authorization",
+            "in": "header"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Expiry timestamp of the signed request",
+            "name": "X-Bundle-Expiry-Timestamp",
+            "in": "header"
+          },
+          {
+            "type": "string",
+            "description": "The account which requested the presigned url",
+            "name": "presignSigner",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The bundle of the object of the presigned url, the latest object with the name is read if it is empty",
+            "name": "presignBundleName",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "The expiry timestamp of the presigned url",
+            "name": "presignExpiry",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The signature of the presigned url",
+            "name": "presignSignature",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully retrieved file",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "206": {
+            "description": "Successfully retrieved the requested range of the file",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "304": {
+            "description": "The file is not modified since the version in the conditional request headers"
+          },
+          "400": {
+            "description": "Invalid request or file format",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "The bucket is private and the request is not signed by an account which can get objects in it",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Object not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "416": {
+            "description": "The requested range is not satisfiable"
+          },
+          "500": {
+            "description": "Internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    }
+  },
+  "definitions": {
+    "BundleInfo": {
+      "type": "object",
+      "properties": {
+        "bucketName": {
+          "description": "The name of the bucket of the bundle",
+          "type": "string",
+          "x-omitempty": false
+        },
+        "bundleName": {
+          "description": "The name of the bundle",
+          "type": "string",
+          "x-omitempty": false
+        },
+        "createdTimestamp": {
+          "description": "The creation timestamp of the bundle",
+          "type": "integer",
+          "x-omitempty": false
+        },
+        "errorMessage": {
+          "description": "The error message of the bundle",
+          "type": "string",
+          "x-omitempty": false
+        },
+        "files": {
+          "description": "The number of files in the bundle",
+          "type": "integer",
+          "x-omitempty": false
+        },
+        "objectId": {
+          "description": "The id of the bundle object on Greenfield",
+          "type": "string",
+          "x-omitempty": false
+        },
+        "size": {
+          "description": "The size of the bundle",
+          "type": "integer",
+          "x-omitempty": false
+        },
+        "status": {
+          "description": "The status of the bundle",
+          "type": "integer",
+          "x-omitempty": false
+        },
+        "txHash": {
+          "description": "The hash of the transaction creating the bundle object on Greenfield",
+          "type": "string",
+          "x-omitempty": false
+        }
+      }
+    },
+    "BundleUploadChunkInfo": {
+      "type": "object",
+      "properties": {
+        "number": {
+          "description": "The number of the chunk",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "sha256": {
+          "description": "The SHA256 hash of the chunk",
+          "type": "string",
+          "x-omitempty": false
+        },
+        "size": {
+          "description": "The size of the chunk",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        }
+      }
+    },
+    "BundleUploadInfo": {
+      "type": "object",
+      "properties": {
+        "bucketName": {
+          "description": "The name of the bucket",
+          "type": "string",
+          "x-omitempty": false
+        },
+        "bundleName": {
+          "description": "The name of the bundle",
+          "type": "string",
+          "x-omitempty": false
+        },
+        "chunkSize": {
+          "description": "The size of every chunk but the last one",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "chunks": {
+          "description": "The number of chunks of the bundle file",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "expireTimestamp": {
+          "description": "The time the upload is garbage collected if it is not updated until then",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "size": {
+          "description": "The size of the bundle file",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "status": {
+          "description": "The status of the upload, one of uploading, completing and completed",
+          "type": "string",
+          "x-omitempty": false
+        },
+        "uploadId": {
+          "description": "The id of the bundle upload",
+          "type": "string",
+          "x-omitempty": false
+        },
+        "uploadedChunks": {
+          "description": "The uploaded chunks ordered by number",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/BundleUploadChunkInfo"
+          },
+          "x-omitempty": false
+        }
+      }
+    },
+    "BundlerAccount": {
+      "type": "object",
+      "properties": {
+        "address": {
+          "description": "The address of the bundler",
+          "type": "string",
+          "x-omitempty": false
+        }
+      }
+    },
+    "Error": {
+      "type": "object",
+      "properties": {
+        "code": {
+          "description": "HTTP error code",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false,
+          "example": "400/500"
+        },
+        "message": {
+          "description": "Error message",
+          "type": "string",
+          "x-omitempty": false,
+          "example": "Bad request/Internal server error"
+        }
+      }
+    },
+    "ListBundlesResponse": {
+      "type": "object",
+      "properties": {
+        "bundles": {
+          "description": "The bundles of the page",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/BundleInfo"
+          },
+          "x-omitempty": false
+        },
+        "nextCursor": {
+          "description": "The cursor of the next page, empty if there are no more bundles",
+          "type": "string",
+          "x-omitempty": false
+        }
+      }
+    },
+    "ListObjectsResponse": {
+      "type": "object",
+      "properties": {
+        "nextCursor": {
+          "description": "The cursor of the next page, empty if there are no more objects",
+          "type": "string",
+          "x-omitempty": false
+        },
+        "objects": {
+          "description": "The objects of the page",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ObjectInfo"
+          },
+          "x-omitempty": false
+        }
+      }
+    },
+    "ObjectInfo": {
+      "type": "object",
+      "properties": {
+        "bucketName": {
+          "description": "The name of the bucket of the object",
+          "type": "string",
+          "x-omitempty": false
+        },
+        "bundleName": {
+          "description": "The name of the bundle of the object",
+          "type": "string",
+          "x-omitempty": false
+        },
+        "contentType": {
+          "description": "The content type of the object",
+          "type": "string",
+          "x-omitempty": false
+        },
+        "createdTimestamp": {
+          "description": "The creation timestamp of the object",
+          "type": "integer",
+          "x-omitempty": false
+        },
+        "hash": {
+          "description": "The hex encoded hash of the object, empty if the hash is not known",
+          "type": "string",
+          "x-omitempty": false
+        },
+        "hashAlgo": {
+          "description": "The hash algorithm of the hash of the object, e.g. SHA256, Unknown if the hash is not known",
+          "type": "string",
+          "x-omitempty": false
+        },
+        "migrated": {
+          "description": "Whether the object is unbundled to a standalone object on Greenfield, which is served instead",
+          "type": "boolean",
+          "x-omitempty": false
+        },
+        "objectName": {
+          "description": "The name of the object",
+          "type": "string",
+          "x-omitempty": false
+        },
+        "offset": {
+          "description": "The offset of the object in the bundle",
+          "type": "integer",
+          "x-omitempty": false
+        },
+        "owner": {
+          "description": "The owner of the object, which is the owner of the bucket",
+          "type": "string",
+          "x-omitempty": false
+        },
+        "size": {
+          "description": "The size of the object",
+          "type": "integer",
+          "x-omitempty": false
+        },
+        "tags": {
+          "description": "The tags of the object",
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          },
+          "x-omitempty": false
+        },
+        "uploader": {
+          "description": "The signer who uploaded the object, the owner of the bucket or a delegate granted to create objects in the bucket, empty for the imported objects",
+          "type": "string",
+          "x-omitempty": false
+        }
+      }
+    },
+    "PresignResponse": {
+      "type": "object",
+      "properties": {
+        "downloadUrl": {
+          "description": "The presigned url to download the object, relative to the server",
+          "type": "string",
+          "x-omitempty": false
+        },
+        "expiryTimestamp": {
+          "description": "The timestamp when the presigned urls expire",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "viewUrl": {
+          "description": "The presigned url to view the object, relative to the server",
+          "type": "string",
+          "x-omitempty": false
+        }
+      }
+    },
+    "QueryBundleResponse": {
+      "type": "object",
+      "properties": {
+        "bucketName": {
+          "description": "The name of the bucket where the file has been uploaded",
+          "type": "string",
+          "x-omitempty": false
+        },
+        "bundleName": {
+          "description": "The name of the bundle where the file has been uploaded",
+          "type": "string",
+          "x-omitempty": false
+        },
+        "createdTimestamp": {
+          "description": "The creation timestamp of the bundle",
+          "type": "integer",
+          "x-omitempty": false
+        },
+        "errorMessage": {
+          "description": "The error message of the object",
+          "type": "string",
+          "x-omitempty": false
+        },
+        "files": {
+          "description": "The number of files in the bundle",
+          "type": "integer",
+          "x-omitempty": false
+        },
+        "size": {
+          "description": "The size of the bundle",
+          "type": "integer",
+          "x-omitempty": false
+        },
+        "status": {
+          "description": "The status of the object",
+          "type": "integer",
+          "x-omitempty": false
+        }
+      }
+    },
+    "SessionKeyInfo": {
+      "type": "object",
+      "properties": {
+        "buckets": {
+          "description": "The buckets which the session key can access",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": false
+        },
+        "createdTimestamp": {
+          "description": "The creation timestamp of the session key",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "expiryTimestamp": {
+          "description": "The timestamp when the session key expires",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "maxRequests": {
+          "description": "The max number of requests signed by the session key, unlimited if it is 0",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "maxUploadSize": {
+          "description": "The max total size of the requests signed by the session key, unlimited if it is 0",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "owner": {
+          "description": "The owner who registered the session key",
+          "type": "string",
+          "x-omitempty": false
+        },
+        "revoked": {
+          "description": "Whether the session key is revoked",
+          "type": "boolean",
+          "x-omitempty": false
+        },
+        "scopes": {
+          "description": "The scopes of the operations which the session key can perform",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": false
+        },
+        "sessionKey": {
+          "description": "The address of the session key",
+          "type": "string",
+          "x-omitempty": false
+        },
+        "usedRequests": {
+          "description": "The number of requests signed by the session key",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "usedUploadSize": {
+          "description": "The total size of the requests signed by the session key",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        }
+      }
+    },
+    "UnbundleJobInfo": {
+      "type": "object",
+      "properties": {
+        "bucketName": {
+          "description": "The name of the bucket",
+          "type": "string",
+          "x-omitempty": false
+        },
+        "bundleName": {
+          "description": "The name of the bundle of the object when the job is created",
+          "type": "string",
+          "x-omitempty": false
+        },
+        "createdTimestamp": {
+          "description": "The creation timestamp of the job",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "errMessage": {
+          "description": "The error of the last attempt of the job",
+          "type": "string",
+          "x-omitempty": false
+        },
+        "objectId": {
+          "description": "The id of the standalone object on Greenfield, 0 until it is created",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "objectName": {
+          "description": "The name of the object",
+          "type": "string",
+          "x-omitempty": false
+        },
+        "status": {
+          "description": "The status of the job, one of pending, created_on_chain, completed and failed",
+          "type": "string",
+          "x-omitempty": false
+        },
+        "updatedTimestamp": {
+          "description": "The timestamp of the last update of the job",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        }
+      }
+    },
+    "UploadObjectResponse": {
+      "type": "object",
+      "properties": {
+        "bundleName": {
+          "description": "The name of the bundle where the file has been uploaded",
+          "type": "string",
+          "x-omitempty": false
+        }
+      }
+    },
+    "UploadObjectsResponse": {
+      "type": "object",
+      "properties": {
+        "objects": {
+          "description": "The uploaded objects in the order of the manifest",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/UploadedObject"
+          },
+          "x-omitempty": false
+        }
+      }
+    },
+    "UploadedObject": {
+      "type": "object",
+      "properties": {
+        "bundleName": {
+          "description": "The name of the bundle where the object has been uploaded",
+          "type": "string",
+          "x-omitempty": false
+        },
+        "objectName": {
+          "description": "The name of the object",
+          "type": "string",
+          "x-omitempty": false
+        }
+      }
+    }
+  }
+}`))
+	FlatSwaggerJSON = json.RawMessage([]byte(`{
+  "schemes": [
+    "http"
+  ],
+  "swagger": "2.0",
+  "info": {
+    "description": "API for handling file bundling and querying objects in the Bundle Service.",
+    "title": "Bundle Service API",
+    "version": "1.0.0"
+  },
+  "host": "gnfd-testnet-bundle.nodereal.io",
+  "basePath": "/v1",
+  "paths": {
+    "/bundlerAccount/{userAddress}": {
+      "post": {
+        "description": "Returns the bundler account for a given user.\n",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "Bundle"
+        ],
+        "summary": "Get Bundler Account for a User",
+        "operationId": "bundlerAccount",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The address of the user",
+            "name": "userAddress",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully retrieved bundler account",
+            "schema": {
+              "$ref": "#/definitions/BundlerAccount"
+            }
+          },
+          "400": {
+            "description": "Invalid request or parameters",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/completeBundleUpload/{uploadId}": {
+      "post": {
+        "description": "Assembles the chunks of a resumable bundle upload and creates the bundle from the bundle file like uploadBundle does. If the bundle file is invalid, the upload stays open, so chunks can be uploaded again. Completing a completed upload again succeeds.\n",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "Bundle"
+        ],
+        "summary": "Complete a resumable bundle upload",
+        "operationId": "completeBundleUpload",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "User's digital signature for authentication",
+            "name": "Authorization",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The id of the bundle upload",
+            "name": "uploadId",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Expiry timestamp of the request",
+            "name": "X-Bundle-Expiry-Timestamp",
+            "in": "header",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully completed the bundle upload",
+            "schema": {
+              "$ref": "#/definitions/BundleUploadInfo"
+            }
+          },
+          "400": {
+            "description": "Invalid request or file format",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Bundle upload not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "The bundle upload is not uploading anymore",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/createBundle": {
+      "post": {
+        "description": "Initiates a new bundle, requiring details like bucket name and bundle name.\n",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "Bundle"
+        ],
+        "summary": "Start a New Bundle",
+        "operationId": "createBundle",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "User's digital signature for authorization",
+            "name": "Authorization",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The name of the bucket",
+            "name": "X-Bundle-Bucket-Name",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The name of the bundle to be created",
+            "name": "X-Bundle-Name",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Expiry timestamp of the request",
+            "name": "X-Bundle-Expiry-Timestamp",
+            "in": "header",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully managed bundle"
+          },
+          "400": {
+            "description": "Invalid request or parameters",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/createSessionKey": {
+      "post": {
+        "description": "Register an ephemeral key which signs the requests of the signer in place of the signer's key, e.g. for batch jobs. The session key is scoped to buckets owned by the signer and to scopes of operations, and it can be used until it expires or is revoked, within its quotas.\n",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "Bundle"
+        ],
+        "summary": "Register a session key",
+        "operationId": "createSessionKey",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "User's digital signature for authorization",
+            "name": "Authorization",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Expiry timestamp of the request",
+            "name": "X-Bundle-Expiry-Timestamp",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The address of the session key",
+            "name": "X-Bundle-Session-Key-Address",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The buckets which the session key can access, separated by commas, the buckets should be owned by the signer",
+            "name": "X-Bundle-Session-Key-Buckets",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The scopes of the operations which the session key can perform, separated by commas, one of upload, finalize and rules",
+            "name": "X-Bundle-Session-Key-Scopes",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "The timestamp when the session key expires, at most 30 days ahead",
+            "name": "X-Bundle-Session-Key-Expiry",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "The max number of requests signed by the session key, unlimited if it is 0",
+            "name": "X-Bundle-Session-Key-Max-Requests",
+            "in": "header"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "The max total size of the requests signed by the session key, unlimited if it is 0",
+            "name": "X-Bundle-Session-Key-Max-Upload-Size",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully registered the session key",
+            "schema": {
+              "$ref": "#/definitions/SessionKeyInfo"
+            }
+          },
+          "400": {
+            "description": "Invalid request or parameters",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "The session key is already registered",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/deleteBundle": {
+      "post": {
+        "description": "Delete an bundle after object deletion on Greenfield\n",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "Bundle"
+        ],
+        "summary": "Delete an bundle after object deletion on Greenfield",
+        "operationId": "deleteBundle",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "User's digital signature for authorization",
+            "name": "Authorization",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The name of the bucket",
+            "name": "X-Bundle-Bucket-Name",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The name of the bundle to be finalized",
+            "name": "X-Bundle-Name",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Expiry timestamp of the request",
+            "name": "X-Bundle-Expiry-Timestamp",
+            "in": "header",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully deleted bundle"
+          },
+          "400": {
+            "description": "Invalid request or parameters",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/deleteObject": {
+      "post": {
+        "description": "Deletes an object from the bundling bundle of a bucket before the bundle is finalized. If X-Bundle-Name names a bundle sealed on Greenfield, the object is tombstoned instead, it is hidden from reads and listings right away and its data is removed when the bundler compacts the bundle. Objects of finalized bundles which are not sealed yet can not be deleted.\n",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "Bundle"
+        ],
+        "summary": "Delete an object from the bundling bundle or a sealed bundle",
+        "operationId": "deleteObject",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "User's digital signature for authentication",
+            "name": "Authorization",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The name of the bucket",
+            "name": "X-Bundle-Bucket-Name",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The name of the object to be deleted",
+            "name": "X-Bundle-File-Name",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The name of the bundle of the object, the bundling bundle of the bucket if not set",
+            "name": "X-Bundle-Name",
+            "in": "header"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Expiry timestamp of the request",
+            "name": "X-Bundle-Expiry-Timestamp",
+            "in": "header",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully deleted object"
+          },
+          "400": {
+            "description": "Invalid request or parameters",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Object or bundle not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/download/{bucketName}/{bundleName}/{objectName}": {
+      "get": {
+        "description": "Download a specific object from a given bundle and returns it as a file. Single byte range requests (Range, If-Range) and conditional requests (If-None-Match, If-Modified-Since) are supported using the ETag and Last-Modified of the object.\n",
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "Bundle"
+        ],
+        "summary": "Download an object as a file from a bundle",
+        "operationId": "downloadBundleObject",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The bucketName of the bundle",
+            "name": "bucketName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The name of the bundle",
+            "name": "bundleName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The name of the object within the bundle",
+            "name": "objectName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Digital signature of an account which can get objects in the bucket, required to read a private bucket without a presigned url",
+            "name": "Authorization",
+            "in": "header"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Expiry timestamp of the signed request",
+            "name": "X-Bundle-Expiry-Timestamp",
+            "in": "header"
+          },
+          {
+            "type": "string",
+            "description": "The account which requested the presigned url",
+            "name": "presignSigner",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The bundle of the object of the presigned url, the latest object with the name is read if it is empty",
+            "name": "presignBundleName",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "The expiry timestamp of the presigned url",
+            "name": "presignExpiry",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The signature of the presigned url",
+            "name": "presignSignature",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully retrieved file",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "206": {
+            "description": "Successfully retrieved the requested range of the file",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "304": {
+            "description": "The file is not modified since the version in the conditional request headers"
+          },
+          "400": {
+            "description": "Invalid request or file format",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "The bucket is private and the request is not signed by an account which can get objects in it",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Bundle or object not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "416": {
+            "description": "The requested range is not satisfiable"
+          },
+          "500": {
+            "description": "Internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/download/{bucketName}/{objectName}": {
+      "get": {
+        "description": "Download an object of a given bucket by its name without the name of its bundle and returns it as a file. If several bundles of the bucket contain an object with the name, the latest uploaded object is returned. Single byte range requests (Range, If-Range) and conditional requests (If-None-Match, If-Modified-Since) are supported using the ETag and Last-Modified of the object.\n",
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "Bundle"
+        ],
+        "summary": "Download an object as a file by its name",
+        "operationId": "downloadObject",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The bucketName of the object",
+            "name": "bucketName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The name of the object",
+            "name": "objectName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Digital signature of an account which can get objects in the bucket, required to read a private bucket without a presigned url",
+            "name": "Authorization",
+            "in": "header"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Expiry timestamp of the signed request",
+            "name": "X-Bundle-Expiry-Timestamp",
+            "in": "header"
+          },
+          {
+            "type": "string",
+            "description": "The account which requested the presigned url",
+            "name": "presignSigner",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The bundle of the object of the presigned url, the latest object with the name is read if it is empty",
+            "name": "presignBundleName",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "The expiry timestamp of the presigned url",
+            "name": "presignExpiry",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The signature of the presigned url",
+            "name": "presignSignature",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully retrieved file",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "206": {
+            "description": "Successfully retrieved the requested range of the file",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "304": {
+            "description": "The file is not modified since the version in the conditional request headers"
+          },
+          "400": {
+            "description": "Invalid request or file format",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "The bucket is private and the request is not signed by an account which can get objects in it",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Object not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "416": {
+            "description": "The requested range is not satisfiable"
+          },
+          "500": {
+            "description": "Internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/finalizeBundle": {
+      "post": {
+        "description": "Completes the lifecycle of an existing bundle, requiring the bundle name for authorization.\n",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "Bundle"
+        ],
+        "summary": "Finalize an Existing Bundle",
+        "operationId": "finalizeBundle",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "User's digital signature for authorization",
+            "name": "Authorization",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The name of the bucket",
+            "name": "X-Bundle-Bucket-Name",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The name of the bundle to be finalized",
+            "name": "X-Bundle-Name",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Expiry timestamp of the request",
+            "name": "X-Bundle-Expiry-Timestamp",
+            "in": "header",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully managed bundle"
+          },
+          "400": {
+            "description": "Invalid request or parameters",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/importBundle": {
+      "post": {
+        "description": "Indexes a bundle which is already sealed on Greenfield but was not created by the service. The bundle is recorded as importing and the bundler reads its metadata from Greenfield, then creates its objects, so that they can be viewed and downloaded like the objects of the bundles created by the service. The status of the import is returned by queryBundle, a failed import can be requested again.\n",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "Bundle"
+        ],
+        "summary": "Import a bundle sealed on Greenfield by another tool",
+        "operationId": "importBundle",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "User's digital signature for authorization",
+            "name": "Authorization",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The name of the bucket",
+            "name": "X-Bundle-Bucket-Name",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The name of the bundle object on Greenfield",
+            "name": "X-Bundle-Name",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Expiry timestamp of the request",
+            "name": "X-Bundle-Expiry-Timestamp",
+            "in": "header",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The imported bundle",
+            "schema": {
+              "$ref": "#/definitions/BundleInfo"
+            }
+          },
+          "400": {
+            "description": "Invalid request or parameters",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Bundle object not found on Greenfield",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "The bundle already exists",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/initiateBundleUpload": {
+      "post": {
+        "description": "Initiates a resumable upload of a bundle file, which is then uploaded in chunks of the returned chunk size by uploadBundleChunk and completed by completeBundleUpload. Uploads which are not updated for a day are garbage collected.\n",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "Bundle"
+        ],
+        "summary": "Initiate a resumable bundle upload",
+        "operationId": "initiateBundleUpload",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "User's digital signature for authentication",
+            "name": "Authorization",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The name of the bucket",
+            "name": "X-Bundle-Bucket-Name",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The name of the bundle to be created",
+            "name": "X-Bundle-Name",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Size of the bundle file",
+            "name": "X-Bundle-File-Size",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "SHA256 hash of the bundle file",
+            "name": "X-Bundle-File-Sha256",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Expiry timestamp of the request",
+            "name": "X-Bundle-Expiry-Timestamp",
+            "in": "header",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully initiated the bundle upload",
+            "schema": {
+              "$ref": "#/definitions/BundleUploadInfo"
+            }
+          },
+          "400": {
+            "description": "Invalid request or file format",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/invalidateBucketCache": {
+      "post": {
+        "description": "Invalidate the cached info of the bucket and the cached permissions of the bundler accounts on it, so they are queried from Greenfield again, e.g. after the owner or the policies of the bucket changed on Greenfield. The signer should be the owner of the bucket on Greenfield.\n",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "Bundle"
+        ],
+        "summary": "Invalidate the cached info of a bucket",
+        "operationId": "invalidateBucketCache",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "User's digital signature for authorization",
+            "name": "Authorization",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The name of the bucket",
+            "name": "X-Bundle-Bucket-Name",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Expiry timestamp of the request",
+            "name": "X-Bundle-Expiry-Timestamp",
+            "in": "header",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully invalidated the cached info of the bucket"
+          },
+          "400": {
+            "description": "Invalid request or parameters",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/listBundles/{bucketName}": {
+      "get": {
+        "description": "Lists the bundles of a given bucket ordered by creation, optionally filtered by status, owner, creation time and name prefix. Pass the nextCursor of a page as the cursor to get the next page.\n",
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "Bundle"
+        ],
+        "summary": "List bundles of a bucket",
+        "operationId": "listBundles",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The bucketName of the bundles",
+            "name": "bucketName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "description": "Only list the bundles with the status",
+            "name": "status",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only list the bundles owned by the address",
+            "name": "owner",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Only list the bundles created at or after the unix timestamp",
+            "name": "createdAfter",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Only list the bundles created before the unix timestamp",
+            "name": "createdBefore",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only list the bundles whose name starts with the prefix",
+            "name": "namePrefix",
+            "in": "query"
+          },
+          {
+            "maximum": 100,
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "default": 20,
+            "description": "The maximum number of bundles to return",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The cursor returned by the previous page",
+            "name": "cursor",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Digital signature of an account which can get objects in the bucket, required to query a private bucket",
+            "name": "Authorization",
+            "in": "header"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Expiry timestamp of the signed request",
+            "name": "X-Bundle-Expiry-Timestamp",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully listed bundles",
+            "schema": {
+              "$ref": "#/definitions/ListBundlesResponse"
+            }
+          },
+          "400": {
+            "description": "Invalid request or parameters",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "The bucket is private and the request is not signed by an account which can get objects in it",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/listObjects/{bucketName}": {
+      "get": {
+        "description": "Lists the objects in the bundles of a given bucket ordered by creation, optionally filtered by bundle name, object name prefix, content type and tag. Pass the nextCursor of a page as the cursor to get the next page.\n",
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "Bundle"
+        ],
+        "summary": "List objects of a bucket",
+        "operationId": "listObjects",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The bucketName of the objects",
+            "name": "bucketName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Only list the objects in the bundle",
+            "name": "bundleName",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only list the objects whose name starts with the prefix",
+            "name": "namePrefix",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only list the objects with the content type",
+            "name": "contentType",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only list the objects with the tag",
+            "name": "tagKey",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only list the objects whose tag given by tagKey has the value",
+            "name": "tagValue",
+            "in": "query"
+          },
+          {
+            "maximum": 100,
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "default": 20,
+            "description": "The maximum number of objects to return",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The cursor returned by the previous page",
+            "name": "cursor",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Digital signature of an account which can get objects in the bucket, required to query a private bucket",
+            "name": "Authorization",
+            "in": "header"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Expiry timestamp of the signed request",
+            "name": "X-Bundle-Expiry-Timestamp",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully listed objects",
+            "schema": {
+              "$ref": "#/definitions/ListObjectsResponse"
+            }
+          },
+          "400": {
+            "description": "Invalid request or parameters",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "The bucket is private and the request is not signed by an account which can get objects in it",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/presign": {
+      "post": {
+        "description": "Returns time-limited urls to view and download an object of a bucket, which can be read without a signature until they expire. The request is signed by an account which can get objects in the bucket.\n",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "Bundle"
+        ],
+        "summary": "Presign the urls of an object",
+        "operationId": "presign",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "User's digital signature for authorization",
+            "name": "Authorization",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Expiry timestamp of the request",
+            "name": "X-Bundle-Expiry-Timestamp",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The name of the bucket",
+            "name": "X-Bundle-Bucket-Name",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The name of the object",
+            "name": "X-Bundle-File-Name",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The name of the bundle of the object, the latest object with the name in the bucket is read if it is not set",
+            "name": "X-Bundle-Name",
+            "in": "header"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "The timestamp when the presigned urls expire, at most 7 days ahead",
+            "name": "X-Bundle-Presign-Expiry",
+            "in": "header",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully presigned the urls",
+            "schema": {
+              "$ref": "#/definitions/PresignResponse"
+            }
+          },
+          "400": {
+            "description": "Invalid request or parameters",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "The signer can not get objects in the bucket",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/queryBundle/{bucketName}/{bundleName}": {
+      "get": {
+        "description": "Queries the bundle information of a given bundle.\n",
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "Bundle"
+        ],
+        "summary": "Query bundle information",
+        "operationId": "queryBundle",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The bucketName of the bundle",
+            "name": "bucketName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The name of the bundle",
+            "name": "bundleName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Digital signature of an account which can get objects in the bucket, required to query a private bucket",
+            "name": "Authorization",
+            "in": "header"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Expiry timestamp of the signed request",
+            "name": "X-Bundle-Expiry-Timestamp",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully queried bundle",
+            "schema": {
+              "$ref": "#/definitions/QueryBundleResponse"
+            }
+          },
+          "400": {
+            "description": "Invalid request or file format",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "The bucket is private and the request is not signed by an account which can get objects in it",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Bundle not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/queryBundleUpload/{uploadId}": {
+      "get": {
+        "description": "Queries the status and the uploaded chunks of a resumable bundle upload, so an interrupted upload can be resumed from the missing chunks.\n",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "Bundle"
+        ],
+        "summary": "Query a resumable bundle upload",
+        "operationId": "queryBundleUpload",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "User's digital signature for authentication",
+            "name": "Authorization",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The id of the bundle upload",
+            "name": "uploadId",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Expiry timestamp of the request",
+            "name": "X-Bundle-Expiry-Timestamp",
+            "in": "header",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully queried the bundle upload",
+            "schema": {
+              "$ref": "#/definitions/BundleUploadInfo"
+            }
+          },
+          "400": {
+            "description": "Invalid request or file format",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Bundle upload not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/queryBundlingBundle/{bucketName}": {
+      "get": {
+        "description": "Queries the bundling bundle information of a given bucket.\n",
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "Bundle"
+        ],
+        "summary": "Query bundling bundle information of a bucket",
+        "operationId": "queryBundlingBundle",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The bucketName of the bundle",
+            "name": "bucketName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Digital signature of an account which can get objects in the bucket, required to query a private bucket",
+            "name": "Authorization",
+            "in": "header"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Expiry timestamp of the signed request",
+            "name": "X-Bundle-Expiry-Timestamp",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully queried bundle",
+            "schema": {
+              "$ref": "#/definitions/QueryBundleResponse"
+            }
+          },
+          "400": {
+            "description": "Invalid request or signature",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "The bucket is private and the request is not signed by an account which can get objects in it",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Bundle not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/revokeSessionKey": {
+      "post": {
+        "description": "Revoke a session key of the signer, the requests signed by the session key are rejected afterwards\n",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "Bundle"
+        ],
+        "summary": "Revoke a session key",
+        "operationId": "revokeSessionKey",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "User's digital signature for authorization",
+            "name": "Authorization",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Expiry timestamp of the request",
+            "name": "X-Bundle-Expiry-Timestamp",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The address of the session key",
+            "name": "X-Bundle-Session-Key-Address",
+            "in": "header",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully revoked the session key"
+          },
+          "400": {
+            "description": "Invalid request or parameters",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "The session key is not registered by the signer",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/setBundleRule": {
+      "post": {
+        "description": "Set new rules or replace old rules for bundling, including constraints like maximum size and number of files.\n",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "Rule"
+        ],
+        "summary": "Set New Bundling Rules",
+        "operationId": "setBundleRule",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "User's digital signature for authorization",
+            "name": "Authorization",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Name of the bucket for which the rule applies",
+            "name": "X-Bundle-Bucket-Name",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Maximum size of a bundle in bytes",
+            "name": "X-Bundle-Max-Bundle-Size",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Maximum number of files in a bundle",
+            "name": "X-Bundle-Max-Bundle-Files",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Maximum time in seconds before a bundle must be finalized",
+            "name": "X-Bundle-Max-Finalize-Time",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Expiry timestamp of the request",
+            "name": "X-Bundle-Expiry-Timestamp",
+            "in": "header",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully added bundle rule"
+          },
+          "400": {
+            "description": "Invalid request or parameters",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/unbundleObject": {
+      "post": {
+        "description": "Creates the job which copies an object out of its bundle sealed on Greenfield into a standalone object with the same name in the bucket, which is created by the bundler account of the bundle. Once the job is completed, the object is marked as migrated and the view and download endpoints redirect to the standalone object on the primary storage provider of the bucket. Requesting an object again returns its job, a failed job is restarted.\n",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "Bundle"
+        ],
+        "summary": "Promote an object of a sealed bundle to a standalone object",
+        "operationId": "unbundleObject",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "User's digital signature for authentication",
+            "name": "Authorization",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The name of the bucket",
+            "name": "X-Bundle-Bucket-Name",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The name of the bundle of the object",
+            "name": "X-Bundle-Name",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The name of the object to be unbundled",
+            "name": "X-Bundle-File-Name",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Expiry timestamp of the request",
+            "name": "X-Bundle-Expiry-Timestamp",
+            "in": "header",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The unbundle job of the object",
+            "schema": {
+              "$ref": "#/definitions/UnbundleJobInfo"
+            }
+          },
+          "400": {
+            "description": "Invalid request or parameters",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Object or bundle not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "An object with the name already exists on Greenfield",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/uploadBundle": {
+      "post": {
+        "description": "Uploads a bundle of objects, requiring details like bucket name, bundle name, and etc.\n",
+        "consumes": [
+          "multipart/form-data"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "Bundle"
+        ],
+        "summary": "Upload a bundle",
+        "operationId": "uploadBundle",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "User's digital signature for authentication",
+            "name": "Authorization",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The name of the bucket",
+            "name": "X-Bundle-Bucket-Name",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The name of the bundle to be created",
+            "name": "X-Bundle-Name",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "SHA256 hash of the file",
+            "name": "X-Bundle-File-Sha256",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "SHA256 hash of the content of the bundle file, which is verified while the bundle file is received",
+            "name": "X-Bundle-Content-Sha256",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Expiry timestamp of the request",
+            "name": "X-Bundle-Expiry-Timestamp",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "file",
+            "description": "The file to be uploaded",
+            "name": "file",
+            "in": "formData",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully uploaded file",
+            "schema": {
+              "$ref": "#/definitions/UploadObjectResponse"
+            }
+          },
+          "400": {
+            "description": "Invalid request or file format",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/uploadBundleChunk/{uploadId}/{chunkNumber}": {
+      "put": {
+        "description": "Uploads the chunk with the number of a resumable bundle upload, chunks are numbered from 1 and every chunk but the last one has the chunk size of the upload. Uploading a chunk again replaces it.\n",
+        "consumes": [
+          "multipart/form-data"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "Bundle"
+        ],
+        "summary": "Upload a chunk of a resumable bundle upload",
+        "operationId": "uploadBundleChunk",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "User's digital signature for authentication",
+            "name": "Authorization",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The id of the bundle upload",
+            "name": "uploadId",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "The number of the chunk, starting from 1",
+            "name": "chunkNumber",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "SHA256 hash of the chunk",
+            "name": "X-Bundle-Chunk-Sha256",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Expiry timestamp of the request",
+            "name": "X-Bundle-Expiry-Timestamp",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "file",
+            "description": "The chunk to be uploaded",
+            "name": "chunk",
+            "in": "formData",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully uploaded the chunk"
+          },
+          "400": {
+            "description": "Invalid request or file format",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Bundle upload not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "The bundle upload is not uploading anymore",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/uploadObject": {
+      "post": {
+        "description": "Uploads a single object to a bundle, requiring details like bucket name, file name, and etc. An object with the same name in the bundling bundle is rejected, unless X-Bundle-Overwrite is true, which replaces it.\n",
+        "consumes": [
+          "multipart/form-data"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "Bundle"
+        ],
+        "summary": "Upload a single object to a bundle",
+        "operationId": "uploadObject",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "User's digital signature for authentication",
+            "name": "Authorization",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The name of the bucket",
+            "name": "X-Bundle-Bucket-Name",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The name of the file to be uploaded",
+            "name": "X-Bundle-File-Name",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Content type of the file",
+            "name": "X-Bundle-Content-Type",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "SHA256 hash of the file",
+            "name": "X-Bundle-File-Sha256",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Tags of the file",
+            "name": "X-Bundle-Tags",
+            "in": "header"
+          },
+          {
+            "type": "boolean",
+            "description": "Replace the object with the same name in the bundling bundle of the bucket",
+            "name": "X-Bundle-Overwrite",
+            "in": "header"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Expiry timestamp of the request",
+            "name": "X-Bundle-Expiry-Timestamp",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "file",
+            "description": "The file to be uploaded",
+            "name": "file",
+            "in": "formData",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully uploaded file",
+            "schema": {
+              "$ref": "#/definitions/UploadObjectResponse"
+            }
+          },
+          "400": {
+            "description": "Invalid request or file format",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "The bundling bundle changed during the upload, the request can be retried",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/uploadObjects": {
+      "post": {
+        "description": "Uploads several objects to the bundling bundle of a bucket under a single signature. The manifest lists the name, SHA256 hash, content type and tags of every object in the order of the files, and its SHA256 hash is signed in the X-Bundle-Manifest-Sha256 header. The objects are validated and created atomically, if they exceed the max files or size of an auto generated bundling bundle, the bundle is finalized and the rest of the objects are uploaded to a new auto generated bundle.\n",
+        "consumes": [
+          "multipart/form-data"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "Bundle"
+        ],
+        "summary": "Upload several objects to the bundling bundle",
+        "operationId": "uploadObjects",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "User's digital signature for authentication",
+            "name": "Authorization",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The name of the bucket",
+            "name": "X-Bundle-Bucket-Name",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "SHA256 hash of the manifest",
+            "name": "X-Bundle-Manifest-Sha256",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Expiry timestamp of the request",
+            "name": "X-Bundle-Expiry-Timestamp",
+            "in": "header",
+            "required": true
+          },
+          {
+            "type": "file",
+            "description": "JSON array of the objects to be uploaded, every object has a name, sha256, contentType and optional tags\n",
+            "name": "manifest",
+            "in": "formData",
+            "required": true
+          },
+          {
+            "type": "file",
+            "description": "The files to be uploaded, one part named files per object in the order of the manifest",
+            "name": "files",
+            "in": "formData",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully uploaded files",
+            "schema": {
+              "$ref": "#/definitions/UploadObjectsResponse"
+            }
+          },
+          "400": {
+            "description": "Invalid request or file format",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "The bundling bundle changed during the upload, the upload can be retried",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/view/{bucketName}/{bundleName}/{objectName}": {
+      "get": {
+        "description": "Fetches a specific object from a given bundle and returns it as a file. Single byte range requests (Range, If-Range) and conditional requests (If-None-Match, If-Modified-Since) are supported using the ETag and Last-Modified of the object.\n",
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "Bundle"
+        ],
+        "summary": "Retrieve an object as a file from a bundle",
+        "operationId": "viewBundleObject",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The bucketName of the bundle",
+            "name": "bucketName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The name of the bundle",
+            "name": "bundleName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The name of the object within the bundle",
+            "name": "objectName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Digital signature of an account which can get objects in the bucket, required to read a private bucket without a presigned url",
+            "name": "Authorization",
+            "in": "header"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Expiry timestamp of the signed request",
+            "name": "X-Bundle-Expiry-Timestamp",
+            "in": "header"
+          },
+          {
+            "type": "string",
+            "description": "The account which requested the presigned url",
+            "name": "presignSigner",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The bundle of the object of the presigned url, the latest object with the name is read if it is empty",
+            "name": "presignBundleName",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "The expiry timestamp of the presigned url",
+            "name": "presignExpiry",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The signature of the presigned url",
+            "name": "presignSignature",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully retrieved file",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "206": {
+            "description": "Successfully retrieved the requested range of the file",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "304": {
+            "description": "The file is not modified since the version in the conditional request headers"
+          },
+          "400": {
+            "description": "Invalid request or file format",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "The bucket is private and the request is not signed by an account which can get objects in it",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
             "description": "Bundle or object not found",
             "schema": {
               "$ref": "#/definitions/Error"
             }
           },
-          "500": {
-            "description": "Internal server error",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          }
+          "416": {
+            "description": "The requested range is not satisfiable"
+          },
+          "500": {
+            "description": "Internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/view/{bucketName}/{objectName}": {
+      "get": {
+        "description": "Fetches an object of a given bucket by its name without the name of its bundle and returns it as a file. If several bundles of the bucket contain an object with the name, the latest uploaded object is returned. Single byte range requests (Range, If-Range) and conditional requests (If-None-Match, If-Modified-Since) are supported using the ETag and Last-Modified of the object.\n",
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "Bundle"
+        ],
+        "summary": "Retrieve an object as a file by its name",
+        "operationId": "viewObject",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The bucketName of the object",
+            "name": "bucketName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The name of the object",
+            "name": "objectName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Digital signature of an account which can get objects in the bucket, required to read a private bucket without a presigned url",
+            "name": "Authorization",
+            "in": "header"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Expiry timestamp of the signed request",
+            "name": "X-Bundle-Expiry-Timestamp",
+            "in": "header"
+          },
+          {
+            "type": "string",
+            "description": "The account which requested the presigned url",
+            "name": "presignSigner",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The bundle of the object of the presigned url, the latest object with the name is read if it is empty",
+            "name": "presignBundleName",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "The expiry timestamp of the presigned url",
+            "name": "presignExpiry",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The signature of the presigned url",
+            "name": "presignSignature",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully retrieved file",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "206": {
+            "description": "Successfully retrieved the requested range of the file",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "304": {
+            "description": "The file is not modified since the version in the conditional request headers"
+          },
+          "400": {
+            "description": "Invalid request or file format",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "The bucket is private and the request is not signed by an account which can get objects in it",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Object not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "416": {
+            "description": "The requested range is not satisfiable"
+          },
+          "500": {
+            "description": "Internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    }
+  },
+  "definitions": {
+    "BundleInfo": {
+      "type": "object",
+      "properties": {
+        "bucketName": {
+          "description": "The name of the bucket of the bundle",
+          "type": "string",
+          "x-omitempty": false
+        },
+        "bundleName": {
+          "description": "The name of the bundle",
+          "type": "string",
+          "x-omitempty": false
+        },
+        "createdTimestamp": {
+          "description": "The creation timestamp of the bundle",
+          "type": "integer",
+          "x-omitempty": false
+        },
+        "errorMessage": {
+          "description": "The error message of the bundle",
+          "type": "string",
+          "x-omitempty": false
+        },
+        "files": {
+          "description": "The number of files in the bundle",
+          "type": "integer",
+          "x-omitempty": false
+        },
+        "objectId": {
+          "description": "The id of the bundle object on Greenfield",
+          "type": "string",
+          "x-omitempty": false
+        },
+        "size": {
+          "description": "The size of the bundle",
+          "type": "integer",
+          "x-omitempty": false
+        },
+        "status": {
+          "description": "The status of the bundle",
+          "type": "integer",
+          "x-omitempty": false
+        },
+        "txHash": {
+          "description": "The hash of the transaction creating the bundle object on Greenfield",
+          "type": "string",
+          "x-omitempty": false
+        }
+      }
+    },
+    "BundleUploadChunkInfo": {
+      "type": "object",
+      "properties": {
+        "number": {
+          "description": "The number of the chunk",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "sha256": {
+          "description": "The SHA256 hash of the chunk",
+          "type": "string",
+          "x-omitempty": false
+        },
+        "size": {
+          "description": "The size of the chunk",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        }
+      }
+    },
+    "BundleUploadInfo": {
+      "type": "object",
+      "properties": {
+        "bucketName": {
+          "description": "The name of the bucket",
+          "type": "string",
+          "x-omitempty": false
+        },
+        "bundleName": {
+          "description": "The name of the bundle",
+          "type": "string",
+          "x-omitempty": false
+        },
+        "chunkSize": {
+          "description": "The size of every chunk but the last one",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "chunks": {
+          "description": "The number of chunks of the bundle file",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "expireTimestamp": {
+          "description": "The time the upload is garbage collected if it is not updated until then",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "size": {
+          "description": "The size of the bundle file",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "status": {
+          "description": "The status of the upload, one of uploading, completing and completed",
+          "type": "string",
+          "x-omitempty": false
+        },
+        "uploadId": {
+          "description": "The id of the bundle upload",
+          "type": "string",
+          "x-omitempty": false
+        },
+        "uploadedChunks": {
+          "description": "The uploaded chunks ordered by number",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/BundleUploadChunkInfo"
+          },
+          "x-omitempty": false
         }
       }
-    }
-  },
-  "definitions": {
+    },
     "BundlerAccount": {
       "type": "object",
       "properties": {
@@ -1583,6 +5205,136 @@ func init() {
         }
       }
     },
+    "ListBundlesResponse": {
+      "type": "object",
+      "properties": {
+        "bundles": {
+          "description": "The bundles of the page",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/BundleInfo"
+          },
+          "x-omitempty": false
+        },
+        "nextCursor": {
+          "description": "The cursor of the next page, empty if there are no more bundles",
+          "type": "string",
+          "x-omitempty": false
+        }
+      }
+    },
+    "ListObjectsResponse": {
+      "type": "object",
+      "properties": {
+        "nextCursor": {
+          "description": "The cursor of the next page, empty if there are no more objects",
+          "type": "string",
+          "x-omitempty": false
+        },
+        "objects": {
+          "description": "The objects of the page",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ObjectInfo"
+          },
+          "x-omitempty": false
+        }
+      }
+    },
+    "ObjectInfo": {
+      "type": "object",
+      "properties": {
+        "bucketName": {
+          "description": "The name of the bucket of the object",
+          "type": "string",
+          "x-omitempty": false
+        },
+        "bundleName": {
+          "description": "The name of the bundle of the object",
+          "type": "string",
+          "x-omitempty": false
+        },
+        "contentType": {
+          "description": "The content type of the object",
+          "type": "string",
+          "x-omitempty": false
+        },
+        "createdTimestamp": {
+          "description": "The creation timestamp of the object",
+          "type": "integer",
+          "x-omitempty": false
+        },
+        "hash": {
+          "description": "The hex encoded hash of the object, empty if the hash is not known",
+          "type": "string",
+          "x-omitempty": false
+        },
+        "hashAlgo": {
+          "description": "The hash algorithm of the hash of the object, e.g. SHA256, Unknown if the hash is not known",
+          "type": "string",
+          "x-omitempty": false
+        },
+        "migrated": {
+          "description": "Whether the object is unbundled to a standalone object on Greenfield, which is served instead",
+          "type": "boolean",
+          "x-omitempty": false
+        },
+        "objectName": {
+          "description": "The name of the object",
+          "type": "string",
+          "x-omitempty": false
+        },
+        "offset": {
+          "description": "The offset of the object in the bundle",
+          "type": "integer",
+          "x-omitempty": false
+        },
+        "owner": {
+          "description": "The owner of the object, which is the owner of the bucket",
+          "type": "string",
+          "x-omitempty": false
+        },
+        "size": {
+          "description": "The size of the object",
+          "type": "integer",
+          "x-omitempty": false
+        },
+        "tags": {
+          "description": "The tags of the object",
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          },
+          "x-omitempty": false
+        },
+        "uploader": {
+          "description": "The signer who uploaded the object, the owner of the bucket or a delegate granted to create objects in the bucket, empty for the imported objects",
+          "type": "string",
+          "x-omitempty": false
+        }
+      }
+    },
+    "PresignResponse": {
+      "type": "object",
+      "properties": {
+        "downloadUrl": {
+          "description": "The presigned url to download the object, relative to the server",
+          "type": "string",
+          "x-omitempty": false
+        },
+        "expiryTimestamp": {
+          "description": "The timestamp when the presigned urls expire",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "viewUrl": {
+          "description": "The presigned url to view the object, relative to the server",
+          "type": "string",
+          "x-omitempty": false
+        }
+      }
+    },
     "QueryBundleResponse": {
       "type": "object",
       "properties": {
@@ -1623,6 +5375,126 @@ func init() {
         }
       }
     },
+    "SessionKeyInfo": {
+      "type": "object",
+      "properties": {
+        "buckets": {
+          "description": "The buckets which the session key can access",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": false
+        },
+        "createdTimestamp": {
+          "description": "The creation timestamp of the session key",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "expiryTimestamp": {
+          "description": "The timestamp when the session key expires",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "maxRequests": {
+          "description": "The max number of requests signed by the session key, unlimited if it is 0",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "maxUploadSize": {
+          "description": "The max total size of the requests signed by the session key, unlimited if it is 0",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "owner": {
+          "description": "The owner who registered the session key",
+          "type": "string",
+          "x-omitempty": false
+        },
+        "revoked": {
+          "description": "Whether the session key is revoked",
+          "type": "boolean",
+          "x-omitempty": false
+        },
+        "scopes": {
+          "description": "The scopes of the operations which the session key can perform",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": false
+        },
+        "sessionKey": {
+          "description": "The address of the session key",
+          "type": "string",
+          "x-omitempty": false
+        },
+        "usedRequests": {
+          "description": "The number of requests signed by the session key",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "usedUploadSize": {
+          "description": "The total size of the requests signed by the session key",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        }
+      }
+    },
+    "UnbundleJobInfo": {
+      "type": "object",
+      "properties": {
+        "bucketName": {
+          "description": "The name of the bucket",
+          "type": "string",
+          "x-omitempty": false
+        },
+        "bundleName": {
+          "description": "The name of the bundle of the object when the job is created",
+          "type": "string",
+          "x-omitempty": false
+        },
+        "createdTimestamp": {
+          "description": "The creation timestamp of the job",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "errMessage": {
+          "description": "The error of the last attempt of the job",
+          "type": "string",
+          "x-omitempty": false
+        },
+        "objectId": {
+          "description": "The id of the standalone object on Greenfield, 0 until it is created",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "objectName": {
+          "description": "The name of the object",
+          "type": "string",
+          "x-omitempty": false
+        },
+        "status": {
+          "description": "The status of the job, one of pending, created_on_chain, completed and failed",
+          "type": "string",
+          "x-omitempty": false
+        },
+        "updatedTimestamp": {
+          "description": "The timestamp of the last update of the job",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        }
+      }
+    },
     "UploadObjectResponse": {
       "type": "object",
       "properties": {
@@ -1632,6 +5504,34 @@ func init() {
           "x-omitempty": false
         }
       }
+    },
+    "UploadObjectsResponse": {
+      "type": "object",
+      "properties": {
+        "objects": {
+          "description": "The uploaded objects in the order of the manifest",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/UploadedObject"
+          },
+          "x-omitempty": false
+        }
+      }
+    },
+    "UploadedObject": {
+      "type": "object",
+      "properties": {
+        "bundleName": {
+          "description": "The name of the bundle where the object has been uploaded",
+          "type": "string",
+          "x-omitempty": false
+        },
+        "objectName": {
+          "description": "The name of the object",
+          "type": "string",
+          "x-omitempty": false
+        }
+      }
     }
   }
 }`))
@@ -1,15 +1,26 @@
 package handlers
 
 import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	sdk "github.com/bnb-chain/greenfield-bundle-sdk/bundle"
+	bundleTypes "github.com/bnb-chain/greenfield-bundle-sdk/types"
+	gnfdtypes "github.com/bnb-chain/greenfield/x/storage/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/go-openapi/runtime/middleware"
 
+	"github.com/node-real/greenfield-bundle-service/dao"
 	"github.com/node-real/greenfield-bundle-service/database"
 	"github.com/node-real/greenfield-bundle-service/models"
 	"github.com/node-real/greenfield-bundle-service/restapi/operations/bundle"
@@ -71,26 +82,48 @@ func HandleDeleteBundle() func(params bundle.DeleteBundleParams) middleware.Resp
 	}
 }
 
-// HandleCreateBundle handles create bundle request
-func HandleCreateBundle() func(params bundle.CreateBundleParams) middleware.Responder {
-	return func(params bundle.CreateBundleParams) middleware.Responder {
+// HandleInvalidateBucketCache handles the invalidate bucket cache request, the info of the bucket and the bundler
+// permissions on it are queried from Greenfield again
+func HandleInvalidateBucketCache() func(params bundle.InvalidateBucketCacheParams) middleware.Responder {
+	return func(params bundle.InvalidateBucketCacheParams) middleware.Responder {
 		// validate headers
 		signerAddress, merr := types.ValidateHeaders(params.HTTPRequest)
 		if merr != nil {
 			util.Logger.Errorf("sig check error, code=%d, msg=%s", merr.Code, merr.Message)
-			return bundle.NewCreateBundleBadRequest().WithPayload(merr)
+			return bundle.NewInvalidateBucketCacheBadRequest().WithPayload(merr)
 		}
 
-		bucketInfo, err := service.BundleSvc.QueryBucketFromGnfd(params.XBundleBucketName)
+		// the owner is checked against the bucket queried bypassing the cache, so a new owner is not rejected by the
+		// cached info, and the cache is only invalidated by the owner
+		bucketInfo, err := service.BundleSvc.QueryUncachedBucketFromGnfd(params.XBundleBucketName)
 		if err != nil {
 			util.Logger.Errorf("query bucket error, err=%s", err.Error())
-			return bundle.NewCreateBundleBadRequest().WithPayload(types.InternalErrorWithError(err))
+			return bundle.NewInvalidateBucketCacheInternalServerError().WithPayload(types.InternalErrorWithError(err))
 		}
-
-		// check if the signer is the owner of the bucket
 		if bucketInfo.Owner != signerAddress.String() {
 			util.Logger.Errorf("signer is not the owner of the bucket, signer=%s, bucket=%s", signerAddress.String(), params.XBundleBucketName)
-			return bundle.NewCreateBundleBadRequest().WithPayload(types.InvalidSignatureErrorWithError(fmt.Errorf("signer is not the owner of the bucket")))
+			return bundle.NewInvalidateBucketCacheBadRequest().WithPayload(types.InvalidSignatureErrorWithError(fmt.Errorf("signer is not the owner of the bucket")))
+		}
+		service.BundleSvc.InvalidateBucketCache(params.XBundleBucketName)
+
+		return bundle.NewInvalidateBucketCacheOK()
+	}
+}
+
+// HandleCreateBundle handles create bundle request
+func HandleCreateBundle() func(params bundle.CreateBundleParams) middleware.Responder {
+	return func(params bundle.CreateBundleParams) middleware.Responder {
+		// validate headers
+		signerAddress, merr := types.ValidateHeaders(params.HTTPRequest)
+		if merr != nil {
+			util.Logger.Errorf("sig check error, code=%d, msg=%s", merr.Code, merr.Message)
+			return bundle.NewCreateBundleBadRequest().WithPayload(merr)
+		}
+
+		// check if the signer can upload objects to the bucket
+		bucketInfo, merr := ValidateBucketUploader(signerAddress, params.XBundleBucketName)
+		if merr != nil {
+			return bundle.NewCreateBundleBadRequest().WithPayload(merr)
 		}
 
 		// check bundle name prefix
@@ -106,7 +139,7 @@ func HandleCreateBundle() func(params bundle.CreateBundleParams) middleware.Resp
 		}
 
 		// check the existence of the bundle in Greenfield
-		_, err = service.BundleSvc.HeadObjectFromGnfd(params.XBundleBucketName, params.XBundleName)
+		_, err := service.BundleSvc.HeadObjectFromGnfd(params.XBundleBucketName, params.XBundleName)
 		if err == nil {
 			return bundle.NewCreateBundleBadRequest().WithPayload(types.ErrorObjectExist)
 		}
@@ -114,9 +147,9 @@ func HandleCreateBundle() func(params bundle.CreateBundleParams) middleware.Resp
 			return bundle.NewCreateBundleBadRequest().WithPayload(types.InternalErrorWithError(err))
 		}
 
-		// create new bundle
+		// create new bundle, which is owned by the owner of the bucket for the fee grant
 		newBundle := database.Bundle{
-			Owner:  signerAddress.String(),
+			Owner:  bucketInfo.Owner,
 			Bucket: params.XBundleBucketName,
 			Name:   params.XBundleName,
 		}
@@ -150,23 +183,22 @@ func HandleFinalizeBundle() func(params bundle.FinalizeBundleParams) middleware.
 			return bundle.NewFinalizeBundleBadRequest().WithPayload(types.InternalErrorWithError(err))
 		}
 
-		// check signature
-		signerAddress, err := types.VerifySignature(params.HTTPRequest)
-		if err != nil {
-			util.Logger.Errorf("sig check error, err=%s", err.Error())
-			return bundle.NewFinalizeBundleBadRequest().WithPayload(types.ErrorInvalidSignature)
-		}
-
-		// check expiry timestamp
-		if err := types.ValidateExpiryTimestamp(params.HTTPRequest); err != nil {
-			util.Logger.Errorf("validate expiry timestamp error, err=%s", err.Error())
-			return bundle.NewFinalizeBundleBadRequest().WithPayload(types.ErrorInvalidExpiryTimestamp)
+		// validate headers
+		signerAddress, merr := types.ValidateHeaders(params.HTTPRequest)
+		if merr != nil {
+			util.Logger.Errorf("sig check error, code=%d, msg=%s", merr.Code, merr.Message)
+			return bundle.NewFinalizeBundleBadRequest().WithPayload(merr)
 		}
 
-		// check owner
+		// check owner, the delegates who can upload objects to the bucket can finalize its bundles as well
 		if signerAddress.String() != queriedBundle.Owner {
-			util.Logger.Errorf("invalid bundle owner, signer=%s, bundleOwner=%s", signerAddress.String(), queriedBundle.Owner)
-			return bundle.NewFinalizeBundleBadRequest().WithPayload(types.ErrorInvalidBundleOwner)
+			if _, merr := ValidateBucketUploader(signerAddress, params.XBundleBucketName); merr != nil {
+				util.Logger.Errorf("invalid bundle owner, signer=%s, bundleOwner=%s", signerAddress.String(), queriedBundle.Owner)
+				if merr.Code == types.ErrorInternalError.Code {
+					return bundle.NewFinalizeBundleBadRequest().WithPayload(merr)
+				}
+				return bundle.NewFinalizeBundleBadRequest().WithPayload(types.ErrorInvalidBundleOwner)
+			}
 		}
 
 		// finalize bundle
@@ -183,6 +215,14 @@ func HandleFinalizeBundle() func(params bundle.FinalizeBundleParams) middleware.
 // HandleQueryBundle handles the query bundle request
 func HandleQueryBundle() func(params bundle.QueryBundleParams) middleware.Responder {
 	return func(params bundle.QueryBundleParams) middleware.Responder {
+		// the bundles of a private bucket can only be queried by the accounts which can read it
+		if merr := ValidateBucketReadAccess(params.HTTPRequest, params.BucketName); merr != nil {
+			if merr.Code == types.ErrorInternalError.Code {
+				return bundle.NewQueryBundleInternalServerError().WithPayload(merr)
+			}
+			return bundle.NewQueryBundleForbidden().WithPayload(merr)
+		}
+
 		bundleInfo, err := service.BundleSvc.QueryBundle(params.BucketName, params.BundleName)
 		if err != nil {
 			util.Logger.Errorf("query bundle error, bucket=%s, bundle=%s, err=%s", params.BucketName, params.BundleName, err.Error())
@@ -208,6 +248,14 @@ func HandleQueryBundle() func(params bundle.QueryBundleParams) middleware.Respon
 // HandleQueryBundlingBundle handles the query bundling bundle request
 func HandleQueryBundlingBundle() func(params bundle.QueryBundlingBundleParams) middleware.Responder {
 	return func(params bundle.QueryBundlingBundleParams) middleware.Responder {
+		// the bundles of a private bucket can only be queried by the accounts which can read it
+		if merr := ValidateBucketReadAccess(params.HTTPRequest, params.BucketName); merr != nil {
+			if merr.Code == types.ErrorInternalError.Code {
+				return bundle.NewQueryBundlingBundleInternalServerError().WithPayload(merr)
+			}
+			return bundle.NewQueryBundlingBundleForbidden().WithPayload(merr)
+		}
+
 		bundleInfo, err := service.BundleSvc.GetBundlingBundle(params.BucketName)
 		if err != nil {
 			util.Logger.Errorf("query bundle error, bucket=%s, err=%s", params.BucketName, err.Error())
@@ -230,51 +278,148 @@ func HandleQueryBundlingBundle() func(params bundle.QueryBundlingBundleParams) m
 	}
 }
 
-func ValidateUploadBundleRequest(params bundle.UploadBundleParams) (common.Address, *models.Error) {
+// HandleListBundles handles the list bundles request
+func HandleListBundles() func(params bundle.ListBundlesParams) middleware.Responder {
+	return func(params bundle.ListBundlesParams) middleware.Responder {
+		// the bundles of a private bucket can only be queried by the accounts which can read it
+		if merr := ValidateBucketReadAccess(params.HTTPRequest, params.BucketName); merr != nil {
+			if merr.Code == types.ErrorInternalError.Code {
+				return bundle.NewListBundlesInternalServerError().WithPayload(merr)
+			}
+			return bundle.NewListBundlesForbidden().WithPayload(merr)
+		}
+
+		filter := dao.BundleFilter{}
+		if params.Status != nil {
+			if *params.Status < 0 {
+				return bundle.NewListBundlesBadRequest().WithPayload(types.InvalidParamsErrorWithError(fmt.Errorf("invalid status %d", *params.Status)))
+			}
+			status := database.BundleStatus(*params.Status)
+			filter.Status = &status
+		}
+		if params.Owner != nil {
+			filter.Owner = *params.Owner
+		}
+		if params.CreatedAfter != nil {
+			filter.CreatedAfter = time.Unix(*params.CreatedAfter, 0)
+		}
+		if params.CreatedBefore != nil {
+			filter.CreatedBefore = time.Unix(*params.CreatedBefore, 0)
+		}
+		if params.NamePrefix != nil {
+			filter.NamePrefix = *params.NamePrefix
+		}
+
+		var cursor string
+		if params.Cursor != nil {
+			cursor = *params.Cursor
+		}
+
+		bundles, nextCursor, err := service.BundleSvc.ListBundles(params.BucketName, filter, cursor, int(*params.Limit))
+		if err != nil {
+			if errors.Is(err, service.ErrInvalidCursor) {
+				return bundle.NewListBundlesBadRequest().WithPayload(types.InvalidParamsErrorWithError(err))
+			}
+			util.Logger.Errorf("list bundles error, bucket=%s, err=%s", params.BucketName, err.Error())
+			return bundle.NewListBundlesInternalServerError().WithPayload(types.InternalErrorWithError(err))
+		}
+
+		bundleInfos := make([]*models.BundleInfo, 0, len(bundles))
+		for _, bundleInfo := range bundles {
+			bundleInfos = append(bundleInfos, &models.BundleInfo{
+				BucketName:       bundleInfo.Bucket,
+				BundleName:       bundleInfo.Name,
+				Status:           int64(bundleInfo.Status),
+				Files:            bundleInfo.Files,
+				Size:             bundleInfo.Size,
+				ErrorMessage:     bundleInfo.ErrMessage,
+				CreatedTimestamp: bundleInfo.CreatedAt.Unix(),
+				ObjectID:         strconv.FormatUint(bundleInfo.ObjectId, 10),
+				TxHash:           bundleInfo.TxHash,
+			})
+		}
+
+		return bundle.NewListBundlesOK().WithPayload(&models.ListBundlesResponse{
+			Bundles:    bundleInfos,
+			NextCursor: nextCursor,
+		})
+	}
+}
+
+// ValidateUploadBundleRequest validates the upload bundle request, it returns the signer and the owner of the bucket
+func ValidateUploadBundleRequest(params bundle.UploadBundleParams) (common.Address, string, *models.Error) {
 	// validate headers
 	signerAddress, merr := types.ValidateHeaders(params.HTTPRequest)
 	if merr != nil {
 		util.Logger.Errorf("sig check error, code=%d, msg=%s", merr.Code, merr.Message)
-		return common.Address{}, merr
+		return common.Address{}, "", merr
+	}
+
+	bucketInfo, merr := ValidateNewBundle(signerAddress, params.XBundleBucketName, params.XBundleName)
+	if merr != nil {
+		return common.Address{}, "", merr
 	}
 
-	bucketInfo, err := service.BundleSvc.QueryBucketFromGnfd(params.XBundleBucketName)
+	return signerAddress, bucketInfo.Owner, nil
+}
+
+// ValidateBucketUploader checks that the signer can upload objects to the bucket, as the owner of the bucket or as a
+// delegate granted to create objects in the bucket on Greenfield, and returns the bucket info
+func ValidateBucketUploader(signerAddress common.Address, bucketName string) (*gnfdtypes.BucketInfo, *models.Error) {
+	bucketInfo, err := service.BundleSvc.QueryBucketFromGnfd(bucketName)
 	if err != nil {
 		util.Logger.Errorf("query bucket error, err=%s", err.Error())
-		return common.Address{}, types.InternalErrorWithError(err)
+		return nil, types.InternalErrorWithError(err)
 	}
 
-	// check if the signer is the owner of the bucket
-	if bucketInfo.Owner != signerAddress.String() {
-		util.Logger.Errorf("signer is not the owner of the bucket, signer=%s, bucket=%s", signerAddress.String(), params.XBundleBucketName)
-		return common.Address{}, types.ErrorInvalidSignature
+	isUploader, err := service.BundleSvc.IsBucketUploader(bucketInfo, signerAddress)
+	if err != nil {
+		return nil, types.InternalErrorWithError(err)
+	}
+	if !isUploader {
+		util.Logger.Errorf("signer is not granted to upload objects to the bucket, signer=%s, bucket=%s", signerAddress.String(), bucketName)
+		return nil, types.InvalidSignatureErrorWithError(fmt.Errorf("signer is neither the owner of the bucket nor granted to create objects in it"))
+	}
+
+	return bucketInfo, nil
+}
+
+// ValidateNewBundle checks that the signer can upload objects to the bucket and the bundle can be created in it by an
+// upload, and returns the bucket info
+func ValidateNewBundle(signerAddress common.Address, bucketName string, bundleName string) (*gnfdtypes.BucketInfo, *models.Error) {
+	bucketInfo, merr := ValidateBucketUploader(signerAddress, bucketName)
+	if merr != nil {
+		return nil, merr
 	}
 
 	// check bundle name prefix
-	if service.IsAutoGeneratedBundleName(params.XBundleName) {
+	if service.IsAutoGeneratedBundleName(bundleName) {
 		util.Logger.Errorf("bundle name should not start with %s", service.BundleNamePrefix)
-		return common.Address{}, types.ErrorInvalidBundleName
+		return nil, types.ErrorInvalidBundleName
 	}
 
 	// validate bundle name
-	if err := types.ValidateBundleName(params.XBundleName); err != nil {
+	if err := types.ValidateBundleName(bundleName); err != nil {
 		util.Logger.Errorf("invalid bundle name, err=%s", err.Message)
-		return common.Address{}, err
+		return nil, err
 	}
 
 	// check the existence of the bundle in Greenfield
-	_, err = service.BundleSvc.HeadObjectFromGnfd(params.XBundleBucketName, params.XBundleName)
+	_, err := service.BundleSvc.HeadObjectFromGnfd(bucketName, bundleName)
 	if err == nil {
-		return common.Address{}, types.ErrorObjectExist
+		return nil, types.ErrorObjectExist
 	}
 	if !service.IsObjectNotFoundError(err) {
-		return common.Address{}, types.InternalErrorWithError(err)
+		return nil, types.InternalErrorWithError(err)
 	}
 
-	return signerAddress, nil
+	return bucketInfo, nil
 }
 
-func ValidateUploadedBundle(bdl *sdk.Bundle, rule database.BundleRule) *models.Error {
+// ValidateUploadedBundle validates an uploaded bundle against the bundle rule, and reads every object out of the bundle
+// file to check it against the hash in the bundle meta. The objects without a hash get the hash computed with the
+// configured hash algorithm.
+func ValidateUploadedBundle(bdl *sdk.Bundle, bundleFile io.ReaderAt, rule database.BundleRule) *models.Error {
 	if int64(bdl.GetBundleSize()) > rule.MaxSize {
 		return types.ErrorBundleSizeExceedsLimit
 	}
@@ -294,14 +439,55 @@ func ValidateUploadedBundle(bdl *sdk.Bundle, rule database.BundleRule) *models.E
 		if err != nil {
 			return err
 		}
+
+		err = types.ValidateTagKeys(meta.Tags)
+		if err != nil {
+			return err
+		}
+	}
+
+	// the objects should lie within the object data before the meta of the bundle
+	dataSize := int64(bdl.GetBundleSize()) - int64(bdl.GetBundleMetaSize()) - bundleTypes.MetaSizeLength - bundleTypes.VersionLength
+	if err := types.ValidateBundleObjectsMeta(metaData, dataSize); err != nil {
+		return err
+	}
+
+	for _, meta := range metaData {
+		if err := verifyBundledObjectHash(meta, io.NewSectionReader(bundleFile, int64(meta.Offset), int64(meta.Size))); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// verifyBundledObjectHash checks the object read from the bundle file against its hash in the bundle meta, an object
+// without a hash is rejected, since the bundle meta on Greenfield should carry the hashes of the objects
+func verifyBundledObjectHash(meta *bundleTypes.ObjectMeta, object io.Reader) *models.Error {
+	if len(meta.Hash) == 0 {
+		util.Logger.Errorf("object has no hash in the bundle meta, object=%s", meta.Name)
+		return types.ErrorObjectHashMissing
 	}
 
+	hash, err := types.NewHash(meta.HashAlgo)
+	if err != nil {
+		util.Logger.Errorf("unsupported hash algorithm of object, object=%s, algo=%s", meta.Name, meta.HashAlgo.String())
+		return types.ErrorUnsupportedHashAlgo
+	}
+	if _, err := io.Copy(hash, object); err != nil {
+		util.Logger.Errorf("read object from bundle error, object=%s, err=%s", meta.Name, err.Error())
+		return types.InternalErrorWithError(err)
+	}
+	if !bytes.Equal(hash.Sum(nil), meta.Hash) {
+		util.Logger.Errorf("object does not match its hash in the bundle meta, object=%s, algo=%s", meta.Name, meta.HashAlgo.String())
+		return types.ErrorObjectHashMismatch
+	}
 	return nil
 }
 
 func HandleUploadBundle() func(params bundle.UploadBundleParams) middleware.Responder {
 	return func(params bundle.UploadBundleParams) middleware.Responder {
-		signerAddress, merr := ValidateUploadBundleRequest(params)
+		signerAddress, bucketOwner, merr := ValidateUploadBundleRequest(params)
 		if merr != nil {
 			return bundle.NewUploadBundleBadRequest().WithPayload(merr)
 		}
@@ -313,84 +499,121 @@ func HandleUploadBundle() func(params bundle.UploadBundleParams) middleware.Resp
 		}
 		defer os.Remove(tmpFile.Name())
 
-		_, err = io.Copy(tmpFile, params.File)
+		// the bundle file is hashed while it is written to the temporary file, so a swapped body is rejected
+		hash := sha256.New()
+		_, err = io.Copy(io.MultiWriter(tmpFile, hash), params.File)
 		if err != nil {
 			return bundle.NewUploadBundleInternalServerError().WithPayload(types.InternalErrorWithError(err))
 		}
 		defer tmpFile.Close()
 
-		// Open the file as a bundle
-		tmpBundle, err := sdk.NewBundleFromFile(tmpFile.Name())
-		if err != nil {
-			return bundle.NewUploadBundleInternalServerError().WithPayload(types.InternalErrorWithError(err))
+		calculatedHash := hex.EncodeToString(hash.Sum(nil))
+		if calculatedHash != strings.ToLower(params.XBundleContentSha256) {
+			util.Logger.Errorf("content hash does not match header hash, calculatedHash=%s, headerHash=%s", calculatedHash, params.XBundleContentSha256)
+			return bundle.NewUploadBundleBadRequest().WithPayload(types.InvalidFileContentErrorWithError(fmt.Errorf("content hash does not match header hash, calculatedHash=%s, headerHash=%s", calculatedHash, params.XBundleContentSha256)))
 		}
-		defer tmpBundle.Close()
 
-		// validate bundle
-		bundleRule, err := service.BundleRuleSvc.QueryBundleRule(signerAddress.String(), params.XBundleBucketName)
-		if err != nil {
-			util.Logger.Errorf("query bundle rule error, err=%s", err.Error())
-			return bundle.NewUploadBundleInternalServerError().WithPayload(types.InternalErrorWithError(err))
-		}
-		if err := ValidateUploadedBundle(tmpBundle, bundleRule); err != nil {
-			return bundle.NewUploadBundleBadRequest().WithPayload(err)
+		if merr := CreateUploadedBundle(params.HTTPRequest.Context(), signerAddress, bucketOwner, params.XBundleBucketName, params.XBundleName, tmpFile); merr != nil {
+			if merr.Code == types.ErrorInternalError.Code {
+				return bundle.NewUploadBundleInternalServerError().WithPayload(merr)
+			}
+			return bundle.NewUploadBundleBadRequest().WithPayload(merr)
 		}
 
-		// save bundle file first, then save records to database
-		_, err = tmpFile.Seek(0, io.SeekStart)
-		if err != nil {
-			return bundle.NewUploadBundleInternalServerError().WithPayload(types.InternalErrorWithError(err))
-		}
-		_, _, err = service.ObjectSvc.StoreBundleFile(params.XBundleBucketName, params.XBundleName, tmpFile)
-		if err != nil {
-			return bundle.NewUploadBundleInternalServerError().WithPayload(types.InternalErrorWithError(err))
-		}
+		return bundle.NewUploadBundleOK()
+	}
+}
 
-		// get objects from bundle
-		objects := make([]database.Object, 0, len(tmpBundle.GetBundleObjectsMeta()))
-		for _, meta := range tmpBundle.GetBundleObjectsMeta() {
-			tags, err := json.Marshal(meta.Tags)
-			if err != nil {
-				util.Logger.Errorf("marshal tags error, err=%s", err.Error())
-				return bundle.NewUploadBundleInternalServerError().WithPayload(types.InternalErrorWithError(err))
-			}
-			newObject := database.Object{
-				Bucket:         params.XBundleBucketName,
-				BundleName:     params.XBundleName,
-				ObjectName:     meta.Name,
-				ContentType:    meta.ContentType,
-				HashAlgo:       meta.HashAlgo,
-				Hash:           meta.Hash,
-				Owner:          signerAddress.String(),
-				Tags:           string(tags),
-				OffsetInBundle: int64(meta.Offset),
-				Size:           int64(meta.Size),
-			}
-			objects = append(objects, newObject)
-		}
-		newBundle := database.Bundle{
-			Owner:  signerAddress.String(),
-			Bucket: params.XBundleBucketName,
-			Name:   params.XBundleName,
-			Files:  int64(len(objects)),
-			Size:   int64(tmpBundle.GetBundleSize()),
-		}
+// CreateUploadedBundle validates an uploaded bundle file, stores it and creates the finalized bundle with its objects,
+// the bundle is owned by the owner of the bucket and its objects are uploaded by the signer. The returned error has the
+// internal error code if the failure is not caused by the bundle file.
+func CreateUploadedBundle(ctx context.Context, signerAddress common.Address, bucketOwner string, bucketName string, bundleName string, bundleFile *os.File) *models.Error {
+	// Open the file as a bundle
+	tmpBundle, err := sdk.NewBundleFromFile(bundleFile.Name())
+	if err != nil {
+		return types.InternalErrorWithError(err)
+	}
+	defer tmpBundle.Close()
 
-		// get bundler account for the user
-		bundlerAccount, err := service.UserBundlerAccountSvc.GetOrCreateUserBundlerAccount(newBundle.Owner)
-		if err != nil {
-			util.Logger.Errorf("get bundler account for user error, user=%s, err=%s", newBundle.Owner, err.Error())
-			return bundle.NewCreateBundleBadRequest().WithPayload(types.InternalErrorWithError(err))
-		}
-		newBundle.BundlerAccount = bundlerAccount.BundlerAddress
+	// validate bundle
+	bundleRule, err := service.BundleRuleSvc.QueryBundleRule(bucketOwner, bucketName)
+	if err != nil {
+		util.Logger.Errorf("query bundle rule error, err=%s", err.Error())
+		return types.InternalErrorWithError(err)
+	}
+	if err := ValidateUploadedBundle(tmpBundle, bundleFile, bundleRule); err != nil {
+		return err
+	}
 
-		// insert bundle and objects
-		newBundle, err = service.BundleSvc.CreateFinalizedBundleWithObjects(newBundle, objects)
+	// check if the object names are already used in the bucket, depending on the object name conflict policy
+	objectNames := make([]string, 0, len(tmpBundle.GetBundleObjectsMeta()))
+	for _, meta := range tmpBundle.GetBundleObjectsMeta() {
+		objectNames = append(objectNames, meta.Name)
+	}
+	conflicts, err := service.ObjectSvc.CheckObjectNameConflicts(bucketName, objectNames)
+	if err != nil {
+		util.Logger.Errorf("check object name conflicts error, bucket=%s, err=%s", bucketName, err.Error())
+		return types.InternalErrorWithError(err)
+	}
+	if len(conflicts) > 0 {
+		util.Logger.Errorf("object names already used in the bucket, bucket=%s, objects=%v", bucketName, conflicts)
+		return types.ErrorObjectAlreadyExists
+	}
+
+	_, err = bundleFile.Seek(0, io.SeekStart)
+	if err != nil {
+		return types.InternalErrorWithError(err)
+	}
+
+	// get objects from bundle
+	objects := make([]database.Object, 0, len(tmpBundle.GetBundleObjectsMeta()))
+	for _, meta := range tmpBundle.GetBundleObjectsMeta() {
+		tags, err := json.Marshal(meta.Tags)
 		if err != nil {
-			util.Logger.Errorf("create finalized bundle with objects error, bundle=%+v, err=%s", newBundle, err.Error())
-			return bundle.NewUploadBundleInternalServerError().WithPayload(types.InternalErrorWithError(err))
-		}
+			util.Logger.Errorf("marshal tags error, err=%s", err.Error())
+			return types.InternalErrorWithError(err)
+		}
+		newObject := database.Object{
+			Bucket:         bucketName,
+			BundleName:     bundleName,
+			ObjectName:     meta.Name,
+			ContentType:    meta.ContentType,
+			HashAlgo:       meta.HashAlgo,
+			Hash:           meta.Hash,
+			Owner:          bucketOwner,
+			Uploader:       signerAddress.String(),
+			Tags:           string(tags),
+			OffsetInBundle: int64(meta.Offset),
+			Size:           int64(meta.Size),
+		}
+		objects = append(objects, newObject)
+	}
+	newBundle := database.Bundle{
+		Owner:  bucketOwner,
+		Bucket: bucketName,
+		Name:   bundleName,
+		Files:  int64(len(objects)),
+		Size:   int64(tmpBundle.GetBundleSize()),
+	}
 
-		return bundle.NewUploadBundleOK()
+	// get bundler account for the user
+	bundlerAccount, err := service.UserBundlerAccountSvc.GetOrCreateUserBundlerAccount(newBundle.Owner)
+	if err != nil {
+		util.Logger.Errorf("get bundler account for user error, user=%s, err=%s", newBundle.Owner, err.Error())
+		return types.InternalErrorWithError(err)
 	}
+	newBundle.BundlerAccount = bundlerAccount.BundlerAddress
+
+	// insert bundle and objects, the bundle file is stored once the bundle is inserted, so it is not stored if the
+	// bundle fails to be created
+	newBundle, err = service.BundleSvc.CreateFinalizedBundleWithObjects(newBundle, objects, func() error {
+		_, _, err := service.ObjectSvc.StoreBundleFile(ctx, bucketName, bundleName, bundleFile)
+		return err
+	})
+	if err != nil {
+		util.Logger.Errorf("create finalized bundle with objects error, bundle=%+v, err=%s", newBundle, err.Error())
+		return types.InternalErrorWithError(err)
+	}
+
+	return nil
 }
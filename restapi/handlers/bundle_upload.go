@@ -0,0 +1,254 @@
+package handlers
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/node-real/greenfield-bundle-service/dao"
+	"github.com/node-real/greenfield-bundle-service/database"
+	"github.com/node-real/greenfield-bundle-service/models"
+	"github.com/node-real/greenfield-bundle-service/restapi/operations/bundle"
+	"github.com/node-real/greenfield-bundle-service/service"
+	"github.com/node-real/greenfield-bundle-service/types"
+	"github.com/node-real/greenfield-bundle-service/util"
+)
+
+// HandleInitiateBundleUpload handles the initiate bundle upload request
+func HandleInitiateBundleUpload() func(params bundle.InitiateBundleUploadParams) middleware.Responder {
+	return func(params bundle.InitiateBundleUploadParams) middleware.Responder {
+		// validate headers
+		signerAddress, merr := types.ValidateHeaders(params.HTTPRequest)
+		if merr != nil {
+			util.Logger.Errorf("sig check error, code=%d, msg=%s", merr.Code, merr.Message)
+			return bundle.NewInitiateBundleUploadBadRequest().WithPayload(merr)
+		}
+
+		bucketInfo, merr := ValidateNewBundle(signerAddress, params.XBundleBucketName, params.XBundleName)
+		if merr != nil {
+			if merr.Code == types.ErrorInternalError.Code {
+				return bundle.NewInitiateBundleUploadInternalServerError().WithPayload(merr)
+			}
+			return bundle.NewInitiateBundleUploadBadRequest().WithPayload(merr)
+		}
+
+		sha256 := strings.ToLower(params.XBundleFileSha256)
+		if hash, err := hex.DecodeString(sha256); err != nil || len(hash) != 32 {
+			return bundle.NewInitiateBundleUploadBadRequest().WithPayload(types.ErrorInvalidFileSha256)
+		}
+
+		// check the size of the bundle file, the bundle is validated against the bundle rule again on completion
+		bundleRule, err := service.BundleRuleSvc.QueryBundleRule(bucketInfo.Owner, params.XBundleBucketName)
+		if err != nil {
+			util.Logger.Errorf("query bundle rule error, err=%s", err.Error())
+			return bundle.NewInitiateBundleUploadInternalServerError().WithPayload(types.InternalErrorWithError(err))
+		}
+		if params.XBundleFileSize <= 0 || params.XBundleFileSize > types.MaxBundleSize || params.XBundleFileSize > bundleRule.MaxSize {
+			return bundle.NewInitiateBundleUploadBadRequest().WithPayload(types.ErrorBundleSizeExceedsLimit)
+		}
+
+		upload, err := service.BundleUploadSvc.InitiateBundleUpload(signerAddress.String(), params.XBundleBucketName, params.XBundleName, params.XBundleFileSize, sha256)
+		if err != nil {
+			return bundle.NewInitiateBundleUploadInternalServerError().WithPayload(types.InternalErrorWithError(err))
+		}
+
+		return bundle.NewInitiateBundleUploadOK().WithPayload(bundleUploadInfo(upload, nil))
+	}
+}
+
+// HandleUploadBundleChunk handles the upload bundle chunk request
+func HandleUploadBundleChunk() func(params bundle.UploadBundleChunkParams) middleware.Responder {
+	return func(params bundle.UploadBundleChunkParams) middleware.Responder {
+		defer params.Chunk.Close()
+
+		upload, merr := ValidateBundleUploadRequest(params.HTTPRequest, params.UploadID)
+		if merr != nil {
+			switch {
+			case merr == types.ErrorBundleUploadNotExist:
+				return bundle.NewUploadBundleChunkNotFound().WithPayload(merr)
+			case merr.Code == types.ErrorInternalError.Code:
+				return bundle.NewUploadBundleChunkInternalServerError().WithPayload(merr)
+			}
+			return bundle.NewUploadBundleChunkBadRequest().WithPayload(merr)
+		}
+		if upload.Status != database.BundleUploadStatusUploading {
+			return bundle.NewUploadBundleChunkConflict().WithPayload(types.ErrorInvalidBundleUploadStatus)
+		}
+
+		// the chunk is read twice, to verify it and to store it
+		chunk, ok := params.Chunk.(*runtime.File)
+		if !ok {
+			return bundle.NewUploadBundleChunkBadRequest().WithPayload(types.InvalidBundleUploadChunkErrorWithError(fmt.Errorf("chunk is not a file")))
+		}
+
+		err := service.BundleUploadSvc.UploadBundleChunk(params.HTTPRequest.Context(), upload, params.ChunkNumber, strings.ToLower(params.XBundleChunkSha256), chunk.Data)
+		switch {
+		case err == nil:
+			return bundle.NewUploadBundleChunkOK()
+		case errors.Is(err, service.ErrInvalidBundleUploadChunk):
+			return bundle.NewUploadBundleChunkBadRequest().WithPayload(types.InvalidBundleUploadChunkErrorWithError(err))
+		case errors.Is(err, dao.ErrBundleUploadNotUploading):
+			return bundle.NewUploadBundleChunkConflict().WithPayload(types.ErrorInvalidBundleUploadStatus)
+		}
+		return bundle.NewUploadBundleChunkInternalServerError().WithPayload(types.InternalErrorWithError(err))
+	}
+}
+
+// HandleQueryBundleUpload handles the query bundle upload request
+func HandleQueryBundleUpload() func(params bundle.QueryBundleUploadParams) middleware.Responder {
+	return func(params bundle.QueryBundleUploadParams) middleware.Responder {
+		upload, merr := ValidateBundleUploadRequest(params.HTTPRequest, params.UploadID)
+		if merr != nil {
+			switch {
+			case merr == types.ErrorBundleUploadNotExist:
+				return bundle.NewQueryBundleUploadNotFound().WithPayload(merr)
+			case merr.Code == types.ErrorInternalError.Code:
+				return bundle.NewQueryBundleUploadInternalServerError().WithPayload(merr)
+			}
+			return bundle.NewQueryBundleUploadBadRequest().WithPayload(merr)
+		}
+
+		chunks, err := service.BundleUploadSvc.GetBundleUploadChunks(upload.UploadId)
+		if err != nil {
+			return bundle.NewQueryBundleUploadInternalServerError().WithPayload(types.InternalErrorWithError(err))
+		}
+
+		return bundle.NewQueryBundleUploadOK().WithPayload(bundleUploadInfo(upload, chunks))
+	}
+}
+
+// HandleCompleteBundleUpload handles the complete bundle upload request, the assembled bundle file is created like
+// an uploaded bundle
+func HandleCompleteBundleUpload() func(params bundle.CompleteBundleUploadParams) middleware.Responder {
+	return func(params bundle.CompleteBundleUploadParams) middleware.Responder {
+		upload, merr := ValidateBundleUploadRequest(params.HTTPRequest, params.UploadID)
+		if merr != nil {
+			switch {
+			case merr == types.ErrorBundleUploadNotExist:
+				return bundle.NewCompleteBundleUploadNotFound().WithPayload(merr)
+			case merr.Code == types.ErrorInternalError.Code:
+				return bundle.NewCompleteBundleUploadInternalServerError().WithPayload(merr)
+			}
+			return bundle.NewCompleteBundleUploadBadRequest().WithPayload(merr)
+		}
+
+		// completing a completed upload again succeeds, so the request can be retried
+		if upload.Status == database.BundleUploadStatusCompleted {
+			return bundle.NewCompleteBundleUploadOK().WithPayload(bundleUploadInfo(upload, nil))
+		}
+
+		// the signer who initiated the upload should still be granted to upload objects to the bucket
+		signerAddress := common.HexToAddress(upload.Owner)
+		bucketInfo, merr := ValidateNewBundle(signerAddress, upload.Bucket, upload.BundleName)
+		if merr != nil {
+			if merr.Code == types.ErrorInternalError.Code {
+				return bundle.NewCompleteBundleUploadInternalServerError().WithPayload(merr)
+			}
+			return bundle.NewCompleteBundleUploadBadRequest().WithPayload(merr)
+		}
+
+		ok, err := service.BundleUploadSvc.StartBundleUploadCompletion(upload)
+		if err != nil {
+			return bundle.NewCompleteBundleUploadInternalServerError().WithPayload(types.InternalErrorWithError(err))
+		}
+		if !ok {
+			return bundle.NewCompleteBundleUploadConflict().WithPayload(types.ErrorInvalidBundleUploadStatus)
+		}
+
+		merr = completeBundleUpload(params.HTTPRequest, signerAddress, bucketInfo.Owner, upload)
+		if err := service.BundleUploadSvc.FinishBundleUploadCompletion(params.HTTPRequest.Context(), upload, merr == nil); err != nil && merr == nil {
+			merr = types.InternalErrorWithError(err)
+		}
+		if merr != nil {
+			if merr.Code == types.ErrorInternalError.Code {
+				return bundle.NewCompleteBundleUploadInternalServerError().WithPayload(merr)
+			}
+			return bundle.NewCompleteBundleUploadBadRequest().WithPayload(merr)
+		}
+
+		upload.Status = database.BundleUploadStatusCompleted
+		return bundle.NewCompleteBundleUploadOK().WithPayload(bundleUploadInfo(upload, nil))
+	}
+}
+
+// completeBundleUpload assembles the chunks of the upload into a temporary bundle file and creates the bundle from it
+func completeBundleUpload(req *http.Request, signerAddress common.Address, bucketOwner string, upload database.BundleUpload) *models.Error {
+	tmpFile, err := os.CreateTemp(os.TempDir(), "tmp-bundle-")
+	if err != nil {
+		return types.InternalErrorWithError(err)
+	}
+	defer os.Remove(tmpFile.Name())
+	defer tmpFile.Close()
+
+	err = service.BundleUploadSvc.AssembleBundleUpload(req.Context(), upload, tmpFile)
+	switch {
+	case errors.Is(err, service.ErrBundleUploadIncomplete):
+		return types.InvalidBundleUploadChunkErrorWithError(err)
+	case errors.Is(err, service.ErrBundleUploadHashMismatch):
+		return types.ErrorInvalidFileSha256
+	case err != nil:
+		return types.InternalErrorWithError(err)
+	}
+
+	return CreateUploadedBundle(req.Context(), signerAddress, bucketOwner, upload.Bucket, upload.BundleName, tmpFile)
+}
+
+// ValidateBundleUploadRequest validates the signature of a request to a bundle upload, which is only accessible to the
+// owner of the upload
+func ValidateBundleUploadRequest(req *http.Request, uploadId string) (database.BundleUpload, *models.Error) {
+	signerAddress, merr := types.ValidateHeaders(req)
+	if merr != nil {
+		util.Logger.Errorf("sig check error, code=%d, msg=%s", merr.Code, merr.Message)
+		return database.BundleUpload{}, merr
+	}
+
+	upload, err := service.BundleUploadSvc.GetBundleUpload(uploadId)
+	if err != nil {
+		return database.BundleUpload{}, types.InternalErrorWithError(err)
+	}
+	if upload.Id == 0 {
+		return database.BundleUpload{}, types.ErrorBundleUploadNotExist
+	}
+
+	if upload.Owner != signerAddress.String() {
+		util.Logger.Errorf("signer is not the owner of the bundle upload, signer=%s, upload=%s", signerAddress.String(), uploadId)
+		return database.BundleUpload{}, types.ErrorInvalidSignature
+	}
+
+	// the bucket of the request is checked for the session keys scoped to it
+	if bucketName := req.Header.Get(types.HTTPHeaderBucketName); bucketName != "" && bucketName != upload.Bucket {
+		return database.BundleUpload{}, types.InvalidBucketNameErrorWithError(fmt.Errorf("bucket name does not match the bundle upload"))
+	}
+
+	return upload, nil
+}
+
+func bundleUploadInfo(upload database.BundleUpload, chunks []*database.BundleUploadChunk) *models.BundleUploadInfo {
+	uploadedChunks := make([]*models.BundleUploadChunkInfo, 0, len(chunks))
+	for _, chunk := range chunks {
+		uploadedChunks = append(uploadedChunks, &models.BundleUploadChunkInfo{
+			Number: chunk.Number,
+			Size:   chunk.Size,
+			Sha256: chunk.Sha256,
+		})
+	}
+
+	return &models.BundleUploadInfo{
+		UploadID:        upload.UploadId,
+		BucketName:      upload.Bucket,
+		BundleName:      upload.BundleName,
+		Size:            upload.Size,
+		ChunkSize:       upload.ChunkSize,
+		Chunks:          upload.Chunks(),
+		UploadedChunks:  uploadedChunks,
+		Status:          upload.Status.String(),
+		ExpireTimestamp: upload.UpdatedAt.Add(service.BundleUploadExpiry).Unix(),
+	}
+}
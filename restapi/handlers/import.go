@@ -0,0 +1,98 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	gnfdtypes "github.com/bnb-chain/greenfield/x/storage/types"
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/node-real/greenfield-bundle-service/dao"
+	"github.com/node-real/greenfield-bundle-service/database"
+	"github.com/node-real/greenfield-bundle-service/models"
+	"github.com/node-real/greenfield-bundle-service/restapi/operations/bundle"
+	"github.com/node-real/greenfield-bundle-service/service"
+	"github.com/node-real/greenfield-bundle-service/types"
+	"github.com/node-real/greenfield-bundle-service/util"
+)
+
+// HandleImportBundle handles the import bundle request, the objects of the bundle sealed on Greenfield are indexed by
+// the bundler
+func HandleImportBundle() func(params bundle.ImportBundleParams) middleware.Responder {
+	return func(params bundle.ImportBundleParams) middleware.Responder {
+		// validate headers
+		signerAddress, merr := types.ValidateHeaders(params.HTTPRequest)
+		if merr != nil {
+			util.Logger.Errorf("sig check error, code=%d, msg=%s", merr.Code, merr.Message)
+			return bundle.NewImportBundleBadRequest().WithPayload(merr)
+		}
+
+		// check if the signer is the owner of the bucket
+		bucketInfo, err := service.BundleSvc.QueryBucketFromGnfd(params.XBundleBucketName)
+		if err != nil {
+			util.Logger.Errorf("query bucket error, err=%s", err.Error())
+			return bundle.NewImportBundleInternalServerError().WithPayload(types.InternalErrorWithError(err))
+		}
+		if bucketInfo.Owner != signerAddress.String() {
+			util.Logger.Errorf("signer is not the owner of the bucket, signer=%s, bucket=%s", signerAddress.String(), params.XBundleBucketName)
+			return bundle.NewImportBundleBadRequest().WithPayload(types.InvalidSignatureErrorWithError(fmt.Errorf("signer is not the owner of the bucket")))
+		}
+
+		// the names of the bundles created by the bundler should not be taken
+		if service.IsAutoGeneratedBundleName(params.XBundleName) {
+			util.Logger.Errorf("bundle name should not start with %s", service.BundleNamePrefix)
+			return bundle.NewImportBundleBadRequest().WithPayload(types.ErrorInvalidBundleName)
+		}
+		if err := types.ValidateBundleName(params.XBundleName); err != nil {
+			util.Logger.Errorf("invalid bundle name, err=%s", err.Message)
+			return bundle.NewImportBundleBadRequest().WithPayload(err)
+		}
+
+		// the bundle object should be sealed on Greenfield
+		objectDetail, err := service.BundleSvc.HeadObjectFromGnfd(params.XBundleBucketName, params.XBundleName)
+		if err != nil {
+			if service.IsObjectNotFoundError(err) {
+				return bundle.NewImportBundleNotFound().WithPayload(types.ErrorObjectNotExist)
+			}
+			return bundle.NewImportBundleInternalServerError().WithPayload(types.InternalErrorWithError(err))
+		}
+		if objectDetail.ObjectInfo.ObjectStatus != gnfdtypes.OBJECT_STATUS_SEALED {
+			return bundle.NewImportBundleBadRequest().WithPayload(types.ErrorInvalidBundleStatus)
+		}
+
+		// get bundler account for the user, which imports the objects of the bundle
+		bundlerAccount, err := service.UserBundlerAccountSvc.GetOrCreateUserBundlerAccount(bucketInfo.Owner)
+		if err != nil {
+			util.Logger.Errorf("get bundler account for user error, user=%s, err=%s", bucketInfo.Owner, err.Error())
+			return bundle.NewImportBundleInternalServerError().WithPayload(types.InternalErrorWithError(err))
+		}
+
+		importedBundle, err := service.BundleSvc.ImportBundle(database.Bundle{
+			Owner:          bucketInfo.Owner,
+			Bucket:         params.XBundleBucketName,
+			Name:           params.XBundleName,
+			BundlerAccount: bundlerAccount.BundlerAddress,
+			ObjectId:       objectDetail.ObjectInfo.Id.Uint64(),
+			Size:           int64(objectDetail.ObjectInfo.PayloadSize),
+		})
+		if err != nil {
+			if errors.Is(err, dao.ErrBundleExists) {
+				return bundle.NewImportBundleConflict().WithPayload(types.ErrorBundleExist)
+			}
+			return bundle.NewImportBundleInternalServerError().WithPayload(types.InternalErrorWithError(err))
+		}
+
+		return bundle.NewImportBundleOK().WithPayload(&models.BundleInfo{
+			BucketName:       importedBundle.Bucket,
+			BundleName:       importedBundle.Name,
+			Status:           int64(importedBundle.Status),
+			Files:            importedBundle.Files,
+			Size:             importedBundle.Size,
+			ErrorMessage:     importedBundle.ErrMessage,
+			CreatedTimestamp: importedBundle.CreatedAt.Unix(),
+			ObjectID:         strconv.FormatUint(importedBundle.ObjectId, 10),
+			TxHash:           importedBundle.TxHash,
+		})
+	}
+}
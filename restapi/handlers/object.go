@@ -5,14 +5,18 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"mime/multipart"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
 
+	"github.com/node-real/greenfield-bundle-service/dao"
 	"github.com/node-real/greenfield-bundle-service/database"
 	"github.com/node-real/greenfield-bundle-service/models"
 	"github.com/node-real/greenfield-bundle-service/restapi/operations/bundle"
@@ -21,8 +25,8 @@ import (
 	"github.com/node-real/greenfield-bundle-service/util"
 )
 
-// ValidateFileContent validates the file content against the hash in the header
-func ValidateFileContent(params bundle.UploadObjectParams) (io.ReadCloser, error) {
+// ValidateFileContent validates the file content against the hash in the header, and returns the file content
+func ValidateFileContent(params bundle.UploadObjectParams) ([]byte, error) {
 	// check tags
 	if params.XBundleTags != nil && *params.XBundleTags != "" {
 		// json unmarshal
@@ -61,10 +65,11 @@ func ValidateFileContent(params bundle.UploadObjectParams) (io.ReadCloser, error
 		return nil, fmt.Errorf("file hash does not match header hash, calculatedHash=%s, headerHash=%s", calculatedHash, params.XBundleFileSha256)
 	}
 
-	return io.NopCloser(bytes.NewReader(fileBytes)), nil
+	return fileBytes, nil
 }
 
-func GetBundlingBundle(bucketName string, signerAddress string) (database.Bundle, *models.Error) {
+// GetBundlingBundle returns the bundling bundle of the bucket, or creates it for the owner of the bucket
+func GetBundlingBundle(bucketName string, owner string) (database.Bundle, *models.Error) {
 	// get bundling bundle
 	bundlingBundle, err := service.BundleSvc.GetBundlingBundle(bucketName)
 	if err != nil {
@@ -76,7 +81,7 @@ func GetBundlingBundle(bucketName string, signerAddress string) (database.Bundle
 	if bundlingBundle.Id == 0 {
 		// create new bundle
 		newBundle := database.Bundle{
-			Owner:  signerAddress,
+			Owner:  owner,
 			Bucket: bucketName,
 		}
 
@@ -102,11 +107,12 @@ func GetBundlingBundle(bucketName string, signerAddress string) (database.Bundle
 func HandleUploadObject() func(params bundle.UploadObjectParams) middleware.Responder {
 	return func(params bundle.UploadObjectParams) middleware.Responder {
 		// check file content
-		file, err := ValidateFileContent(params)
+		fileBytes, err := ValidateFileContent(params)
 		if err != nil {
 			util.Logger.Errorf("validate file content error, err=%s", err.Error())
 			return bundle.NewUploadObjectBadRequest().WithPayload(types.InvalidFileContentErrorWithError(err))
 		}
+		file := io.NopCloser(bytes.NewReader(fileBytes))
 
 		// validate headers
 		signerAddress, merr := types.ValidateHeaders(params.HTTPRequest)
@@ -115,21 +121,16 @@ func HandleUploadObject() func(params bundle.UploadObjectParams) middleware.Resp
 			return bundle.NewUploadObjectBadRequest().WithPayload(merr)
 		}
 
-		// check if the signer is the owner of the bucket
-		bucketInfo, err := service.BundleSvc.QueryBucketFromGnfd(params.XBundleBucketName)
-		if err != nil {
-			util.Logger.Errorf("query bucket error, err=%s", err.Error())
-			return bundle.NewUploadObjectBadRequest().WithPayload(types.InternalErrorWithError(err))
-		}
-		if bucketInfo.Owner != signerAddress.String() {
-			util.Logger.Errorf("signer is not the owner of the bucket, signer=%s, bucket=%s", signerAddress.String(), params.XBundleBucketName)
-			return bundle.NewUploadObjectBadRequest().WithPayload(types.InvalidSignatureErrorWithError(fmt.Errorf("signer is not the owner of the bucket")))
+		// check if the signer can upload objects to the bucket
+		bucketInfo, merr := ValidateBucketUploader(signerAddress, params.XBundleBucketName)
+		if merr != nil {
+			return bundle.NewUploadObjectBadRequest().WithPayload(merr)
 		}
 
 		// get bundling bundle
-		bundlingBundle, merr := GetBundlingBundle(params.XBundleBucketName, signerAddress.String())
-		if err != nil {
-			util.Logger.Errorf("get bundling bundle error, bucket=%s, err=%s", params.XBundleBucketName, err.Error())
+		bundlingBundle, merr := GetBundlingBundle(params.XBundleBucketName, bucketInfo.Owner)
+		if merr != nil {
+			util.Logger.Errorf("get bundling bundle error, bucket=%s, code=%d, msg=%s", params.XBundleBucketName, merr.Code, merr.Message)
 			return bundle.NewUploadObjectInternalServerError().WithPayload(merr)
 		}
 
@@ -140,32 +141,47 @@ func HandleUploadObject() func(params bundle.UploadObjectParams) middleware.Resp
 			return bundle.NewUploadObjectBadRequest().WithPayload(types.ErrorBundleSizeExceedsLimit)
 		}
 
-		// check if the object already exists
+		// check if the object already exists, it is replaced if the request asks to overwrite it
 		queriedObject, err := service.ObjectSvc.GetObject(params.XBundleBucketName, bundlingBundle.Name, params.XBundleFileName)
 		if err != nil {
 			util.Logger.Errorf("get object error, bucket=%s, bundle=%s, object=%s, err=%s", params.XBundleBucketName, bundlingBundle.Name, params.XBundleFileName, err.Error())
 			return bundle.NewUploadObjectInternalServerError().WithPayload(types.InternalErrorWithError(err))
 		}
-		if queriedObject.Id != 0 {
+		overwrite := params.XBundleOverwrite != nil && *params.XBundleOverwrite
+		if queriedObject.Id != 0 && !overwrite {
 			util.Logger.Errorf("object already exists, bucket=%s, bundle=%s, object=%s", params.XBundleBucketName, bundlingBundle.Name, params.XBundleFileName)
 			return bundle.NewUploadObjectBadRequest().WithPayload(types.ErrorObjectAlreadyExists)
 		}
 
-		// save object file to local storage
-		_, fileSize, err := service.ObjectSvc.StoreObjectFile(params.XBundleBucketName, bundlingBundle.Name, params.XBundleFileName, file)
+		// check if the object name is already used in the bucket, depending on the object name conflict policy, the
+		// object which is overwritten does not conflict
+		if queriedObject.Id == 0 {
+			conflicts, err := service.ObjectSvc.CheckObjectNameConflicts(params.XBundleBucketName, []string{params.XBundleFileName})
+			if err != nil {
+				util.Logger.Errorf("check object name conflicts error, bucket=%s, object=%s, err=%s", params.XBundleBucketName, params.XBundleFileName, err.Error())
+				return bundle.NewUploadObjectInternalServerError().WithPayload(types.InternalErrorWithError(err))
+			}
+			if len(conflicts) > 0 {
+				util.Logger.Errorf("object name already used in the bucket, bucket=%s, object=%s", params.XBundleBucketName, params.XBundleFileName)
+				return bundle.NewUploadObjectBadRequest().WithPayload(types.ErrorObjectAlreadyExists)
+			}
+		}
+
+		// create object, with the hash of the verified file content which goes into the bundle meta
+		hashAlgo, hash, err := service.ObjectSvc.HashObjectFile(bytes.NewReader(fileBytes))
 		if err != nil {
-			util.Logger.Errorf("store object file error, err=%s", err.Error())
+			util.Logger.Errorf("hash object file error, err=%s", err.Error())
 			return bundle.NewUploadObjectInternalServerError().WithPayload(types.InternalErrorWithError(err))
 		}
-
-		// create object
 		newObject := database.Object{
 			Bucket:      params.XBundleBucketName,
 			BundleName:  bundlingBundle.Name,
 			ObjectName:  params.XBundleFileName,
-			Owner:       signerAddress.String(),
+			Owner:       bucketInfo.Owner,
+			Uploader:    signerAddress.String(),
 			ContentType: params.XBundleContentType,
-			Size:        fileSize,
+			HashAlgo:    hashAlgo,
+			Hash:        hash,
 		}
 
 		// check tags
@@ -180,10 +196,22 @@ func HandleUploadObject() func(params bundle.UploadObjectParams) middleware.Resp
 			}
 		}
 
-		_, err = service.ObjectSvc.CreateObjectForBundling(newObject)
-		if err != nil {
-			util.Logger.Errorf("create object error, object=%+v, err=%s", newObject, err.Error())
-			return bundle.NewUploadObjectInternalServerError().WithPayload(types.InternalErrorWithError(err))
+		if queriedObject.Id != 0 {
+			// the staged file is replaced while the bundling bundle is locked, so the bundle is not finalized meanwhile
+			_, err = service.ObjectSvc.OverwriteObjectForBundling(params.HTTPRequest.Context(), newObject, file)
+			if errors.Is(err, dao.ErrBundlingBundleChanged) {
+				return bundle.NewUploadObjectConflict().WithPayload(types.ErrorBundlingBundleChanged)
+			}
+			if err != nil {
+				return bundle.NewUploadObjectInternalServerError().WithPayload(types.InternalErrorWithError(err))
+			}
+		} else {
+			// the staged file is swapped in while the bundling bundle is locked, once the object is created
+			_, err = service.ObjectSvc.CreateObjectForBundling(params.HTTPRequest.Context(), newObject, file)
+			if err != nil {
+				util.Logger.Errorf("create object error, object=%+v, err=%s", newObject, err.Error())
+				return bundle.NewUploadObjectInternalServerError().WithPayload(types.InternalErrorWithError(err))
+			}
 		}
 
 		return bundle.NewUploadObjectOK().WithPayload(&models.UploadObjectResponse{
@@ -192,45 +220,309 @@ func HandleUploadObject() func(params bundle.UploadObjectParams) middleware.Resp
 	}
 }
 
-// HandleViewBundleObject handles the view bundle object request
-func HandleViewBundleObject() func(params bundle.ViewBundleObjectParams) middleware.Responder {
-	return func(params bundle.ViewBundleObjectParams) middleware.Responder {
-		if params.BucketName == "" || params.BundleName == "" || params.ObjectName == "" {
-			return bundle.NewViewBundleObjectBadRequest().WithPayload(types.InvalidParamsErrorWithError(fmt.Errorf("invalid params")))
+// HandleDeleteObject handles the delete object request, only the objects of the bundling bundle can be deleted
+func HandleDeleteObject() func(params bundle.DeleteObjectParams) middleware.Responder {
+	return func(params bundle.DeleteObjectParams) middleware.Responder {
+		// validate headers
+		signerAddress, merr := types.ValidateHeaders(params.HTTPRequest)
+		if merr != nil {
+			util.Logger.Errorf("sig check error, code=%d, msg=%s", merr.Code, merr.Message)
+			return bundle.NewDeleteObjectBadRequest().WithPayload(merr)
+		}
+
+		// check if the signer can upload objects to the bucket, like the uploads of the objects
+		if _, merr := ValidateBucketUploader(signerAddress, params.XBundleBucketName); merr != nil {
+			if merr.Code == types.ErrorInternalError.Code {
+				return bundle.NewDeleteObjectInternalServerError().WithPayload(merr)
+			}
+			return bundle.NewDeleteObjectBadRequest().WithPayload(merr)
 		}
 
-		object, err := service.ObjectSvc.GetObject(params.BucketName, params.BundleName, params.ObjectName)
+		var (
+			deletedObject database.Object
+			err           error
+		)
+		if params.XBundleName == nil {
+			deletedObject, err = service.ObjectSvc.DeleteObjectForBundling(params.HTTPRequest.Context(), params.XBundleBucketName, "", params.XBundleFileName)
+		} else {
+			// the objects of a named bundle can be deleted while it is bundling or once it is sealed
+			var queriedBundle *database.Bundle
+			queriedBundle, err = service.BundleSvc.QueryBundle(params.XBundleBucketName, *params.XBundleName)
+			if err != nil {
+				return bundle.NewDeleteObjectInternalServerError().WithPayload(types.InternalErrorWithError(err))
+			}
+			if queriedBundle.Id == 0 {
+				return bundle.NewDeleteObjectNotFound().WithPayload(types.ErrorBundleNotExist)
+			}
+
+			switch queriedBundle.Status {
+			case database.BundleStatusBundling:
+				// the bundle may be finalized meanwhile, which is checked again while it is locked
+				deletedObject, err = service.ObjectSvc.DeleteObjectForBundling(params.HTTPRequest.Context(), params.XBundleBucketName, *params.XBundleName, params.XBundleFileName)
+			case database.BundleStatusSealedOnChain:
+				deletedObject, err = service.ObjectSvc.TombstoneObject(params.HTTPRequest.Context(), params.XBundleBucketName, *params.XBundleName, params.XBundleFileName)
+			default:
+				return bundle.NewDeleteObjectBadRequest().WithPayload(types.ErrorInvalidBundleStatus)
+			}
+			if errors.Is(err, dao.ErrBundleNotSealed) || errors.Is(err, dao.ErrBundleNotBundling) {
+				return bundle.NewDeleteObjectBadRequest().WithPayload(types.ErrorInvalidBundleStatus)
+			}
+		}
 		if err != nil {
-			util.Logger.Errorf("get object error, bucket=%s, bundle=%s, object=%s, err=%s", params.BucketName, params.BundleName, params.ObjectName, err.Error())
-			return bundle.NewViewBundleObjectInternalServerError().WithPayload(types.InternalErrorWithError(err))
+			return bundle.NewDeleteObjectInternalServerError().WithPayload(types.InternalErrorWithError(err))
+		}
+		if deletedObject.Id == 0 {
+			return bundle.NewDeleteObjectNotFound().WithPayload(types.ErrorObjectNotExist)
 		}
 
-		if object.Id == 0 {
-			return bundle.NewViewBundleObjectNotFound()
+		return bundle.NewDeleteObjectOK()
+	}
+}
+
+// ValidateUploadObjectsManifest validates the manifest of the upload objects request against the hash in the header
+func ValidateUploadObjectsManifest(params bundle.UploadObjectsParams) ([]types.UploadObjectsEntry, *models.Error) {
+	manifest, err := io.ReadAll(io.LimitReader(params.Manifest, types.MaxUploadObjectsManifestSize))
+	if err != nil {
+		util.Logger.Errorf("read manifest error, err=%s", err.Error())
+		return nil, types.InvalidManifestErrorWithError(err)
+	}
+
+	hash := sha256.Sum256(manifest)
+	if !strings.EqualFold(hex.EncodeToString(hash[:]), params.XBundleManifestSha256) {
+		return nil, types.InvalidManifestErrorWithError(fmt.Errorf("manifest hash does not match header hash"))
+	}
+
+	return types.ParseUploadObjectsManifest(manifest)
+}
+
+// ValidateUploadObjectsFiles validates the files of the upload objects request against the manifest, and returns the
+// headers of the files in the order of the manifest
+func ValidateUploadObjectsFiles(r *http.Request, entries []types.UploadObjectsEntry) ([]*multipart.FileHeader, error) {
+	if r.MultipartForm == nil {
+		return nil, fmt.Errorf("files are missing")
+	}
+	fileHeaders := r.MultipartForm.File["files"]
+	if len(fileHeaders) != len(entries) {
+		return nil, fmt.Errorf("the manifest has %d objects but there are %d files", len(entries), len(fileHeaders))
+	}
+
+	for i, fileHeader := range fileHeaders {
+		if fileHeader.Size > types.DefaultMaxFileSize {
+			return nil, fmt.Errorf("file size exceeds limit, object=%s, size=%d", entries[i].Name, fileHeader.Size)
 		}
 
-		objectFile, err := service.ObjectSvc.GetObjectFile(params.BucketName, params.BundleName, params.ObjectName)
+		file, err := fileHeader.Open()
 		if err != nil {
-			util.Logger.Errorf("get object file error, bucket=%s, bundle=%s, object=%s, err=%s", params.BucketName, params.BundleName, params.ObjectName, err.Error())
-			return bundle.NewViewBundleObjectInternalServerError().WithPayload(types.InternalErrorWithError(err))
+			return nil, err
+		}
+		hash := sha256.New()
+		_, err = io.Copy(hash, file)
+		_ = file.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		if calculatedHash := hex.EncodeToString(hash.Sum(nil)); !strings.EqualFold(calculatedHash, entries[i].Sha256) {
+			return nil, fmt.Errorf("file hash does not match manifest hash, object=%s, calculatedHash=%s, manifestHash=%s", entries[i].Name, calculatedHash, entries[i].Sha256)
+		}
+	}
+	return fileHeaders, nil
+}
+
+// HandleUploadObjects handles the upload objects request
+func HandleUploadObjects() func(params bundle.UploadObjectsParams) middleware.Responder {
+	return func(params bundle.UploadObjectsParams) middleware.Responder {
+		// validate headers
+		signerAddress, merr := types.ValidateHeaders(params.HTTPRequest)
+		if merr != nil {
+			util.Logger.Errorf("sig check error, code=%d, msg=%s", merr.Code, merr.Message)
+			return bundle.NewUploadObjectsBadRequest().WithPayload(merr)
+		}
+
+		// check manifest and files
+		entries, merr := ValidateUploadObjectsManifest(params)
+		if merr != nil {
+			util.Logger.Errorf("validate manifest error, code=%d, msg=%s", merr.Code, merr.Message)
+			return bundle.NewUploadObjectsBadRequest().WithPayload(merr)
+		}
+		fileHeaders, err := ValidateUploadObjectsFiles(params.HTTPRequest, entries)
+		if err != nil {
+			util.Logger.Errorf("validate files error, err=%s", err.Error())
+			return bundle.NewUploadObjectsBadRequest().WithPayload(types.InvalidFileContentErrorWithError(err))
+		}
+
+		// check if the signer can upload objects to the bucket
+		bucketInfo, merr := ValidateBucketUploader(signerAddress, params.XBundleBucketName)
+		if merr != nil {
+			return bundle.NewUploadObjectsBadRequest().WithPayload(merr)
+		}
+
+		// get bundling bundle
+		bundlingBundle, merr := GetBundlingBundle(params.XBundleBucketName, bucketInfo.Owner)
+		if merr != nil {
+			util.Logger.Errorf("get bundling bundle error, bucket=%s, code=%d, msg=%s", params.XBundleBucketName, merr.Code, merr.Message)
+			return bundle.NewUploadObjectsInternalServerError().WithPayload(merr)
+		}
+
+		// check if the objects already exist in the bundling bundle, which is the latest bundle of the bucket
+		objectNames := make([]string, 0, len(entries))
+		for _, entry := range entries {
+			objectNames = append(objectNames, entry.Name)
+		}
+		existingObjects, err := service.ObjectSvc.GetLatestObjects(params.XBundleBucketName, objectNames)
+		if err != nil {
+			util.Logger.Errorf("get latest objects error, bucket=%s, err=%s", params.XBundleBucketName, err.Error())
+			return bundle.NewUploadObjectsInternalServerError().WithPayload(types.InternalErrorWithError(err))
+		}
+		for _, existingObject := range existingObjects {
+			if existingObject.BundleName == bundlingBundle.Name {
+				util.Logger.Errorf("object already exists, bucket=%s, bundle=%s, object=%s", params.XBundleBucketName, bundlingBundle.Name, existingObject.ObjectName)
+				return bundle.NewUploadObjectsBadRequest().WithPayload(types.ErrorObjectAlreadyExists)
+			}
+		}
+
+		// check if the object names are already used in the bucket, depending on the object name conflict policy
+		conflicts, err := service.ObjectSvc.CheckObjectNameConflicts(params.XBundleBucketName, objectNames)
+		if err != nil {
+			util.Logger.Errorf("check object name conflicts error, bucket=%s, err=%s", params.XBundleBucketName, err.Error())
+			return bundle.NewUploadObjectsInternalServerError().WithPayload(types.InternalErrorWithError(err))
+		}
+		if len(conflicts) > 0 {
+			util.Logger.Errorf("object names already used in the bucket, bucket=%s, objects=%v", params.XBundleBucketName, conflicts)
+			return bundle.NewUploadObjectsBadRequest().WithPayload(types.ErrorObjectAlreadyExists)
+		}
+
+		newObjects := make([]database.Object, 0, len(entries))
+		for i, entry := range entries {
+			newObjects = append(newObjects, database.Object{
+				Bucket:      params.XBundleBucketName,
+				ObjectName:  entry.Name,
+				Owner:       bucketInfo.Owner,
+				Uploader:    signerAddress.String(),
+				ContentType: entry.ContentType,
+				Size:        fileHeaders[i].Size,
+				Tags:        entry.TagsString(),
+			})
 		}
 
-		response := &http.Response{
-			StatusCode: http.StatusOK,
-			Body:       objectFile,
+		// add the objects to the bundling bundle, and roll over to new bundles if they do not fit in it
+		newBundles, err := service.ObjectSvc.PlanObjectsForBundling(bundlingBundle, newObjects)
+		if err != nil {
+			if errors.Is(err, service.ErrBundleLimitExceeded) {
+				util.Logger.Errorf("bundle size exceeds limit, bucket=%s, bundle=%s, size=%d, maxSize=%d, files=%d, maxFiles=%d", params.XBundleBucketName, bundlingBundle.Name, bundlingBundle.Size, bundlingBundle.MaxSize, bundlingBundle.Files, bundlingBundle.MaxFiles)
+				return bundle.NewUploadObjectsBadRequest().WithPayload(types.ErrorBundleSizeExceedsLimit)
+			}
+			util.Logger.Errorf("plan objects error, bucket=%s, err=%s", params.XBundleBucketName, err.Error())
+			return bundle.NewUploadObjectsInternalServerError().WithPayload(types.InternalErrorWithError(err))
 		}
 
-		return middleware.ResponderFunc(func(w http.ResponseWriter, _ runtime.Producer) {
-			w.Header().Set("Content-Disposition", "inline")
-			w.Header().Set("Content-Type", object.ContentType)
-			_, err := io.Copy(w, response.Body)
+		// hash the object files, which are stored with the objects in one transaction
+		openedFiles := make([]multipart.File, 0, len(fileHeaders))
+		defer func() {
+			for _, file := range openedFiles {
+				_ = file.Close()
+			}
+		}()
+		files := make([]io.Reader, 0, len(fileHeaders))
+		for i, fileHeader := range fileHeaders {
+			file, err := fileHeader.Open()
+			if err != nil {
+				util.Logger.Errorf("open file error, object=%s, err=%s", newObjects[i].ObjectName, err.Error())
+				return bundle.NewUploadObjectsInternalServerError().WithPayload(types.InternalErrorWithError(err))
+			}
+			openedFiles = append(openedFiles, file)
+			files = append(files, file)
+			newObjects[i].HashAlgo, newObjects[i].Hash, err = service.ObjectSvc.HashObjectFile(file)
+			if err == nil {
+				_, err = file.Seek(0, io.SeekStart)
+			}
 			if err != nil {
-				util.Logger.Errorf("copy object file error, err=%s", err.Error())
+				util.Logger.Errorf("hash object file error, object=%s, err=%s", newObjects[i].ObjectName, err.Error())
+				return bundle.NewUploadObjectsInternalServerError().WithPayload(types.InternalErrorWithError(err))
 			}
+		}
+
+		err = service.ObjectSvc.CreateObjectsForBundling(params.HTTPRequest.Context(), bundlingBundle, newBundles, newObjects, files)
+		if err != nil {
+			if errors.Is(err, dao.ErrBundlingBundleChanged) {
+				return bundle.NewUploadObjectsConflict().WithPayload(types.ErrorBundlingBundleChanged)
+			}
+			return bundle.NewUploadObjectsInternalServerError().WithPayload(types.InternalErrorWithError(err))
+		}
+
+		uploadedObjects := make([]*models.UploadedObject, 0, len(newObjects))
+		for _, newObject := range newObjects {
+			uploadedObjects = append(uploadedObjects, &models.UploadedObject{
+				ObjectName: newObject.ObjectName,
+				BundleName: newObject.BundleName,
+			})
+		}
+		return bundle.NewUploadObjectsOK().WithPayload(&models.UploadObjectsResponse{
+			Objects: uploadedObjects,
 		})
 	}
 }
 
+// HandleViewBundleObject handles the view bundle object request
+func HandleViewBundleObject() func(params bundle.ViewBundleObjectParams) middleware.Responder {
+	return func(params bundle.ViewBundleObjectParams) middleware.Responder {
+		if params.BucketName == "" || params.BundleName == "" || params.ObjectName == "" {
+			return bundle.NewViewBundleObjectBadRequest().WithPayload(types.InvalidParamsErrorWithError(fmt.Errorf("invalid params")))
+		}
+
+		if merr := ValidateObjectReadAccess(params.HTTPRequest, params.BucketName, params.BundleName, params.ObjectName); merr != nil {
+			if merr.Code == types.ErrorInternalError.Code {
+				return bundle.NewViewBundleObjectInternalServerError().WithPayload(merr)
+			}
+			return bundle.NewViewBundleObjectForbidden().WithPayload(merr)
+		}
+
+		return viewBundleObject(params)
+	}
+}
+
+// viewBundleObject serves the object of the view bundle object request, whose read access is validated
+func viewBundleObject(params bundle.ViewBundleObjectParams) middleware.Responder {
+	object, err := service.ObjectSvc.GetObject(params.BucketName, params.BundleName, params.ObjectName)
+	if err != nil {
+		util.Logger.Errorf("get object error, bucket=%s, bundle=%s, object=%s, err=%s", params.BucketName, params.BundleName, params.ObjectName, err.Error())
+		return bundle.NewViewBundleObjectInternalServerError().WithPayload(types.InternalErrorWithError(err))
+	}
+
+	if object.Id == 0 {
+		return bundle.NewViewBundleObjectNotFound()
+	}
+
+	if object.Migrated {
+		objectURL, err := service.UnbundleSvc.GetMigratedObjectURL(params.HTTPRequest.Context(), object.Bucket, object.ObjectName, false)
+		if err != nil {
+			return bundle.NewViewBundleObjectInternalServerError().WithPayload(types.InternalErrorWithError(err))
+		}
+		return migratedObjectResponder(objectURL)
+	}
+
+	if types.CheckNotModified(params.HTTPRequest.Header, types.ObjectETag(object.Hash), object.CreatedAt) {
+		return notModifiedResponder(object)
+	}
+
+	byteRange, err := objectFileRange(params.HTTPRequest, object)
+	if err != nil {
+		return rangeNotSatisfiableResponder(object)
+	}
+
+	var off, limit int64
+	if byteRange != nil {
+		off, limit = byteRange.Start, byteRange.Length
+	}
+	objectFile, err := service.ObjectSvc.GetObjectFile(params.HTTPRequest.Context(), params.BucketName, params.BundleName, params.ObjectName, off, limit)
+	if err != nil {
+		util.Logger.Errorf("get object file error, bucket=%s, bundle=%s, object=%s, err=%s", params.BucketName, params.BundleName, params.ObjectName, err.Error())
+		return bundle.NewViewBundleObjectInternalServerError().WithPayload(types.InternalErrorWithError(err))
+	}
+
+	return objectFileResponder(objectFile, object, byteRange, "inline")
+}
+
 // HandleDownloadBundleObject handles the download bundle object request
 func HandleDownloadBundleObject() func(params bundle.DownloadBundleObjectParams) middleware.Responder {
 	return func(params bundle.DownloadBundleObjectParams) middleware.Responder {
@@ -238,34 +530,275 @@ func HandleDownloadBundleObject() func(params bundle.DownloadBundleObjectParams)
 			return bundle.NewViewBundleObjectBadRequest().WithPayload(types.InvalidParamsErrorWithError(fmt.Errorf("invalid params")))
 		}
 
-		object, err := service.ObjectSvc.GetObject(params.BucketName, params.BundleName, params.ObjectName)
+		if merr := ValidateObjectReadAccess(params.HTTPRequest, params.BucketName, params.BundleName, params.ObjectName); merr != nil {
+			if merr.Code == types.ErrorInternalError.Code {
+				return bundle.NewDownloadBundleObjectInternalServerError().WithPayload(merr)
+			}
+			return bundle.NewDownloadBundleObjectForbidden().WithPayload(merr)
+		}
+
+		return downloadBundleObject(params)
+	}
+}
+
+// downloadBundleObject serves the object of the download bundle object request, whose read access is validated
+func downloadBundleObject(params bundle.DownloadBundleObjectParams) middleware.Responder {
+	object, err := service.ObjectSvc.GetObject(params.BucketName, params.BundleName, params.ObjectName)
+	if err != nil {
+		util.Logger.Errorf("get object error, bucket=%s, bundle=%s, object=%s, err=%s", params.BucketName, params.BundleName, params.ObjectName, err.Error())
+		return bundle.NewViewBundleObjectInternalServerError().WithPayload(types.InternalErrorWithError(err))
+	}
+
+	if object.Id == 0 {
+		return bundle.NewViewBundleObjectNotFound()
+	}
+
+	if object.Migrated {
+		objectURL, err := service.UnbundleSvc.GetMigratedObjectURL(params.HTTPRequest.Context(), object.Bucket, object.ObjectName, true)
 		if err != nil {
-			util.Logger.Errorf("get object error, bucket=%s, bundle=%s, object=%s, err=%s", params.BucketName, params.BundleName, params.ObjectName, err.Error())
 			return bundle.NewViewBundleObjectInternalServerError().WithPayload(types.InternalErrorWithError(err))
 		}
+		return migratedObjectResponder(objectURL)
+	}
+
+	if types.CheckNotModified(params.HTTPRequest.Header, types.ObjectETag(object.Hash), object.CreatedAt) {
+		return notModifiedResponder(object)
+	}
+
+	byteRange, err := objectFileRange(params.HTTPRequest, object)
+	if err != nil {
+		return rangeNotSatisfiableResponder(object)
+	}
+
+	var off, limit int64
+	if byteRange != nil {
+		off, limit = byteRange.Start, byteRange.Length
+	}
+	objectFile, err := service.ObjectSvc.GetObjectFile(params.HTTPRequest.Context(), params.BucketName, params.BundleName, params.ObjectName, off, limit)
+	if err != nil {
+		util.Logger.Errorf("get object file error, bucket=%s, bundle=%s, object=%s, err=%s", params.BucketName, params.BundleName, params.ObjectName, err.Error())
+		return bundle.NewViewBundleObjectInternalServerError().WithPayload(types.InternalErrorWithError(err))
+	}
+
+	return objectFileResponder(objectFile, object, byteRange, fmt.Sprintf("attachment; filename=%s", object.ObjectName))
+}
+
+// objectFileRange returns the range of the object file requested by the Range and If-Range headers of the request,
+// nil means the whole object file is requested
+func objectFileRange(r *http.Request, object database.Object) (*types.ByteRange, error) {
+	rangeHeader := r.Header.Get(types.HTTPHeaderRange)
+	if rangeHeader == "" || !types.CheckIfRange(r.Header, types.ObjectETag(object.Hash), object.CreatedAt) {
+		return nil, nil
+	}
+	return types.ParseRange(rangeHeader, object.Size)
+}
+
+// setObjectValidatorHeaders sets the headers used by clients to validate their copy of the object file
+func setObjectValidatorHeaders(w http.ResponseWriter, object database.Object) {
+	if etag := types.ObjectETag(object.Hash); etag != "" {
+		w.Header().Set("ETag", etag)
+	}
+	if !object.CreatedAt.IsZero() {
+		w.Header().Set("Last-Modified", object.CreatedAt.UTC().Format(http.TimeFormat))
+	}
+}
+
+// objectFileResponder writes the object file, or the range of it if byteRange is not nil
+func objectFileResponder(objectFile io.ReadCloser, object database.Object, byteRange *types.ByteRange, contentDisposition string) middleware.Responder {
+	return middleware.ResponderFunc(func(w http.ResponseWriter, _ runtime.Producer) {
+		defer objectFile.Close()
+
+		setObjectValidatorHeaders(w, object)
+		w.Header().Set("Accept-Ranges", "bytes")
+		w.Header().Set("Content-Disposition", contentDisposition)
+		w.Header().Set("Content-Type", object.ContentType)
+		if byteRange != nil {
+			w.Header().Set("Content-Range", byteRange.ContentRange(object.Size))
+			w.Header().Set("Content-Length", strconv.FormatInt(byteRange.Length, 10))
+			w.WriteHeader(http.StatusPartialContent)
+		} else {
+			w.Header().Set("Content-Length", strconv.FormatInt(object.Size, 10))
+			w.WriteHeader(http.StatusOK)
+		}
+
+		_, err := io.Copy(w, objectFile)
+		if err != nil {
+			util.Logger.Errorf("copy object file error, err=%s", err.Error())
+		}
+	})
+}
+
+// notModifiedResponder tells the client its copy of the object file is still valid
+func notModifiedResponder(object database.Object) middleware.Responder {
+	return middleware.ResponderFunc(func(w http.ResponseWriter, _ runtime.Producer) {
+		setObjectValidatorHeaders(w, object)
+		w.WriteHeader(http.StatusNotModified)
+	})
+}
+
+// rangeNotSatisfiableResponder tells the client the requested range is out of the object file
+func rangeNotSatisfiableResponder(object database.Object) middleware.Responder {
+	return middleware.ResponderFunc(func(w http.ResponseWriter, _ runtime.Producer) {
+		w.Header().Set("Content-Range", fmt.Sprintf("bytes */%d", object.Size))
+		w.WriteHeader(http.StatusRequestedRangeNotSatisfiable)
+	})
+}
+
+// HandleViewObject handles the view object request, which resolves the latest object with the name in the bucket
+func HandleViewObject() func(params bundle.ViewObjectParams) middleware.Responder {
+	return func(params bundle.ViewObjectParams) middleware.Responder {
+		if params.BucketName == "" || params.ObjectName == "" {
+			return bundle.NewViewObjectBadRequest().WithPayload(types.InvalidParamsErrorWithError(fmt.Errorf("invalid params")))
+		}
+
+		// the access is validated before the object is resolved, so the names of private objects are not revealed
+		if merr := ValidateObjectReadAccess(params.HTTPRequest, params.BucketName, "", params.ObjectName); merr != nil {
+			if merr.Code == types.ErrorInternalError.Code {
+				return bundle.NewViewObjectInternalServerError().WithPayload(merr)
+			}
+			return bundle.NewViewObjectForbidden().WithPayload(merr)
+		}
+
+		object, err := service.ObjectSvc.GetLatestObject(params.BucketName, params.ObjectName)
+		if err != nil {
+			util.Logger.Errorf("get latest object error, bucket=%s, object=%s, err=%s", params.BucketName, params.ObjectName, err.Error())
+			return bundle.NewViewObjectInternalServerError().WithPayload(types.InternalErrorWithError(err))
+		}
 
 		if object.Id == 0 {
-			return bundle.NewViewBundleObjectNotFound()
+			return bundle.NewViewObjectNotFound()
+		}
+
+		return viewBundleObject(bundle.ViewBundleObjectParams{
+			HTTPRequest: params.HTTPRequest,
+			BucketName:  object.Bucket,
+			BundleName:  object.BundleName,
+			ObjectName:  object.ObjectName,
+		})
+	}
+}
+
+// HandleDownloadObject handles the download object request, which resolves the latest object with the name in the
+// bucket
+func HandleDownloadObject() func(params bundle.DownloadObjectParams) middleware.Responder {
+	return func(params bundle.DownloadObjectParams) middleware.Responder {
+		if params.BucketName == "" || params.ObjectName == "" {
+			return bundle.NewDownloadObjectBadRequest().WithPayload(types.InvalidParamsErrorWithError(fmt.Errorf("invalid params")))
 		}
 
-		objectFile, err := service.ObjectSvc.GetObjectFile(params.BucketName, params.BundleName, params.ObjectName)
+		// the access is validated before the object is resolved, so the names of private objects are not revealed
+		if merr := ValidateObjectReadAccess(params.HTTPRequest, params.BucketName, "", params.ObjectName); merr != nil {
+			if merr.Code == types.ErrorInternalError.Code {
+				return bundle.NewDownloadObjectInternalServerError().WithPayload(merr)
+			}
+			return bundle.NewDownloadObjectForbidden().WithPayload(merr)
+		}
+
+		object, err := service.ObjectSvc.GetLatestObject(params.BucketName, params.ObjectName)
 		if err != nil {
-			util.Logger.Errorf("get object file error, bucket=%s, bundle=%s, object=%s, err=%s", params.BucketName, params.BundleName, params.ObjectName, err.Error())
-			return bundle.NewViewBundleObjectInternalServerError().WithPayload(types.InternalErrorWithError(err))
+			util.Logger.Errorf("get latest object error, bucket=%s, object=%s, err=%s", params.BucketName, params.ObjectName, err.Error())
+			return bundle.NewDownloadObjectInternalServerError().WithPayload(types.InternalErrorWithError(err))
 		}
 
-		response := &http.Response{
-			StatusCode: http.StatusOK,
-			Body:       objectFile,
+		if object.Id == 0 {
+			return bundle.NewDownloadObjectNotFound()
+		}
+
+		return downloadBundleObject(bundle.DownloadBundleObjectParams{
+			HTTPRequest: params.HTTPRequest,
+			BucketName:  object.Bucket,
+			BundleName:  object.BundleName,
+			ObjectName:  object.ObjectName,
+		})
+	}
+}
+
+// SplitBundleObjectPath splits the object path of the view and download routes into the bundle name and the object
+// name, it returns false if the path does not point to an object in a bundle of the bucket, in which case the path is
+// the name of the object
+func SplitBundleObjectPath(bucketName string, objectPath string) (string, string, bool) {
+	bundleName, objectName, found := strings.Cut(objectPath, "/")
+	if !found || bundleName == "" || objectName == "" {
+		return "", "", false
+	}
+
+	object, err := service.ObjectSvc.GetObject(bucketName, bundleName, objectName)
+	if err != nil {
+		util.Logger.Errorf("get object error, bucket=%s, bundle=%s, object=%s, err=%s", bucketName, bundleName, objectName, err.Error())
+		// let the bundle object handlers report the error
+		return bundleName, objectName, true
+	}
+	return bundleName, objectName, object.Id != 0
+}
+
+// HandleListObjects handles the list objects request
+func HandleListObjects() func(params bundle.ListObjectsParams) middleware.Responder {
+	return func(params bundle.ListObjectsParams) middleware.Responder {
+		// the objects of a private bucket can only be queried by the accounts which can read it
+		if merr := ValidateBucketReadAccess(params.HTTPRequest, params.BucketName); merr != nil {
+			if merr.Code == types.ErrorInternalError.Code {
+				return bundle.NewListObjectsInternalServerError().WithPayload(merr)
+			}
+			return bundle.NewListObjectsForbidden().WithPayload(merr)
 		}
 
-		return middleware.ResponderFunc(func(w http.ResponseWriter, _ runtime.Producer) {
-			w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%s", object.ObjectName))
-			w.Header().Set("Content-Type", object.ContentType)
-			_, err := io.Copy(w, response.Body)
+		filter := dao.ObjectFilter{TagValue: params.TagValue}
+		if params.BundleName != nil {
+			filter.BundleName = *params.BundleName
+		}
+		if params.NamePrefix != nil {
+			filter.NamePrefix = *params.NamePrefix
+		}
+		if params.ContentType != nil {
+			filter.ContentType = *params.ContentType
+		}
+		if params.TagKey != nil {
+			filter.TagKey = *params.TagKey
+		}
+		if filter.TagKey == "" && filter.TagValue != nil {
+			return bundle.NewListObjectsBadRequest().WithPayload(types.InvalidParamsErrorWithError(fmt.Errorf("tagValue requires tagKey")))
+		}
+
+		var cursor string
+		if params.Cursor != nil {
+			cursor = *params.Cursor
+		}
+
+		objects, nextCursor, err := service.ObjectSvc.ListObjects(params.BucketName, filter, cursor, int(*params.Limit))
+		if err != nil {
+			if errors.Is(err, service.ErrInvalidCursor) {
+				return bundle.NewListObjectsBadRequest().WithPayload(types.InvalidParamsErrorWithError(err))
+			}
+			util.Logger.Errorf("list objects error, bucket=%s, err=%s", params.BucketName, err.Error())
+			return bundle.NewListObjectsInternalServerError().WithPayload(types.InternalErrorWithError(err))
+		}
+
+		objectInfos := make([]*models.ObjectInfo, 0, len(objects))
+		for _, object := range objects {
+			tags, err := database.ParseObjectTags(object.Tags)
 			if err != nil {
-				util.Logger.Errorf("copy object file error, err=%s", err.Error())
+				util.Logger.Warnf("unmarshal tags failed, tags=%s, err=%v", object.Tags, err.Error())
 			}
+			objectInfos = append(objectInfos, &models.ObjectInfo{
+				BucketName:       object.Bucket,
+				BundleName:       object.BundleName,
+				ObjectName:       object.ObjectName,
+				ContentType:      object.ContentType,
+				Size:             object.Size,
+				Offset:           object.OffsetInBundle,
+				Hash:             hex.EncodeToString(object.Hash),
+				HashAlgo:         object.HashAlgo.String(),
+				Owner:            object.Owner,
+				Uploader:         object.Uploader,
+				Tags:             tags,
+				Migrated:         object.Migrated,
+				CreatedTimestamp: object.CreatedAt.Unix(),
+			})
+		}
+
+		return bundle.NewListObjectsOK().WithPayload(&models.ListObjectsResponse{
+			Objects:    objectInfos,
+			NextCursor: nextCursor,
 		})
 	}
 }
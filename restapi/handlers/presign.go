@@ -0,0 +1,171 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	gnfdtypes "github.com/bnb-chain/greenfield/x/storage/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/node-real/greenfield-bundle-service/cache"
+	"github.com/node-real/greenfield-bundle-service/models"
+	"github.com/node-real/greenfield-bundle-service/restapi/operations/bundle"
+	"github.com/node-real/greenfield-bundle-service/service"
+	"github.com/node-real/greenfield-bundle-service/types"
+	"github.com/node-real/greenfield-bundle-service/util"
+)
+
+// HandlePresign handles the presign request, it returns the urls to view and download the object without a signature
+// until they expire
+func HandlePresign() func(params bundle.PresignParams) middleware.Responder {
+	return func(params bundle.PresignParams) middleware.Responder {
+		// validate headers
+		signerAddress, merr := types.ValidateHeaders(params.HTTPRequest)
+		if merr != nil {
+			util.Logger.Errorf("sig check error, code=%d, msg=%s", merr.Code, merr.Message)
+			return bundle.NewPresignBadRequest().WithPayload(merr)
+		}
+
+		var bundleName string
+		if params.XBundleName != nil {
+			bundleName = *params.XBundleName
+		}
+		if bundleName != "" {
+			if merr := types.ValidateBundleName(bundleName); merr != nil {
+				return bundle.NewPresignBadRequest().WithPayload(merr)
+			}
+		}
+
+		now := time.Now().Unix()
+		if params.XBundlePresignExpiry <= now || params.XBundlePresignExpiry-now > types.MaxPresignExpiryAge {
+			return bundle.NewPresignBadRequest().WithPayload(types.InvalidParamsErrorWithError(fmt.Errorf("presign expiry should be within %d seconds in the future", types.MaxPresignExpiryAge)))
+		}
+
+		// check if the signer can get objects in the bucket
+		bucketInfo, err := service.BundleSvc.QueryBucketFromGnfd(params.XBundleBucketName)
+		if err != nil {
+			util.Logger.Errorf("query bucket error, err=%s", err.Error())
+			return bundle.NewPresignInternalServerError().WithPayload(types.InternalErrorWithError(err))
+		}
+		if merr := validateBucketReader(bucketInfo, signerAddress); merr != nil {
+			if merr.Code == types.ErrorInternalError.Code {
+				return bundle.NewPresignInternalServerError().WithPayload(merr)
+			}
+			return bundle.NewPresignForbidden().WithPayload(merr)
+		}
+
+		query, err := types.PresignObject(signerAddress, params.XBundleBucketName, bundleName, params.XBundleFileName, params.XBundlePresignExpiry)
+		if err != nil {
+			util.Logger.Errorf("presign object error, bucket=%s, object=%s, err=%s", params.XBundleBucketName, params.XBundleFileName, err.Error())
+			return bundle.NewPresignInternalServerError().WithPayload(types.InternalErrorWithError(err))
+		}
+
+		var viewURL, downloadURL *url.URL
+		if bundleName != "" {
+			viewURL, err = (&bundle.ViewBundleObjectURL{BucketName: params.XBundleBucketName, BundleName: bundleName, ObjectName: params.XBundleFileName}).Build()
+			if err == nil {
+				downloadURL, err = (&bundle.DownloadBundleObjectURL{BucketName: params.XBundleBucketName, BundleName: bundleName, ObjectName: params.XBundleFileName}).Build()
+			}
+		} else {
+			viewURL, err = (&bundle.ViewObjectURL{BucketName: params.XBundleBucketName, ObjectName: params.XBundleFileName}).Build()
+			if err == nil {
+				downloadURL, err = (&bundle.DownloadObjectURL{BucketName: params.XBundleBucketName, ObjectName: params.XBundleFileName}).Build()
+			}
+		}
+		if err != nil {
+			return bundle.NewPresignBadRequest().WithPayload(types.InvalidParamsErrorWithError(err))
+		}
+		viewURL.RawQuery = query.Encode()
+		downloadURL.RawQuery = query.Encode()
+
+		return bundle.NewPresignOK().WithPayload(&models.PresignResponse{
+			ViewURL:         viewURL.String(),
+			DownloadURL:     downloadURL.String(),
+			ExpiryTimestamp: params.XBundlePresignExpiry,
+		})
+	}
+}
+
+// ValidateObjectReadAccess checks that the object in the bundle of the bucket can be read by the request, the objects
+// of the public buckets can be read by anyone, the objects of the other buckets can be read by a request signed by an
+// account which can get objects in the bucket, or by a request to an unexpired presigned url. An empty bundle name
+// means the latest object with the name in the bucket.
+func ValidateObjectReadAccess(req *http.Request, bucketName string, bundleName string, objectName string) *models.Error {
+	bucketInfo, merr := queryReadBucket(bucketName)
+	if merr != nil {
+		return merr
+	}
+	if bucketInfo.Visibility == gnfdtypes.VISIBILITY_TYPE_PUBLIC_READ {
+		return nil
+	}
+
+	var (
+		readerAddress common.Address
+		err           error
+	)
+	if types.IsPresignedRequest(req) {
+		readerAddress, err = types.VerifyPresignedRequest(req, bucketName, bundleName, objectName)
+		if err != nil {
+			util.Logger.Errorf("presigned url check error, bucket=%s, object=%s, err=%s", bucketName, objectName, err.Error())
+			return types.InvalidPresignedUrlErrorWithError(err)
+		}
+	} else {
+		readerAddress, merr = types.ValidateHeaders(req)
+		if merr != nil {
+			util.Logger.Errorf("sig check error, code=%d, msg=%s", merr.Code, merr.Message)
+			return merr
+		}
+	}
+
+	// the permission of the signer of a presigned url is checked again, so revoking it revokes the url
+	return validateBucketReader(bucketInfo, readerAddress)
+}
+
+// ValidateBucketReadAccess checks that the metadata of the bundles and objects of the bucket can be queried by the
+// request, the metadata of the public buckets can be queried by anyone, the metadata of the other buckets can be
+// queried by a request signed by an account which can get objects in the bucket
+func ValidateBucketReadAccess(req *http.Request, bucketName string) *models.Error {
+	bucketInfo, merr := queryReadBucket(bucketName)
+	if merr != nil {
+		return merr
+	}
+	if bucketInfo.Visibility == gnfdtypes.VISIBILITY_TYPE_PUBLIC_READ {
+		return nil
+	}
+
+	readerAddress, merr := types.ValidateHeaders(req)
+	if merr != nil {
+		util.Logger.Errorf("sig check error, code=%d, msg=%s", merr.Code, merr.Message)
+		return merr
+	}
+	return validateBucketReader(bucketInfo, readerAddress)
+}
+
+// queryReadBucket queries the bucket to be read, a missing bucket denies the access, so the requests do not tell the
+// missing buckets from the private ones
+func queryReadBucket(bucketName string) (*gnfdtypes.BucketInfo, *models.Error) {
+	bucketInfo, err := service.BundleSvc.QueryBucketFromGnfd(bucketName)
+	if err != nil {
+		if cache.IsBucketNotFoundError(err) {
+			return nil, types.AccessDeniedErrorWithError(fmt.Errorf("bucket does not exist"))
+		}
+		return nil, types.InternalErrorWithError(err)
+	}
+	return bucketInfo, nil
+}
+
+// validateBucketReader checks that the reader can get objects in the bucket
+func validateBucketReader(bucketInfo *gnfdtypes.BucketInfo, readerAddress common.Address) *models.Error {
+	isReader, err := service.BundleSvc.IsBucketReader(bucketInfo, readerAddress)
+	if err != nil {
+		return types.InternalErrorWithError(err)
+	}
+	if !isReader {
+		util.Logger.Errorf("signer is not granted to get objects in the bucket, signer=%s, bucket=%s", readerAddress.String(), bucketInfo.BucketName)
+		return types.AccessDeniedErrorWithError(fmt.Errorf("signer is neither the owner of the bucket nor granted to get objects in it"))
+	}
+	return nil
+}
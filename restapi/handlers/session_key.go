@@ -0,0 +1,163 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/node-real/greenfield-bundle-service/dao"
+	"github.com/node-real/greenfield-bundle-service/database"
+	"github.com/node-real/greenfield-bundle-service/models"
+	"github.com/node-real/greenfield-bundle-service/restapi/operations/bundle"
+	"github.com/node-real/greenfield-bundle-service/service"
+	"github.com/node-real/greenfield-bundle-service/types"
+	"github.com/node-real/greenfield-bundle-service/util"
+)
+
+// HandleCreateSessionKey handles create session key request, the session key is registered for the signer, which
+// should own all the buckets the session key can access
+func HandleCreateSessionKey() func(params bundle.CreateSessionKeyParams) middleware.Responder {
+	return func(params bundle.CreateSessionKeyParams) middleware.Responder {
+		// validate headers
+		signerAddress, merr := types.ValidateHeaders(params.HTTPRequest)
+		if merr != nil {
+			util.Logger.Errorf("sig check error, code=%d, msg=%s", merr.Code, merr.Message)
+			return bundle.NewCreateSessionKeyBadRequest().WithPayload(merr)
+		}
+
+		sessionKey, merr := ValidateNewSessionKey(signerAddress, params)
+		if merr != nil {
+			return bundle.NewCreateSessionKeyBadRequest().WithPayload(merr)
+		}
+
+		// check if the signer is the owner of the buckets
+		for _, bucketName := range strings.Split(sessionKey.Buckets, ",") {
+			bucketInfo, err := service.BundleSvc.QueryBucketFromGnfd(bucketName)
+			if err != nil {
+				util.Logger.Errorf("query bucket error, err=%s", err.Error())
+				return bundle.NewCreateSessionKeyInternalServerError().WithPayload(types.InternalErrorWithError(err))
+			}
+			if bucketInfo.Owner != signerAddress.String() {
+				util.Logger.Errorf("signer is not the owner of the bucket, signer=%s, bucket=%s", signerAddress.String(), bucketName)
+				return bundle.NewCreateSessionKeyBadRequest().WithPayload(types.InvalidSignatureErrorWithError(fmt.Errorf("signer is not the owner of the bucket %s", bucketName)))
+			}
+		}
+
+		createdSessionKey, err := service.SessionKeySvc.CreateSessionKey(sessionKey)
+		if errors.Is(err, dao.ErrSessionKeyExists) {
+			return bundle.NewCreateSessionKeyConflict().WithPayload(types.ErrorSessionKeyExist)
+		}
+		if err != nil {
+			return bundle.NewCreateSessionKeyInternalServerError().WithPayload(types.InternalErrorWithError(err))
+		}
+
+		return bundle.NewCreateSessionKeyOK().WithPayload(sessionKeyInfo(createdSessionKey))
+	}
+}
+
+// HandleRevokeSessionKey handles revoke session key request, the requests signed by the session key are rejected
+// afterwards
+func HandleRevokeSessionKey() func(params bundle.RevokeSessionKeyParams) middleware.Responder {
+	return func(params bundle.RevokeSessionKeyParams) middleware.Responder {
+		// validate headers
+		signerAddress, merr := types.ValidateHeaders(params.HTTPRequest)
+		if merr != nil {
+			util.Logger.Errorf("sig check error, code=%d, msg=%s", merr.Code, merr.Message)
+			return bundle.NewRevokeSessionKeyBadRequest().WithPayload(merr)
+		}
+
+		if !common.IsHexAddress(params.XBundleSessionKeyAddress) {
+			return bundle.NewRevokeSessionKeyBadRequest().WithPayload(types.ErrorInvalidSessionKey)
+		}
+
+		revoked, err := service.SessionKeySvc.RevokeSessionKey(signerAddress.String(), common.HexToAddress(params.XBundleSessionKeyAddress).String())
+		if err != nil {
+			return bundle.NewRevokeSessionKeyInternalServerError().WithPayload(types.InternalErrorWithError(err))
+		}
+		if !revoked {
+			return bundle.NewRevokeSessionKeyNotFound().WithPayload(types.ErrorSessionKeyNotExist)
+		}
+
+		return bundle.NewRevokeSessionKeyOK()
+	}
+}
+
+// ValidateNewSessionKey validates the params of a session key registered by the signer, and returns the session key
+// to create
+func ValidateNewSessionKey(signerAddress common.Address, params bundle.CreateSessionKeyParams) (database.SessionKey, *models.Error) {
+	if !common.IsHexAddress(params.XBundleSessionKeyAddress) {
+		return database.SessionKey{}, types.InvalidSessionKeyErrorWithError(errors.New("session key address is invalid"))
+	}
+	address := common.HexToAddress(params.XBundleSessionKeyAddress)
+	if address == signerAddress {
+		return database.SessionKey{}, types.InvalidSessionKeyErrorWithError(errors.New("session key should not be the signer"))
+	}
+
+	var buckets []string
+	seen := make(map[string]struct{})
+	for _, bucketName := range strings.Split(params.XBundleSessionKeyBuckets, ",") {
+		bucketName = strings.TrimSpace(bucketName)
+		if merr := types.ValidateBucketName(bucketName); merr != nil {
+			return database.SessionKey{}, merr
+		}
+		if _, ok := seen[bucketName]; ok {
+			continue
+		}
+		seen[bucketName] = struct{}{}
+		buckets = append(buckets, bucketName)
+	}
+	if len(buckets) > types.MaxSessionKeyBuckets {
+		return database.SessionKey{}, types.InvalidSessionKeyErrorWithError(fmt.Errorf("session key can access at most %d buckets", types.MaxSessionKeyBuckets))
+	}
+
+	scopes, err := types.ParseSessionKeyScopes(params.XBundleSessionKeyScopes)
+	if err != nil {
+		return database.SessionKey{}, types.InvalidSessionKeyErrorWithError(err)
+	}
+
+	now := time.Now().Unix()
+	if params.XBundleSessionKeyExpiry <= now || params.XBundleSessionKeyExpiry-now > types.MaxSessionKeyExpiryAge {
+		return database.SessionKey{}, types.InvalidSessionKeyErrorWithError(fmt.Errorf("session key expiry should be within %d seconds in the future", types.MaxSessionKeyExpiryAge))
+	}
+
+	var maxRequests, maxUploadSize int64
+	if params.XBundleSessionKeyMaxRequests != nil {
+		maxRequests = *params.XBundleSessionKeyMaxRequests
+	}
+	if params.XBundleSessionKeyMaxUploadSize != nil {
+		maxUploadSize = *params.XBundleSessionKeyMaxUploadSize
+	}
+	if maxRequests < 0 || maxUploadSize < 0 {
+		return database.SessionKey{}, types.InvalidSessionKeyErrorWithError(errors.New("session key quotas should not be negative"))
+	}
+
+	return database.SessionKey{
+		Address:       address.String(),
+		Owner:         signerAddress.String(),
+		Buckets:       strings.Join(buckets, ","),
+		Scopes:        strings.Join(scopes, ","),
+		ExpireAt:      time.Unix(params.XBundleSessionKeyExpiry, 0),
+		MaxRequests:   maxRequests,
+		MaxUploadSize: maxUploadSize,
+	}, nil
+}
+
+func sessionKeyInfo(sessionKey database.SessionKey) *models.SessionKeyInfo {
+	return &models.SessionKeyInfo{
+		SessionKey:       sessionKey.Address,
+		Owner:            sessionKey.Owner,
+		Buckets:          strings.Split(sessionKey.Buckets, ","),
+		Scopes:           strings.Split(sessionKey.Scopes, ","),
+		ExpiryTimestamp:  sessionKey.ExpireAt.Unix(),
+		MaxRequests:      sessionKey.MaxRequests,
+		MaxUploadSize:    sessionKey.MaxUploadSize,
+		UsedRequests:     sessionKey.UsedRequests,
+		UsedUploadSize:   sessionKey.UsedUploadSize,
+		Revoked:          sessionKey.Revoked,
+		CreatedTimestamp: sessionKey.CreatedAt.Unix(),
+	}
+}
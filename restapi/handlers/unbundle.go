@@ -0,0 +1,107 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/node-real/greenfield-bundle-service/database"
+	"github.com/node-real/greenfield-bundle-service/models"
+	"github.com/node-real/greenfield-bundle-service/restapi/operations/bundle"
+	"github.com/node-real/greenfield-bundle-service/service"
+	"github.com/node-real/greenfield-bundle-service/types"
+	"github.com/node-real/greenfield-bundle-service/util"
+)
+
+// HandleUnbundleObject handles the unbundle object request, the object is copied to a standalone object on Greenfield
+// by the bundler
+func HandleUnbundleObject() func(params bundle.UnbundleObjectParams) middleware.Responder {
+	return func(params bundle.UnbundleObjectParams) middleware.Responder {
+		// validate headers
+		signerAddress, merr := types.ValidateHeaders(params.HTTPRequest)
+		if merr != nil {
+			util.Logger.Errorf("sig check error, code=%d, msg=%s", merr.Code, merr.Message)
+			return bundle.NewUnbundleObjectBadRequest().WithPayload(merr)
+		}
+
+		// check if the signer is the owner of the bucket
+		bucketInfo, err := service.BundleSvc.QueryBucketFromGnfd(params.XBundleBucketName)
+		if err != nil {
+			util.Logger.Errorf("query bucket error, err=%s", err.Error())
+			return bundle.NewUnbundleObjectInternalServerError().WithPayload(types.InternalErrorWithError(err))
+		}
+		if bucketInfo.Owner != signerAddress.String() {
+			util.Logger.Errorf("signer is not the owner of the bucket, signer=%s, bucket=%s", signerAddress.String(), params.XBundleBucketName)
+			return bundle.NewUnbundleObjectBadRequest().WithPayload(types.InvalidSignatureErrorWithError(fmt.Errorf("signer is not the owner of the bucket")))
+		}
+
+		object, err := service.ObjectSvc.GetObject(params.XBundleBucketName, params.XBundleName, params.XBundleFileName)
+		if err != nil {
+			return bundle.NewUnbundleObjectInternalServerError().WithPayload(types.InternalErrorWithError(err))
+		}
+		if object.Id == 0 {
+			return bundle.NewUnbundleObjectNotFound().WithPayload(types.ErrorObjectNotExist)
+		}
+
+		// the job of the object is returned until it fails, so the request can be repeated to follow the job
+		job, err := service.UnbundleSvc.GetUnbundleJob(object.Id)
+		if err != nil {
+			return bundle.NewUnbundleObjectInternalServerError().WithPayload(types.InternalErrorWithError(err))
+		}
+		if job.Id != 0 && job.Status != database.UnbundleJobStatusFailed {
+			return bundle.NewUnbundleObjectOK().WithPayload(unbundleJobInfo(job))
+		}
+
+		queriedBundle, err := service.BundleSvc.QueryBundle(params.XBundleBucketName, params.XBundleName)
+		if err != nil {
+			return bundle.NewUnbundleObjectInternalServerError().WithPayload(types.InternalErrorWithError(err))
+		}
+		if queriedBundle.Id == 0 {
+			return bundle.NewUnbundleObjectNotFound().WithPayload(types.ErrorBundleNotExist)
+		}
+		if queriedBundle.Status != database.BundleStatusSealedOnChain {
+			return bundle.NewUnbundleObjectBadRequest().WithPayload(types.ErrorInvalidBundleStatus)
+		}
+
+		// the standalone object has the name of the object, which should not be taken in the bucket
+		if job.Id == 0 {
+			_, err = service.BundleSvc.HeadObjectFromGnfd(params.XBundleBucketName, params.XBundleFileName)
+			if err == nil {
+				return bundle.NewUnbundleObjectConflict().WithPayload(types.ErrorGnfdObjectExist)
+			}
+			if !service.IsObjectNotFoundError(err) {
+				return bundle.NewUnbundleObjectInternalServerError().WithPayload(types.InternalErrorWithError(err))
+			}
+		}
+
+		job, err = service.UnbundleSvc.UnbundleObject(*queriedBundle, object)
+		if err != nil {
+			return bundle.NewUnbundleObjectInternalServerError().WithPayload(types.InternalErrorWithError(err))
+		}
+
+		return bundle.NewUnbundleObjectOK().WithPayload(unbundleJobInfo(job))
+	}
+}
+
+// migratedObjectResponder redirects the client to the standalone object of a migrated object
+func migratedObjectResponder(objectURL string) middleware.Responder {
+	return middleware.ResponderFunc(func(w http.ResponseWriter, _ runtime.Producer) {
+		w.Header().Set("Location", objectURL)
+		w.WriteHeader(http.StatusFound)
+	})
+}
+
+func unbundleJobInfo(job database.UnbundleJob) *models.UnbundleJobInfo {
+	return &models.UnbundleJobInfo{
+		BucketName:       job.Bucket,
+		BundleName:       job.BundleName,
+		ObjectName:       job.ObjectName,
+		Status:           job.Status.String(),
+		ObjectID:         int64(job.GnfdObjectId),
+		ErrMessage:       job.ErrMessage,
+		CreatedTimestamp: job.CreatedAt.Unix(),
+		UpdatedTimestamp: job.UpdatedAt.Unix(),
+	}
+}
@@ -34,7 +34,7 @@ func NewDownloadBundleObject(ctx *middleware.Context, handler DownloadBundleObje
 
 # Download an object as a file from a bundle
 
-Download a specific object from a given bundle and returns it as a file.
+Download a specific object from a given bundle and returns it as a file. Single byte range requests (Range, If-Range) and conditional requests (If-None-Match, If-Modified-Since) are supported using the ETag and Last-Modified of the object.
 */
 type DownloadBundleObject struct {
 	Context *middleware.Context
@@ -57,6 +57,74 @@ func (o *DownloadBundleObjectOK) WriteResponse(rw http.ResponseWriter, producer
 	}
 }
 
+// DownloadBundleObjectPartialContentCode is the HTTP code returned for type DownloadBundleObjectPartialContent
+const DownloadBundleObjectPartialContentCode int = 206
+
+/*
+DownloadBundleObjectPartialContent Successfully retrieved the requested range of the file
+
+swagger:response downloadBundleObjectPartialContent
+*/
+type DownloadBundleObjectPartialContent struct {
+
+	/*
+	  In: Body
+	*/
+	Payload io.ReadCloser `json:"body,omitempty"`
+}
+
+// NewDownloadBundleObjectPartialContent creates DownloadBundleObjectPartialContent with default headers values
+func NewDownloadBundleObjectPartialContent() *DownloadBundleObjectPartialContent {
+
+	return &DownloadBundleObjectPartialContent{}
+}
+
+// WithPayload adds the payload to the download bundle object partial content response
+func (o *DownloadBundleObjectPartialContent) WithPayload(payload io.ReadCloser) *DownloadBundleObjectPartialContent {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the download bundle object partial content response
+func (o *DownloadBundleObjectPartialContent) SetPayload(payload io.ReadCloser) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DownloadBundleObjectPartialContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(206)
+	payload := o.Payload
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// DownloadBundleObjectNotModifiedCode is the HTTP code returned for type DownloadBundleObjectNotModified
+const DownloadBundleObjectNotModifiedCode int = 304
+
+/*
+DownloadBundleObjectNotModified The file is not modified since the version in the conditional request headers
+
+swagger:response downloadBundleObjectNotModified
+*/
+type DownloadBundleObjectNotModified struct {
+}
+
+// NewDownloadBundleObjectNotModified creates DownloadBundleObjectNotModified with default headers values
+func NewDownloadBundleObjectNotModified() *DownloadBundleObjectNotModified {
+
+	return &DownloadBundleObjectNotModified{}
+}
+
+// WriteResponse to the client
+func (o *DownloadBundleObjectNotModified) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(304)
+}
+
 // DownloadBundleObjectBadRequestCode is the HTTP code returned for type DownloadBundleObjectBadRequest
 const DownloadBundleObjectBadRequestCode int = 400
 
@@ -102,6 +170,51 @@ func (o *DownloadBundleObjectBadRequest) WriteResponse(rw http.ResponseWriter, p
 	}
 }
 
+// DownloadBundleObjectForbiddenCode is the HTTP code returned for type DownloadBundleObjectForbidden
+const DownloadBundleObjectForbiddenCode int = 403
+
+/*
+DownloadBundleObjectForbidden The bucket is private and the request is not signed by an account which can get objects in it
+
+swagger:response downloadBundleObjectForbidden
+*/
+type DownloadBundleObjectForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewDownloadBundleObjectForbidden creates DownloadBundleObjectForbidden with default headers values
+func NewDownloadBundleObjectForbidden() *DownloadBundleObjectForbidden {
+
+	return &DownloadBundleObjectForbidden{}
+}
+
+// WithPayload adds the payload to the download bundle object forbidden response
+func (o *DownloadBundleObjectForbidden) WithPayload(payload *models.Error) *DownloadBundleObjectForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the download bundle object forbidden response
+func (o *DownloadBundleObjectForbidden) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DownloadBundleObjectForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // DownloadBundleObjectNotFoundCode is the HTTP code returned for type DownloadBundleObjectNotFound
 const DownloadBundleObjectNotFoundCode int = 404
 
@@ -147,6 +260,31 @@ func (o *DownloadBundleObjectNotFound) WriteResponse(rw http.ResponseWriter, pro
 	}
 }
 
+// DownloadBundleObjectRequestedRangeNotSatisfiableCode is the HTTP code returned for type DownloadBundleObjectRequestedRangeNotSatisfiable
+const DownloadBundleObjectRequestedRangeNotSatisfiableCode int = 416
+
+/*
+DownloadBundleObjectRequestedRangeNotSatisfiable The requested range is not satisfiable
+
+swagger:response downloadBundleObjectRequestedRangeNotSatisfiable
+*/
+type DownloadBundleObjectRequestedRangeNotSatisfiable struct {
+}
+
+// NewDownloadBundleObjectRequestedRangeNotSatisfiable creates DownloadBundleObjectRequestedRangeNotSatisfiable with default headers values
+func NewDownloadBundleObjectRequestedRangeNotSatisfiable() *DownloadBundleObjectRequestedRangeNotSatisfiable {
+
+	return &DownloadBundleObjectRequestedRangeNotSatisfiable{}
+}
+
+// WriteResponse to the client
+func (o *DownloadBundleObjectRequestedRangeNotSatisfiable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(416)
+}
+
 // DownloadBundleObjectInternalServerErrorCode is the HTTP code returned for type DownloadBundleObjectInternalServerError
 const DownloadBundleObjectInternalServerErrorCode int = 500
 
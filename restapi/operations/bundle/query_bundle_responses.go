@@ -103,6 +103,51 @@ func (o *QueryBundleBadRequest) WriteResponse(rw http.ResponseWriter, producer r
 	}
 }
 
+// QueryBundleForbiddenCode is the HTTP code returned for type QueryBundleForbidden
+const QueryBundleForbiddenCode int = 403
+
+/*
+QueryBundleForbidden The bucket is private and the request is not signed by an account which can get objects in it
+
+swagger:response queryBundleForbidden
+*/
+type QueryBundleForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewQueryBundleForbidden creates QueryBundleForbidden with default headers values
+func NewQueryBundleForbidden() *QueryBundleForbidden {
+
+	return &QueryBundleForbidden{}
+}
+
+// WithPayload adds the payload to the query bundle forbidden response
+func (o *QueryBundleForbidden) WithPayload(payload *models.Error) *QueryBundleForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the query bundle forbidden response
+func (o *QueryBundleForbidden) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *QueryBundleForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // QueryBundleNotFoundCode is the HTTP code returned for type QueryBundleNotFound
 const QueryBundleNotFoundCode int = 404
 
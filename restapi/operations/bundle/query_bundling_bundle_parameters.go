@@ -11,6 +11,7 @@ import (
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 )
 
 // NewQueryBundlingBundleParams creates a new QueryBundlingBundleParams object
@@ -30,6 +31,14 @@ type QueryBundlingBundleParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
+	/*Digital signature of an account which can get objects in the bucket, required to query a private bucket
+	  In: header
+	*/
+	Authorization *string
+	/*Expiry timestamp of the signed request
+	  In: header
+	*/
+	XBundleExpiryTimestamp *int64
 	/*The bucketName of the bundle
 	  Required: true
 	  In: path
@@ -46,6 +55,14 @@ func (o *QueryBundlingBundleParams) BindRequest(r *http.Request, route *middlewa
 
 	o.HTTPRequest = r
 
+	if err := o.bindAuthorization(r.Header[http.CanonicalHeaderKey("Authorization")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.bindXBundleExpiryTimestamp(r.Header[http.CanonicalHeaderKey("X-Bundle-Expiry-Timestamp")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	rBucketName, rhkBucketName, _ := route.Params.GetOK("bucketName")
 	if err := o.bindBucketName(rBucketName, rhkBucketName, route.Formats); err != nil {
 		res = append(res, err)
@@ -56,6 +73,45 @@ func (o *QueryBundlingBundleParams) BindRequest(r *http.Request, route *middlewa
 	return nil
 }
 
+// bindAuthorization binds and validates parameter Authorization from header.
+func (o *QueryBundlingBundleParams) bindAuthorization(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Authorization = &raw
+
+	return nil
+}
+
+// bindXBundleExpiryTimestamp binds and validates parameter XBundleExpiryTimestamp from header.
+func (o *QueryBundlingBundleParams) bindXBundleExpiryTimestamp(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("X-Bundle-Expiry-Timestamp", "header", "int64", raw)
+	}
+	o.XBundleExpiryTimestamp = &value
+
+	return nil
+}
+
 // bindBucketName binds and validates parameter BucketName from path.
 func (o *QueryBundlingBundleParams) bindBucketName(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
@@ -58,6 +58,96 @@ func (o *QueryBundlingBundleOK) WriteResponse(rw http.ResponseWriter, producer r
 	}
 }
 
+// QueryBundlingBundleBadRequestCode is the HTTP code returned for type QueryBundlingBundleBadRequest
+const QueryBundlingBundleBadRequestCode int = 400
+
+/*
+QueryBundlingBundleBadRequest Invalid request or signature
+
+swagger:response queryBundlingBundleBadRequest
+*/
+type QueryBundlingBundleBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewQueryBundlingBundleBadRequest creates QueryBundlingBundleBadRequest with default headers values
+func NewQueryBundlingBundleBadRequest() *QueryBundlingBundleBadRequest {
+
+	return &QueryBundlingBundleBadRequest{}
+}
+
+// WithPayload adds the payload to the query bundling bundle bad request response
+func (o *QueryBundlingBundleBadRequest) WithPayload(payload *models.Error) *QueryBundlingBundleBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the query bundling bundle bad request response
+func (o *QueryBundlingBundleBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *QueryBundlingBundleBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// QueryBundlingBundleForbiddenCode is the HTTP code returned for type QueryBundlingBundleForbidden
+const QueryBundlingBundleForbiddenCode int = 403
+
+/*
+QueryBundlingBundleForbidden The bucket is private and the request is not signed by an account which can get objects in it
+
+swagger:response queryBundlingBundleForbidden
+*/
+type QueryBundlingBundleForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewQueryBundlingBundleForbidden creates QueryBundlingBundleForbidden with default headers values
+func NewQueryBundlingBundleForbidden() *QueryBundlingBundleForbidden {
+
+	return &QueryBundlingBundleForbidden{}
+}
+
+// WithPayload adds the payload to the query bundling bundle forbidden response
+func (o *QueryBundlingBundleForbidden) WithPayload(payload *models.Error) *QueryBundlingBundleForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the query bundling bundle forbidden response
+func (o *QueryBundlingBundleForbidden) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *QueryBundlingBundleForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // QueryBundlingBundleNotFoundCode is the HTTP code returned for type QueryBundlingBundleNotFound
 const QueryBundlingBundleNotFoundCode int = 404
 
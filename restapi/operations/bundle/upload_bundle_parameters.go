@@ -52,6 +52,11 @@ type UploadBundleParams struct {
 	  In: header
 	*/
 	XBundleBucketName string
+	/*SHA256 hash of the content of the bundle file, which is verified while the bundle file is received
+	  Required: true
+	  In: header
+	*/
+	XBundleContentSha256 string
 	/*Expiry timestamp of the request
 	  Required: true
 	  In: header
@@ -99,6 +104,10 @@ func (o *UploadBundleParams) BindRequest(r *http.Request, route *middleware.Matc
 		res = append(res, err)
 	}
 
+	if err := o.bindXBundleContentSha256(r.Header[http.CanonicalHeaderKey("X-Bundle-Content-Sha256")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := o.bindXBundleExpiryTimestamp(r.Header[http.CanonicalHeaderKey("X-Bundle-Expiry-Timestamp")], true, route.Formats); err != nil {
 		res = append(res, err)
 	}
@@ -166,6 +175,26 @@ func (o *UploadBundleParams) bindXBundleBucketName(rawData []string, hasKey bool
 	return nil
 }
 
+// bindXBundleContentSha256 binds and validates parameter XBundleContentSha256 from header.
+func (o *UploadBundleParams) bindXBundleContentSha256(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	if !hasKey {
+		return errors.Required("X-Bundle-Content-Sha256", "header", rawData)
+	}
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+
+	if err := validate.RequiredString("X-Bundle-Content-Sha256", "header", raw); err != nil {
+		return err
+	}
+	o.XBundleContentSha256 = raw
+
+	return nil
+}
+
 // bindXBundleExpiryTimestamp binds and validates parameter XBundleExpiryTimestamp from header.
 func (o *UploadBundleParams) bindXBundleExpiryTimestamp(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	if !hasKey {
@@ -34,7 +34,7 @@ func NewUploadObject(ctx *middleware.Context, handler UploadObjectHandler) *Uplo
 
 # Upload a single object to a bundle
 
-Uploads a single object to a bundle, requiring details like bucket name, file name, and etc.
+Uploads a single object to a bundle, requiring details like bucket name, file name, and etc. An object with the same name in the bundling bundle is rejected, unless X-Bundle-Overwrite is true, which replaces it.
 */
 type UploadObject struct {
 	Context *middleware.Context
@@ -72,6 +72,10 @@ type UploadObjectParams struct {
 	  In: header
 	*/
 	XBundleFileSha256 string
+	/*Replace the object with the same name in the bundling bundle of the bucket
+	  In: header
+	*/
+	XBundleOverwrite *bool
 	/*Tags of the file
 	  In: header
 	*/
@@ -124,6 +128,10 @@ func (o *UploadObjectParams) BindRequest(r *http.Request, route *middleware.Matc
 		res = append(res, err)
 	}
 
+	if err := o.bindXBundleOverwrite(r.Header[http.CanonicalHeaderKey("X-Bundle-Overwrite")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := o.bindXBundleTags(r.Header[http.CanonicalHeaderKey("X-Bundle-Tags")], true, route.Formats); err != nil {
 		res = append(res, err)
 	}
@@ -268,6 +276,28 @@ func (o *UploadObjectParams) bindXBundleFileSha256(rawData []string, hasKey bool
 	return nil
 }
 
+// bindXBundleOverwrite binds and validates parameter XBundleOverwrite from header.
+func (o *UploadObjectParams) bindXBundleOverwrite(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertBool(raw)
+	if err != nil {
+		return errors.InvalidType("X-Bundle-Overwrite", "header", "bool", raw)
+	}
+	o.XBundleOverwrite = &value
+
+	return nil
+}
+
 // bindXBundleTags binds and validates parameter XBundleTags from header.
 func (o *UploadObjectParams) bindXBundleTags(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
@@ -103,6 +103,51 @@ func (o *UploadObjectBadRequest) WriteResponse(rw http.ResponseWriter, producer
 	}
 }
 
+// UploadObjectConflictCode is the HTTP code returned for type UploadObjectConflict
+const UploadObjectConflictCode int = 409
+
+/*
+UploadObjectConflict The bundling bundle changed during the upload, the request can be retried
+
+swagger:response uploadObjectConflict
+*/
+type UploadObjectConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewUploadObjectConflict creates UploadObjectConflict with default headers values
+func NewUploadObjectConflict() *UploadObjectConflict {
+
+	return &UploadObjectConflict{}
+}
+
+// WithPayload adds the payload to the upload object conflict response
+func (o *UploadObjectConflict) WithPayload(payload *models.Error) *UploadObjectConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the upload object conflict response
+func (o *UploadObjectConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UploadObjectConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // UploadObjectInternalServerErrorCode is the HTTP code returned for type UploadObjectInternalServerError
 const UploadObjectInternalServerErrorCode int = 500
 
@@ -34,7 +34,7 @@ func NewViewBundleObject(ctx *middleware.Context, handler ViewBundleObjectHandle
 
 # Retrieve an object as a file from a bundle
 
-Fetches a specific object from a given bundle and returns it as a file.
+Fetches a specific object from a given bundle and returns it as a file. Single byte range requests (Range, If-Range) and conditional requests (If-None-Match, If-Modified-Since) are supported using the ETag and Last-Modified of the object.
 */
 type ViewBundleObject struct {
 	Context *middleware.Context
@@ -9,8 +9,10 @@ import (
 	"net/http"
 
 	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 )
 
 // NewViewBundleObjectParams creates a new ViewBundleObjectParams object
@@ -30,6 +32,14 @@ type ViewBundleObjectParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
+	/*Digital signature of an account which can get objects in the bucket, required to read a private bucket without a presigned url
+	  In: header
+	*/
+	Authorization *string
+	/*Expiry timestamp of the signed request
+	  In: header
+	*/
+	XBundleExpiryTimestamp *int64
 	/*The bucketName of the bundle
 	  Required: true
 	  In: path
@@ -45,6 +55,22 @@ type ViewBundleObjectParams struct {
 	  In: path
 	*/
 	ObjectName string
+	/*The bundle of the object of the presigned url, the latest object with the name is read if it is empty
+	  In: query
+	*/
+	PresignBundleName *string
+	/*The expiry timestamp of the presigned url
+	  In: query
+	*/
+	PresignExpiry *int64
+	/*The signature of the presigned url
+	  In: query
+	*/
+	PresignSignature *string
+	/*The account which requested the presigned url
+	  In: query
+	*/
+	PresignSigner *string
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -56,6 +82,16 @@ func (o *ViewBundleObjectParams) BindRequest(r *http.Request, route *middleware.
 
 	o.HTTPRequest = r
 
+	qs := runtime.Values(r.URL.Query())
+
+	if err := o.bindAuthorization(r.Header[http.CanonicalHeaderKey("Authorization")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.bindXBundleExpiryTimestamp(r.Header[http.CanonicalHeaderKey("X-Bundle-Expiry-Timestamp")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	rBucketName, rhkBucketName, _ := route.Params.GetOK("bucketName")
 	if err := o.bindBucketName(rBucketName, rhkBucketName, route.Formats); err != nil {
 		res = append(res, err)
@@ -70,12 +106,71 @@ func (o *ViewBundleObjectParams) BindRequest(r *http.Request, route *middleware.
 	if err := o.bindObjectName(rObjectName, rhkObjectName, route.Formats); err != nil {
 		res = append(res, err)
 	}
+
+	qPresignBundleName, qhkPresignBundleName, _ := qs.GetOK("presignBundleName")
+	if err := o.bindPresignBundleName(qPresignBundleName, qhkPresignBundleName, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qPresignExpiry, qhkPresignExpiry, _ := qs.GetOK("presignExpiry")
+	if err := o.bindPresignExpiry(qPresignExpiry, qhkPresignExpiry, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qPresignSignature, qhkPresignSignature, _ := qs.GetOK("presignSignature")
+	if err := o.bindPresignSignature(qPresignSignature, qhkPresignSignature, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qPresignSigner, qhkPresignSigner, _ := qs.GetOK("presignSigner")
+	if err := o.bindPresignSigner(qPresignSigner, qhkPresignSigner, route.Formats); err != nil {
+		res = append(res, err)
+	}
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+// bindAuthorization binds and validates parameter Authorization from header.
+func (o *ViewBundleObjectParams) bindAuthorization(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Authorization = &raw
+
+	return nil
+}
+
+// bindXBundleExpiryTimestamp binds and validates parameter XBundleExpiryTimestamp from header.
+func (o *ViewBundleObjectParams) bindXBundleExpiryTimestamp(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("X-Bundle-Expiry-Timestamp", "header", "int64", raw)
+	}
+	o.XBundleExpiryTimestamp = &value
+
+	return nil
+}
+
 // bindBucketName binds and validates parameter BucketName from path.
 func (o *ViewBundleObjectParams) bindBucketName(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
@@ -117,3 +212,80 @@ func (o *ViewBundleObjectParams) bindObjectName(rawData []string, hasKey bool, f
 
 	return nil
 }
+
+// bindPresignBundleName binds and validates parameter PresignBundleName from query.
+func (o *ViewBundleObjectParams) bindPresignBundleName(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.PresignBundleName = &raw
+
+	return nil
+}
+
+// bindPresignExpiry binds and validates parameter PresignExpiry from query.
+func (o *ViewBundleObjectParams) bindPresignExpiry(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("presignExpiry", "query", "int64", raw)
+	}
+	o.PresignExpiry = &value
+
+	return nil
+}
+
+// bindPresignSignature binds and validates parameter PresignSignature from query.
+func (o *ViewBundleObjectParams) bindPresignSignature(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.PresignSignature = &raw
+
+	return nil
+}
+
+// bindPresignSigner binds and validates parameter PresignSigner from query.
+func (o *ViewBundleObjectParams) bindPresignSigner(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.PresignSigner = &raw
+
+	return nil
+}
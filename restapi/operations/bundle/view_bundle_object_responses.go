@@ -57,6 +57,74 @@ func (o *ViewBundleObjectOK) WriteResponse(rw http.ResponseWriter, producer runt
 	}
 }
 
+// ViewBundleObjectPartialContentCode is the HTTP code returned for type ViewBundleObjectPartialContent
+const ViewBundleObjectPartialContentCode int = 206
+
+/*
+ViewBundleObjectPartialContent Successfully retrieved the requested range of the file
+
+swagger:response viewBundleObjectPartialContent
+*/
+type ViewBundleObjectPartialContent struct {
+
+	/*
+	  In: Body
+	*/
+	Payload io.ReadCloser `json:"body,omitempty"`
+}
+
+// NewViewBundleObjectPartialContent creates ViewBundleObjectPartialContent with default headers values
+func NewViewBundleObjectPartialContent() *ViewBundleObjectPartialContent {
+
+	return &ViewBundleObjectPartialContent{}
+}
+
+// WithPayload adds the payload to the view bundle object partial content response
+func (o *ViewBundleObjectPartialContent) WithPayload(payload io.ReadCloser) *ViewBundleObjectPartialContent {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the view bundle object partial content response
+func (o *ViewBundleObjectPartialContent) SetPayload(payload io.ReadCloser) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ViewBundleObjectPartialContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(206)
+	payload := o.Payload
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// ViewBundleObjectNotModifiedCode is the HTTP code returned for type ViewBundleObjectNotModified
+const ViewBundleObjectNotModifiedCode int = 304
+
+/*
+ViewBundleObjectNotModified The file is not modified since the version in the conditional request headers
+
+swagger:response viewBundleObjectNotModified
+*/
+type ViewBundleObjectNotModified struct {
+}
+
+// NewViewBundleObjectNotModified creates ViewBundleObjectNotModified with default headers values
+func NewViewBundleObjectNotModified() *ViewBundleObjectNotModified {
+
+	return &ViewBundleObjectNotModified{}
+}
+
+// WriteResponse to the client
+func (o *ViewBundleObjectNotModified) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(304)
+}
+
 // ViewBundleObjectBadRequestCode is the HTTP code returned for type ViewBundleObjectBadRequest
 const ViewBundleObjectBadRequestCode int = 400
 
@@ -102,6 +170,51 @@ func (o *ViewBundleObjectBadRequest) WriteResponse(rw http.ResponseWriter, produ
 	}
 }
 
+// ViewBundleObjectForbiddenCode is the HTTP code returned for type ViewBundleObjectForbidden
+const ViewBundleObjectForbiddenCode int = 403
+
+/*
+ViewBundleObjectForbidden The bucket is private and the request is not signed by an account which can get objects in it
+
+swagger:response viewBundleObjectForbidden
+*/
+type ViewBundleObjectForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewViewBundleObjectForbidden creates ViewBundleObjectForbidden with default headers values
+func NewViewBundleObjectForbidden() *ViewBundleObjectForbidden {
+
+	return &ViewBundleObjectForbidden{}
+}
+
+// WithPayload adds the payload to the view bundle object forbidden response
+func (o *ViewBundleObjectForbidden) WithPayload(payload *models.Error) *ViewBundleObjectForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the view bundle object forbidden response
+func (o *ViewBundleObjectForbidden) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ViewBundleObjectForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // ViewBundleObjectNotFoundCode is the HTTP code returned for type ViewBundleObjectNotFound
 const ViewBundleObjectNotFoundCode int = 404
 
@@ -147,6 +260,31 @@ func (o *ViewBundleObjectNotFound) WriteResponse(rw http.ResponseWriter, produce
 	}
 }
 
+// ViewBundleObjectRequestedRangeNotSatisfiableCode is the HTTP code returned for type ViewBundleObjectRequestedRangeNotSatisfiable
+const ViewBundleObjectRequestedRangeNotSatisfiableCode int = 416
+
+/*
+ViewBundleObjectRequestedRangeNotSatisfiable The requested range is not satisfiable
+
+swagger:response viewBundleObjectRequestedRangeNotSatisfiable
+*/
+type ViewBundleObjectRequestedRangeNotSatisfiable struct {
+}
+
+// NewViewBundleObjectRequestedRangeNotSatisfiable creates ViewBundleObjectRequestedRangeNotSatisfiable with default headers values
+func NewViewBundleObjectRequestedRangeNotSatisfiable() *ViewBundleObjectRequestedRangeNotSatisfiable {
+
+	return &ViewBundleObjectRequestedRangeNotSatisfiable{}
+}
+
+// WriteResponse to the client
+func (o *ViewBundleObjectRequestedRangeNotSatisfiable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(416)
+}
+
 // ViewBundleObjectInternalServerErrorCode is the HTTP code returned for type ViewBundleObjectInternalServerError
 const ViewBundleObjectInternalServerErrorCode int = 500
 
@@ -10,13 +10,19 @@ import (
 	"net/url"
 	golangswaggerpaths "path"
 	"strings"
+
+	"github.com/go-openapi/swag"
 )
 
 // ViewBundleObjectURL generates an URL for the view bundle object operation
 type ViewBundleObjectURL struct {
-	BucketName string
-	BundleName string
-	ObjectName string
+	BucketName        string
+	BundleName        string
+	ObjectName        string
+	PresignBundleName *string
+	PresignExpiry     *int64
+	PresignSignature  *string
+	PresignSigner     *string
 
 	_basePath string
 	// avoid unkeyed usage
@@ -71,6 +77,42 @@ func (o *ViewBundleObjectURL) Build() (*url.URL, error) {
 	}
 	_result.Path = golangswaggerpaths.Join(_basePath, _path)
 
+	qs := make(url.Values)
+
+	var presignBundleNameQ string
+	if o.PresignBundleName != nil {
+		presignBundleNameQ = *o.PresignBundleName
+	}
+	if presignBundleNameQ != "" {
+		qs.Set("presignBundleName", presignBundleNameQ)
+	}
+
+	var presignExpiryQ string
+	if o.PresignExpiry != nil {
+		presignExpiryQ = swag.FormatInt64(*o.PresignExpiry)
+	}
+	if presignExpiryQ != "" {
+		qs.Set("presignExpiry", presignExpiryQ)
+	}
+
+	var presignSignatureQ string
+	if o.PresignSignature != nil {
+		presignSignatureQ = *o.PresignSignature
+	}
+	if presignSignatureQ != "" {
+		qs.Set("presignSignature", presignSignatureQ)
+	}
+
+	var presignSignerQ string
+	if o.PresignSigner != nil {
+		presignSignerQ = *o.PresignSigner
+	}
+	if presignSignerQ != "" {
+		qs.Set("presignSigner", presignSignerQ)
+	}
+
+	_result.RawQuery = qs.Encode()
+
 	return &_result, nil
 }
 
@@ -50,36 +50,84 @@ func NewBundleServiceAPI(spec *loads.Document) *BundleServiceAPI {
 		BundleBundlerAccountHandler: bundle.BundlerAccountHandlerFunc(func(params bundle.BundlerAccountParams) middleware.Responder {
 			return middleware.NotImplemented("operation bundle.BundlerAccount has not yet been implemented")
 		}),
+		BundleCompleteBundleUploadHandler: bundle.CompleteBundleUploadHandlerFunc(func(params bundle.CompleteBundleUploadParams) middleware.Responder {
+			return middleware.NotImplemented("operation bundle.CompleteBundleUpload has not yet been implemented")
+		}),
 		BundleCreateBundleHandler: bundle.CreateBundleHandlerFunc(func(params bundle.CreateBundleParams) middleware.Responder {
 			return middleware.NotImplemented("operation bundle.CreateBundle has not yet been implemented")
 		}),
+		BundleCreateSessionKeyHandler: bundle.CreateSessionKeyHandlerFunc(func(params bundle.CreateSessionKeyParams) middleware.Responder {
+			return middleware.NotImplemented("operation bundle.CreateSessionKey has not yet been implemented")
+		}),
 		BundleDeleteBundleHandler: bundle.DeleteBundleHandlerFunc(func(params bundle.DeleteBundleParams) middleware.Responder {
 			return middleware.NotImplemented("operation bundle.DeleteBundle has not yet been implemented")
 		}),
+		BundleDeleteObjectHandler: bundle.DeleteObjectHandlerFunc(func(params bundle.DeleteObjectParams) middleware.Responder {
+			return middleware.NotImplemented("operation bundle.DeleteObject has not yet been implemented")
+		}),
 		BundleDownloadBundleObjectHandler: bundle.DownloadBundleObjectHandlerFunc(func(params bundle.DownloadBundleObjectParams) middleware.Responder {
 			return middleware.NotImplemented("operation bundle.DownloadBundleObject has not yet been implemented")
 		}),
+		BundleDownloadObjectHandler: bundle.DownloadObjectHandlerFunc(func(params bundle.DownloadObjectParams) middleware.Responder {
+			return middleware.NotImplemented("operation bundle.DownloadObject has not yet been implemented")
+		}),
 		BundleFinalizeBundleHandler: bundle.FinalizeBundleHandlerFunc(func(params bundle.FinalizeBundleParams) middleware.Responder {
 			return middleware.NotImplemented("operation bundle.FinalizeBundle has not yet been implemented")
 		}),
+		BundleImportBundleHandler: bundle.ImportBundleHandlerFunc(func(params bundle.ImportBundleParams) middleware.Responder {
+			return middleware.NotImplemented("operation bundle.ImportBundle has not yet been implemented")
+		}),
+		BundleInitiateBundleUploadHandler: bundle.InitiateBundleUploadHandlerFunc(func(params bundle.InitiateBundleUploadParams) middleware.Responder {
+			return middleware.NotImplemented("operation bundle.InitiateBundleUpload has not yet been implemented")
+		}),
+		BundleInvalidateBucketCacheHandler: bundle.InvalidateBucketCacheHandlerFunc(func(params bundle.InvalidateBucketCacheParams) middleware.Responder {
+			return middleware.NotImplemented("operation bundle.InvalidateBucketCache has not yet been implemented")
+		}),
+		BundleListBundlesHandler: bundle.ListBundlesHandlerFunc(func(params bundle.ListBundlesParams) middleware.Responder {
+			return middleware.NotImplemented("operation bundle.ListBundles has not yet been implemented")
+		}),
+		BundleListObjectsHandler: bundle.ListObjectsHandlerFunc(func(params bundle.ListObjectsParams) middleware.Responder {
+			return middleware.NotImplemented("operation bundle.ListObjects has not yet been implemented")
+		}),
+		BundlePresignHandler: bundle.PresignHandlerFunc(func(params bundle.PresignParams) middleware.Responder {
+			return middleware.NotImplemented("operation bundle.Presign has not yet been implemented")
+		}),
 		BundleQueryBundleHandler: bundle.QueryBundleHandlerFunc(func(params bundle.QueryBundleParams) middleware.Responder {
 			return middleware.NotImplemented("operation bundle.QueryBundle has not yet been implemented")
 		}),
+		BundleQueryBundleUploadHandler: bundle.QueryBundleUploadHandlerFunc(func(params bundle.QueryBundleUploadParams) middleware.Responder {
+			return middleware.NotImplemented("operation bundle.QueryBundleUpload has not yet been implemented")
+		}),
 		BundleQueryBundlingBundleHandler: bundle.QueryBundlingBundleHandlerFunc(func(params bundle.QueryBundlingBundleParams) middleware.Responder {
 			return middleware.NotImplemented("operation bundle.QueryBundlingBundle has not yet been implemented")
 		}),
+		BundleRevokeSessionKeyHandler: bundle.RevokeSessionKeyHandlerFunc(func(params bundle.RevokeSessionKeyParams) middleware.Responder {
+			return middleware.NotImplemented("operation bundle.RevokeSessionKey has not yet been implemented")
+		}),
 		RuleSetBundleRuleHandler: rule.SetBundleRuleHandlerFunc(func(params rule.SetBundleRuleParams) middleware.Responder {
 			return middleware.NotImplemented("operation rule.SetBundleRule has not yet been implemented")
 		}),
+		BundleUnbundleObjectHandler: bundle.UnbundleObjectHandlerFunc(func(params bundle.UnbundleObjectParams) middleware.Responder {
+			return middleware.NotImplemented("operation bundle.UnbundleObject has not yet been implemented")
+		}),
 		BundleUploadBundleHandler: bundle.UploadBundleHandlerFunc(func(params bundle.UploadBundleParams) middleware.Responder {
 			return middleware.NotImplemented("operation bundle.UploadBundle has not yet been implemented")
 		}),
+		BundleUploadBundleChunkHandler: bundle.UploadBundleChunkHandlerFunc(func(params bundle.UploadBundleChunkParams) middleware.Responder {
+			return middleware.NotImplemented("operation bundle.UploadBundleChunk has not yet been implemented")
+		}),
 		BundleUploadObjectHandler: bundle.UploadObjectHandlerFunc(func(params bundle.UploadObjectParams) middleware.Responder {
 			return middleware.NotImplemented("operation bundle.UploadObject has not yet been implemented")
 		}),
+		BundleUploadObjectsHandler: bundle.UploadObjectsHandlerFunc(func(params bundle.UploadObjectsParams) middleware.Responder {
+			return middleware.NotImplemented("operation bundle.UploadObjects has not yet been implemented")
+		}),
 		BundleViewBundleObjectHandler: bundle.ViewBundleObjectHandlerFunc(func(params bundle.ViewBundleObjectParams) middleware.Responder {
 			return middleware.NotImplemented("operation bundle.ViewBundleObject has not yet been implemented")
 		}),
+		BundleViewObjectHandler: bundle.ViewObjectHandlerFunc(func(params bundle.ViewObjectParams) middleware.Responder {
+			return middleware.NotImplemented("operation bundle.ViewObject has not yet been implemented")
+		}),
 	}
 }
 
@@ -124,26 +172,58 @@ type BundleServiceAPI struct {
 
 	// BundleBundlerAccountHandler sets the operation handler for the bundler account operation
 	BundleBundlerAccountHandler bundle.BundlerAccountHandler
+	// BundleCompleteBundleUploadHandler sets the operation handler for the complete bundle upload operation
+	BundleCompleteBundleUploadHandler bundle.CompleteBundleUploadHandler
 	// BundleCreateBundleHandler sets the operation handler for the create bundle operation
 	BundleCreateBundleHandler bundle.CreateBundleHandler
+	// BundleCreateSessionKeyHandler sets the operation handler for the create session key operation
+	BundleCreateSessionKeyHandler bundle.CreateSessionKeyHandler
 	// BundleDeleteBundleHandler sets the operation handler for the delete bundle operation
 	BundleDeleteBundleHandler bundle.DeleteBundleHandler
+	// BundleDeleteObjectHandler sets the operation handler for the delete object operation
+	BundleDeleteObjectHandler bundle.DeleteObjectHandler
 	// BundleDownloadBundleObjectHandler sets the operation handler for the download bundle object operation
 	BundleDownloadBundleObjectHandler bundle.DownloadBundleObjectHandler
+	// BundleDownloadObjectHandler sets the operation handler for the download object operation
+	BundleDownloadObjectHandler bundle.DownloadObjectHandler
 	// BundleFinalizeBundleHandler sets the operation handler for the finalize bundle operation
 	BundleFinalizeBundleHandler bundle.FinalizeBundleHandler
+	// BundleImportBundleHandler sets the operation handler for the import bundle operation
+	BundleImportBundleHandler bundle.ImportBundleHandler
+	// BundleInitiateBundleUploadHandler sets the operation handler for the initiate bundle upload operation
+	BundleInitiateBundleUploadHandler bundle.InitiateBundleUploadHandler
+	// BundleInvalidateBucketCacheHandler sets the operation handler for the invalidate bucket cache operation
+	BundleInvalidateBucketCacheHandler bundle.InvalidateBucketCacheHandler
+	// BundleListBundlesHandler sets the operation handler for the list bundles operation
+	BundleListBundlesHandler bundle.ListBundlesHandler
+	// BundleListObjectsHandler sets the operation handler for the list objects operation
+	BundleListObjectsHandler bundle.ListObjectsHandler
+	// BundlePresignHandler sets the operation handler for the presign operation
+	BundlePresignHandler bundle.PresignHandler
 	// BundleQueryBundleHandler sets the operation handler for the query bundle operation
 	BundleQueryBundleHandler bundle.QueryBundleHandler
+	// BundleQueryBundleUploadHandler sets the operation handler for the query bundle upload operation
+	BundleQueryBundleUploadHandler bundle.QueryBundleUploadHandler
 	// BundleQueryBundlingBundleHandler sets the operation handler for the query bundling bundle operation
 	BundleQueryBundlingBundleHandler bundle.QueryBundlingBundleHandler
+	// BundleRevokeSessionKeyHandler sets the operation handler for the revoke session key operation
+	BundleRevokeSessionKeyHandler bundle.RevokeSessionKeyHandler
 	// RuleSetBundleRuleHandler sets the operation handler for the set bundle rule operation
 	RuleSetBundleRuleHandler rule.SetBundleRuleHandler
+	// BundleUnbundleObjectHandler sets the operation handler for the unbundle object operation
+	BundleUnbundleObjectHandler bundle.UnbundleObjectHandler
 	// BundleUploadBundleHandler sets the operation handler for the upload bundle operation
 	BundleUploadBundleHandler bundle.UploadBundleHandler
+	// BundleUploadBundleChunkHandler sets the operation handler for the upload bundle chunk operation
+	BundleUploadBundleChunkHandler bundle.UploadBundleChunkHandler
 	// BundleUploadObjectHandler sets the operation handler for the upload object operation
 	BundleUploadObjectHandler bundle.UploadObjectHandler
+	// BundleUploadObjectsHandler sets the operation handler for the upload objects operation
+	BundleUploadObjectsHandler bundle.UploadObjectsHandler
 	// BundleViewBundleObjectHandler sets the operation handler for the view bundle object operation
 	BundleViewBundleObjectHandler bundle.ViewBundleObjectHandler
+	// BundleViewObjectHandler sets the operation handler for the view object operation
+	BundleViewObjectHandler bundle.ViewObjectHandler
 
 	// ServeError is called when an error is received, there is a default handler
 	// but you can set your own with this
@@ -230,36 +310,84 @@ func (o *BundleServiceAPI) Validate() error {
 	if o.BundleBundlerAccountHandler == nil {
 		unregistered = append(unregistered, "bundle.BundlerAccountHandler")
 	}
+	if o.BundleCompleteBundleUploadHandler == nil {
+		unregistered = append(unregistered, "bundle.CompleteBundleUploadHandler")
+	}
 	if o.BundleCreateBundleHandler == nil {
 		unregistered = append(unregistered, "bundle.CreateBundleHandler")
 	}
+	if o.BundleCreateSessionKeyHandler == nil {
+		unregistered = append(unregistered, "bundle.CreateSessionKeyHandler")
+	}
 	if o.BundleDeleteBundleHandler == nil {
 		unregistered = append(unregistered, "bundle.DeleteBundleHandler")
 	}
+	if o.BundleDeleteObjectHandler == nil {
+		unregistered = append(unregistered, "bundle.DeleteObjectHandler")
+	}
 	if o.BundleDownloadBundleObjectHandler == nil {
 		unregistered = append(unregistered, "bundle.DownloadBundleObjectHandler")
 	}
+	if o.BundleDownloadObjectHandler == nil {
+		unregistered = append(unregistered, "bundle.DownloadObjectHandler")
+	}
 	if o.BundleFinalizeBundleHandler == nil {
 		unregistered = append(unregistered, "bundle.FinalizeBundleHandler")
 	}
+	if o.BundleImportBundleHandler == nil {
+		unregistered = append(unregistered, "bundle.ImportBundleHandler")
+	}
+	if o.BundleInitiateBundleUploadHandler == nil {
+		unregistered = append(unregistered, "bundle.InitiateBundleUploadHandler")
+	}
+	if o.BundleInvalidateBucketCacheHandler == nil {
+		unregistered = append(unregistered, "bundle.InvalidateBucketCacheHandler")
+	}
+	if o.BundleListBundlesHandler == nil {
+		unregistered = append(unregistered, "bundle.ListBundlesHandler")
+	}
+	if o.BundleListObjectsHandler == nil {
+		unregistered = append(unregistered, "bundle.ListObjectsHandler")
+	}
+	if o.BundlePresignHandler == nil {
+		unregistered = append(unregistered, "bundle.PresignHandler")
+	}
 	if o.BundleQueryBundleHandler == nil {
 		unregistered = append(unregistered, "bundle.QueryBundleHandler")
 	}
+	if o.BundleQueryBundleUploadHandler == nil {
+		unregistered = append(unregistered, "bundle.QueryBundleUploadHandler")
+	}
 	if o.BundleQueryBundlingBundleHandler == nil {
 		unregistered = append(unregistered, "bundle.QueryBundlingBundleHandler")
 	}
+	if o.BundleRevokeSessionKeyHandler == nil {
+		unregistered = append(unregistered, "bundle.RevokeSessionKeyHandler")
+	}
 	if o.RuleSetBundleRuleHandler == nil {
 		unregistered = append(unregistered, "rule.SetBundleRuleHandler")
 	}
+	if o.BundleUnbundleObjectHandler == nil {
+		unregistered = append(unregistered, "bundle.UnbundleObjectHandler")
+	}
 	if o.BundleUploadBundleHandler == nil {
 		unregistered = append(unregistered, "bundle.UploadBundleHandler")
 	}
+	if o.BundleUploadBundleChunkHandler == nil {
+		unregistered = append(unregistered, "bundle.UploadBundleChunkHandler")
+	}
 	if o.BundleUploadObjectHandler == nil {
 		unregistered = append(unregistered, "bundle.UploadObjectHandler")
 	}
+	if o.BundleUploadObjectsHandler == nil {
+		unregistered = append(unregistered, "bundle.UploadObjectsHandler")
+	}
 	if o.BundleViewBundleObjectHandler == nil {
 		unregistered = append(unregistered, "bundle.ViewBundleObjectHandler")
 	}
+	if o.BundleViewObjectHandler == nil {
+		unregistered = append(unregistered, "bundle.ViewObjectHandler")
+	}
 
 	if len(unregistered) > 0 {
 		return fmt.Errorf("missing registration: %s", strings.Join(unregistered, ", "))
@@ -359,19 +487,59 @@ func (o *BundleServiceAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/completeBundleUpload/{uploadId}"] = bundle.NewCompleteBundleUpload(o.context, o.BundleCompleteBundleUploadHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/createBundle"] = bundle.NewCreateBundle(o.context, o.BundleCreateBundleHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/createSessionKey"] = bundle.NewCreateSessionKey(o.context, o.BundleCreateSessionKeyHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/deleteBundle"] = bundle.NewDeleteBundle(o.context, o.BundleDeleteBundleHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/deleteObject"] = bundle.NewDeleteObject(o.context, o.BundleDeleteObjectHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/download/{bucketName}/{bundleName}/{objectName}"] = bundle.NewDownloadBundleObject(o.context, o.BundleDownloadBundleObjectHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/download/{bucketName}/{objectName}"] = bundle.NewDownloadObject(o.context, o.BundleDownloadObjectHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/finalizeBundle"] = bundle.NewFinalizeBundle(o.context, o.BundleFinalizeBundleHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/importBundle"] = bundle.NewImportBundle(o.context, o.BundleImportBundleHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/initiateBundleUpload"] = bundle.NewInitiateBundleUpload(o.context, o.BundleInitiateBundleUploadHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/invalidateBucketCache"] = bundle.NewInvalidateBucketCache(o.context, o.BundleInvalidateBucketCacheHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/listBundles/{bucketName}"] = bundle.NewListBundles(o.context, o.BundleListBundlesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/listObjects/{bucketName}"] = bundle.NewListObjects(o.context, o.BundleListObjectsHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/presign"] = bundle.NewPresign(o.context, o.BundlePresignHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -379,23 +547,47 @@ func (o *BundleServiceAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/queryBundleUpload/{uploadId}"] = bundle.NewQueryBundleUpload(o.context, o.BundleQueryBundleUploadHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/queryBundlingBundle/{bucketName}"] = bundle.NewQueryBundlingBundle(o.context, o.BundleQueryBundlingBundleHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/revokeSessionKey"] = bundle.NewRevokeSessionKey(o.context, o.BundleRevokeSessionKeyHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/setBundleRule"] = rule.NewSetBundleRule(o.context, o.RuleSetBundleRuleHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/unbundleObject"] = bundle.NewUnbundleObject(o.context, o.BundleUnbundleObjectHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/uploadBundle"] = bundle.NewUploadBundle(o.context, o.BundleUploadBundleHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/uploadBundleChunk/{uploadId}/{chunkNumber}"] = bundle.NewUploadBundleChunk(o.context, o.BundleUploadBundleChunkHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/uploadObject"] = bundle.NewUploadObject(o.context, o.BundleUploadObjectHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/uploadObjects"] = bundle.NewUploadObjects(o.context, o.BundleUploadObjectsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/view/{bucketName}/{bundleName}/{objectName}"] = bundle.NewViewBundleObject(o.context, o.BundleViewBundleObjectHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/view/{bucketName}/{objectName}"] = bundle.NewViewObject(o.context, o.BundleViewObjectHandler)
 }
 
 // Serve creates a http handler to serve the API over HTTP
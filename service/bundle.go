@@ -2,8 +2,10 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/bnb-chain/greenfield-go-sdk/client"
 	sdktypes "github.com/bnb-chain/greenfield-go-sdk/types"
@@ -11,8 +13,10 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/node-real/greenfield-bundle-service/auth"
+	"github.com/node-real/greenfield-bundle-service/cache"
 	"github.com/node-real/greenfield-bundle-service/dao"
 	"github.com/node-real/greenfield-bundle-service/database"
+	"github.com/node-real/greenfield-bundle-service/metrics"
 	"github.com/node-real/greenfield-bundle-service/types"
 	"github.com/node-real/greenfield-bundle-service/util"
 )
@@ -37,13 +41,20 @@ type Bundle interface {
 	FinalizeBundle(bucketName string, bundleName string) (*database.Bundle, error)
 	GetBundlingBundle(bucketName string) (database.Bundle, error)
 	QueryBucketFromGnfd(bucketName string) (*gnfdtypes.BucketInfo, error)
+	QueryUncachedBucketFromGnfd(bucketName string) (*gnfdtypes.BucketInfo, error)
+	InvalidateBucketCache(bucketName string)
+	IsBucketUploader(bucketInfo *gnfdtypes.BucketInfo, uploader common.Address) (bool, error)
+	IsBucketReader(bucketInfo *gnfdtypes.BucketInfo, reader common.Address) (bool, error)
 	HeadObjectFromGnfd(bucketName string, objectName string) (*sdktypes.ObjectDetail, error)
 	DeleteBundle(bucketName, bundleName string) error
-	CreateFinalizedBundleWithObjects(newBundle database.Bundle, objects []database.Object) (database.Bundle, error)
+	CreateFinalizedBundleWithObjects(newBundle database.Bundle, objects []database.Object, storeFile func() error) (database.Bundle, error)
+	ListBundles(bucketName string, filter dao.BundleFilter, cursor string, limit int) ([]*database.Bundle, string, error)
+	ImportBundle(newBundle database.Bundle) (database.Bundle, error)
 }
 
 type BundleService struct {
 	gndfClient     client.IClient
+	gnfdCache      *cache.GnfdCache
 	authManager    *auth.AuthManager
 	bundleDao      dao.BundleDao
 	bundleRuleDao  dao.BundleRuleDao
@@ -51,9 +62,10 @@ type BundleService struct {
 }
 
 // NewBundleService returns a new BundleService
-func NewBundleService(gndfClient client.IClient, authManager *auth.AuthManager, bundleDao dao.BundleDao, bundleRuleDao dao.BundleRuleDao, userBundlerDao dao.UserBundlerAccountDao) Bundle {
+func NewBundleService(gndfClient client.IClient, gnfdCache *cache.GnfdCache, authManager *auth.AuthManager, bundleDao dao.BundleDao, bundleRuleDao dao.BundleRuleDao, userBundlerDao dao.UserBundlerAccountDao) Bundle {
 	bs := BundleService{
 		gndfClient:     gndfClient,
+		gnfdCache:      gnfdCache,
 		authManager:    authManager,
 		bundleDao:      bundleDao,
 		bundleRuleDao:  bundleRuleDao,
@@ -75,7 +87,9 @@ func (s *BundleService) GetBundlingBundle(bucketName string) (database.Bundle, e
 
 // QueryBucketFromGndf queries the bucket info from gndf
 func (s *BundleService) QueryBucketFromGnfd(bucketName string) (*gnfdtypes.BucketInfo, error) {
-	bucket, err := s.gndfClient.HeadBucket(context.Background(), bucketName)
+	bucket, err := s.gnfdCache.GetBucket(bucketName, func() (*gnfdtypes.BucketInfo, error) {
+		return s.headBucket(bucketName)
+	})
 	if err != nil {
 		util.Logger.Errorf("query bucket error, bucket=%s, err=%s", bucketName, err.Error())
 		return nil, err
@@ -84,6 +98,60 @@ func (s *BundleService) QueryBucketFromGnfd(bucketName string) (*gnfdtypes.Bucke
 	return bucket, nil
 }
 
+// QueryUncachedBucketFromGnfd queries the bucket info from gnfd bypassing the cache, the cache is left unchanged
+func (s *BundleService) QueryUncachedBucketFromGnfd(bucketName string) (*gnfdtypes.BucketInfo, error) {
+	bucket, err := s.headBucket(bucketName)
+	if err != nil {
+		util.Logger.Errorf("query bucket error, bucket=%s, err=%s", bucketName, err.Error())
+		return nil, err
+	}
+
+	return bucket, nil
+}
+
+func (s *BundleService) headBucket(bucketName string) (*gnfdtypes.BucketInfo, error) {
+	start := time.Now()
+	bucket, err := s.gndfClient.HeadBucket(context.Background(), bucketName)
+	metrics.ObserveGnfdRequest("head_bucket", start, err)
+	return bucket, err
+}
+
+// InvalidateBucketCache removes the cached info of the bucket and the cached bundler permissions on it, so they are
+// queried from Greenfield again
+func (s *BundleService) InvalidateBucketCache(bucketName string) {
+	s.gnfdCache.InvalidateBucket(bucketName)
+}
+
+// IsBucketUploader returns whether the uploader can upload objects to the bucket, which is the owner of the bucket or
+// a delegate granted to create objects in the bucket by the bucket policies or the group policies on Greenfield
+func (s *BundleService) IsBucketUploader(bucketInfo *gnfdtypes.BucketInfo, uploader common.Address) (bool, error) {
+	if bucketInfo.Owner == uploader.String() {
+		return true, nil
+	}
+
+	isPermissionGranted, err := s.authManager.IsBucketPermissionGranted(uploader, bucketInfo.BucketName)
+	if err != nil {
+		util.Logger.Errorf("check bucket permission error, bucket=%s, uploader=%s, err=%s", bucketInfo.BucketName, uploader.String(), err.Error())
+		return false, err
+	}
+	return isPermissionGranted, nil
+}
+
+// IsBucketReader returns whether the reader can get objects in the bucket, as the owner of the bucket or as an account
+// granted ACTION_GET_OBJECT on the bucket on Greenfield
+func (s *BundleService) IsBucketReader(bucketInfo *gnfdtypes.BucketInfo, reader common.Address) (bool, error) {
+	if bucketInfo.Owner == reader.String() {
+		return true, nil
+	}
+
+	isPermissionGranted, err := s.authManager.IsBucketReadPermissionGranted(reader, bucketInfo.BucketName)
+	if err != nil {
+		util.Logger.Errorf("check bucket read permission error, bucket=%s, reader=%s, err=%s", bucketInfo.BucketName, reader.String(), err.Error())
+		return false, err
+	}
+	return isPermissionGranted, nil
+}
+
 // DeleteBundle deletes the bundle for the bucket
 func (s *BundleService) DeleteBundle(bucketName, bundleName string) error {
 	err := s.bundleDao.DeleteBundle(bucketName, bundleName)
@@ -96,7 +164,9 @@ func (s *BundleService) DeleteBundle(bucketName, bundleName string) error {
 
 // HeadObjectFromGnfd queries the object info from gndf
 func (s *BundleService) HeadObjectFromGnfd(bucketName string, objectName string) (*sdktypes.ObjectDetail, error) {
+	start := time.Now()
 	object, err := s.gndfClient.HeadObject(context.Background(), bucketName, objectName)
+	metrics.ObserveGnfdRequest("head_object", start, err)
 	if err != nil {
 		util.Logger.Errorf("query object error, bucket=%s, object=%s, err=%s", bucketName, objectName, err.Error())
 		return nil, err
@@ -116,6 +186,28 @@ func (s *BundleService) QueryBundle(bucketName string, bundleName string) (*data
 	return bundle, nil
 }
 
+// ListBundles returns a page of at most limit bundles of the bucket matching the filter, starting after the cursor.
+// It also returns the cursor of the next page, which is empty if there are no more bundles.
+func (s *BundleService) ListBundles(bucketName string, filter dao.BundleFilter, cursor string, limit int) ([]*database.Bundle, string, error) {
+	afterId, err := decodeCursor(cursor)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// query one more bundle to know whether there is a next page
+	bundles, err := s.bundleDao.ListBundles(bucketName, filter, afterId, limit+1)
+	if err != nil {
+		util.Logger.Errorf("list bundles error, bucket=%s, err=%s", bucketName, err.Error())
+		return nil, "", err
+	}
+
+	if len(bundles) <= limit {
+		return bundles, "", nil
+	}
+	bundles = bundles[:limit]
+	return bundles, encodeCursor(bundles[limit-1].Id), nil
+}
+
 // CreateBundle creates a new bundle for the bucket if it does not exist, it also checks the permission for the bucket
 // of the bundler
 func (s *BundleService) CreateBundle(newBundle database.Bundle) (database.Bundle, error) {
@@ -206,8 +298,9 @@ func (s *BundleService) FinalizeBundle(bucketName string, bundleName string) (*d
 	return updatedBundle, nil
 }
 
-// CreateFinalizedBundleWithObjects creates a new finalized bundle with objects
-func (s *BundleService) CreateFinalizedBundleWithObjects(newBundle database.Bundle, objects []database.Object) (database.Bundle, error) {
+// CreateFinalizedBundleWithObjects creates a new finalized bundle with objects, the bundle file is stored by storeFile
+// in the transaction which creates the bundle
+func (s *BundleService) CreateFinalizedBundleWithObjects(newBundle database.Bundle, objects []database.Object, storeFile func() error) (database.Bundle, error) {
 	// check permission for the bucket
 	isPermissionGranted, err := s.authManager.IsBucketPermissionGranted(common.HexToAddress(newBundle.BundlerAccount), newBundle.Bucket)
 	if err != nil {
@@ -246,7 +339,7 @@ func (s *BundleService) CreateFinalizedBundleWithObjects(newBundle database.Bund
 	// set bundle status to finalized
 	newBundle.Status = database.BundleStatusFinalized
 
-	newBundle, err = s.bundleDao.InsertObjectsInOneTransaction(newBundle, objects)
+	newBundle, err = s.bundleDao.InsertObjectsInOneTransaction(newBundle, objects, storeFile)
 	if err != nil {
 		util.Logger.Errorf("insert objects error, bundle=%+v, err=%s", newBundle, err.Error())
 		return database.Bundle{}, err
@@ -254,3 +347,16 @@ func (s *BundleService) CreateFinalizedBundleWithObjects(newBundle database.Bund
 
 	return newBundle, nil
 }
+
+// ImportBundle records a bundle sealed on Greenfield by another tool, whose objects are imported by the bundler
+// account of the bundle. It returns dao.ErrBundleExists if the bundle exists and its import has not failed.
+func (s *BundleService) ImportBundle(newBundle database.Bundle) (database.Bundle, error) {
+	bundle, err := s.bundleDao.CreateImportingBundle(newBundle)
+	if err != nil {
+		if !errors.Is(err, dao.ErrBundleExists) {
+			util.Logger.Errorf("create importing bundle error, bucket=%s, bundle=%s, err=%s", newBundle.Bucket, newBundle.Name, err.Error())
+		}
+		return database.Bundle{}, err
+	}
+	return bundle, nil
+}
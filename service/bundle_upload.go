@@ -0,0 +1,311 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/node-real/greenfield-bundle-service/dao"
+	"github.com/node-real/greenfield-bundle-service/database"
+	"github.com/node-real/greenfield-bundle-service/storage"
+	"github.com/node-real/greenfield-bundle-service/types"
+	"github.com/node-real/greenfield-bundle-service/util"
+)
+
+const (
+	// BundleUploadExpiry is the time after which a bundle upload which is not updated is garbage collected
+	BundleUploadExpiry = 24 * time.Hour
+	// BundleUploadCompletionTimeout is the time after which a bundle upload which is still completing is moved back
+	// to uploading, so its completion can be retried
+	BundleUploadCompletionTimeout = time.Hour
+
+	bundleUploadGCInterval  = 10 * time.Minute
+	bundleUploadGCBatchSize = 100
+)
+
+var (
+	// ErrInvalidBundleUploadChunk is returned when an uploaded chunk does not match its number or hash
+	ErrInvalidBundleUploadChunk = errors.New("invalid bundle upload chunk")
+	// ErrBundleUploadIncomplete is returned when a bundle upload is completed before all its chunks are uploaded
+	ErrBundleUploadIncomplete = errors.New("bundle upload is incomplete")
+	// ErrBundleUploadHashMismatch is returned when the assembled bundle file does not match the hash of the upload
+	ErrBundleUploadHashMismatch = errors.New("bundle file sha256 mismatch")
+)
+
+type BundleUpload interface {
+	InitiateBundleUpload(owner string, bucket string, bundleName string, size int64, sha256 string) (database.BundleUpload, error)
+	GetBundleUpload(uploadId string) (database.BundleUpload, error)
+	GetBundleUploadChunks(uploadId string) ([]*database.BundleUploadChunk, error)
+	UploadBundleChunk(ctx context.Context, upload database.BundleUpload, number int64, sha256 string, chunk io.ReadSeeker) error
+	StartBundleUploadCompletion(upload database.BundleUpload) (bool, error)
+	AssembleBundleUpload(ctx context.Context, upload database.BundleUpload, w io.Writer) error
+	FinishBundleUploadCompletion(ctx context.Context, upload database.BundleUpload, completed bool) error
+	RequeueStaleBundleUploadCompletions() (int64, error)
+	CollectExpiredBundleUploads(ctx context.Context) (int, error)
+	RunBundleUploadGC(ctx context.Context)
+}
+
+type BundleUploadService struct {
+	fileManager     *storage.FileManager
+	bundleUploadDao dao.BundleUploadDao
+}
+
+// NewBundleUploadService returns a new BundleUploadService
+func NewBundleUploadService(fileManager *storage.FileManager, bundleUploadDao dao.BundleUploadDao) BundleUpload {
+	return &BundleUploadService{
+		fileManager:     fileManager,
+		bundleUploadDao: bundleUploadDao,
+	}
+}
+
+// InitiateBundleUpload creates a bundle upload with a random id, the bundle file is uploaded in chunks of
+// types.BundleUploadChunkSize bytes
+func (s *BundleUploadService) InitiateBundleUpload(owner string, bucket string, bundleName string, size int64, sha256 string) (database.BundleUpload, error) {
+	uploadId, err := newBundleUploadId()
+	if err != nil {
+		return database.BundleUpload{}, err
+	}
+
+	upload, err := s.bundleUploadDao.CreateBundleUpload(database.BundleUpload{
+		UploadId:   uploadId,
+		Owner:      owner,
+		Bucket:     bucket,
+		BundleName: bundleName,
+		Size:       size,
+		ChunkSize:  types.BundleUploadChunkSize,
+		Sha256:     sha256,
+	})
+	if err != nil {
+		util.Logger.Errorf("create bundle upload error, bucket=%s, bundle=%s, err=%s", bucket, bundleName, err.Error())
+		return database.BundleUpload{}, err
+	}
+	return upload, nil
+}
+
+// GetBundleUpload gets a bundle upload, the id of the returned upload is 0 if it does not exist
+func (s *BundleUploadService) GetBundleUpload(uploadId string) (database.BundleUpload, error) {
+	upload, err := s.bundleUploadDao.GetBundleUpload(uploadId)
+	if err != nil {
+		util.Logger.Errorf("get bundle upload error, upload=%s, err=%s", uploadId, err.Error())
+		return database.BundleUpload{}, err
+	}
+	return upload, nil
+}
+
+// GetBundleUploadChunks gets the uploaded chunks of a bundle upload ordered by number
+func (s *BundleUploadService) GetBundleUploadChunks(uploadId string) ([]*database.BundleUploadChunk, error) {
+	chunks, err := s.bundleUploadDao.GetBundleUploadChunks(uploadId)
+	if err != nil {
+		util.Logger.Errorf("get bundle upload chunks error, upload=%s, err=%s", uploadId, err.Error())
+		return nil, err
+	}
+	return chunks, nil
+}
+
+// UploadBundleChunk verifies the size and hash of a chunk before it is stored, so a stored chunk is only replaced by
+// a valid one
+func (s *BundleUploadService) UploadBundleChunk(ctx context.Context, upload database.BundleUpload, number int64, sha256Hex string, chunk io.ReadSeeker) error {
+	expectedSize := upload.ChunkLength(number)
+	if expectedSize == 0 {
+		return fmt.Errorf("%w: chunk number should be between 1 and %d", ErrInvalidBundleUploadChunk, upload.Chunks())
+	}
+
+	hash := sha256.New()
+	size, err := io.Copy(hash, chunk)
+	if err != nil {
+		return err
+	}
+	if size != expectedSize {
+		return fmt.Errorf("%w: chunk %d should have %d bytes, got %d", ErrInvalidBundleUploadChunk, number, expectedSize, size)
+	}
+	if hex.EncodeToString(hash.Sum(nil)) != sha256Hex {
+		return fmt.Errorf("%w: chunk %d sha256 mismatch", ErrInvalidBundleUploadChunk, number)
+	}
+	if _, err := chunk.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	// the chunk is stored once the upload is checked to be uploading, so the chunks of a completing upload are not
+	// replaced while they are assembled
+	err = s.bundleUploadDao.SaveBundleUploadChunk(database.BundleUploadChunk{
+		UploadId: upload.UploadId,
+		Number:   number,
+		Size:     size,
+		Sha256:   sha256Hex,
+	}, func() error {
+		if _, err := s.fileManager.StoreUploadChunk(ctx, upload.UploadId, number, chunk); err != nil {
+			util.Logger.Errorf("store bundle upload chunk error, upload=%s, chunk=%d, err=%s", upload.UploadId, number, err.Error())
+			return err
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, dao.ErrBundleUploadNotUploading) {
+		util.Logger.Errorf("save bundle upload chunk error, upload=%s, chunk=%d, err=%s", upload.UploadId, number, err.Error())
+	}
+	return err
+}
+
+// StartBundleUploadCompletion moves an uploading bundle upload to completing, it returns false if the upload is not
+// uploading, so only one request completes an upload
+func (s *BundleUploadService) StartBundleUploadCompletion(upload database.BundleUpload) (bool, error) {
+	ok, err := s.bundleUploadDao.UpdateBundleUploadStatus(upload.UploadId, database.BundleUploadStatusUploading, database.BundleUploadStatusCompleting)
+	if err != nil {
+		util.Logger.Errorf("update bundle upload status error, upload=%s, err=%s", upload.UploadId, err.Error())
+		return false, err
+	}
+	return ok, nil
+}
+
+// AssembleBundleUpload writes the chunks of a bundle upload to w in order, and verifies the size and hash of the
+// assembled bundle file
+func (s *BundleUploadService) AssembleBundleUpload(ctx context.Context, upload database.BundleUpload, w io.Writer) error {
+	chunks, err := s.GetBundleUploadChunks(upload.UploadId)
+	if err != nil {
+		return err
+	}
+	if int64(len(chunks)) != upload.Chunks() {
+		return fmt.Errorf("%w: %d of %d chunks are uploaded", ErrBundleUploadIncomplete, len(chunks), upload.Chunks())
+	}
+
+	hash := sha256.New()
+	w = io.MultiWriter(w, hash)
+	var size int64
+	for _, chunk := range chunks {
+		n, err := s.copyBundleUploadChunk(ctx, upload, chunk.Number, w)
+		if err != nil {
+			util.Logger.Errorf("assemble bundle upload chunk error, upload=%s, chunk=%d, err=%s", upload.UploadId, chunk.Number, err.Error())
+			return err
+		}
+		size += n
+	}
+
+	if size != upload.Size {
+		return fmt.Errorf("%w: bundle file should have %d bytes, got %d", ErrBundleUploadIncomplete, upload.Size, size)
+	}
+	if hex.EncodeToString(hash.Sum(nil)) != upload.Sha256 {
+		return ErrBundleUploadHashMismatch
+	}
+	return nil
+}
+
+func (s *BundleUploadService) copyBundleUploadChunk(ctx context.Context, upload database.BundleUpload, number int64, w io.Writer) (int64, error) {
+	chunk, err := s.fileManager.GetUploadChunk(ctx, upload.UploadId, number)
+	if err != nil {
+		return 0, err
+	}
+	defer chunk.Close()
+	return io.Copy(w, chunk)
+}
+
+// FinishBundleUploadCompletion marks a completing bundle upload as completed and deletes its chunks, or moves it back
+// to uploading if the completion failed, so the chunks can be uploaded again
+func (s *BundleUploadService) FinishBundleUploadCompletion(ctx context.Context, upload database.BundleUpload, completed bool) error {
+	if !completed {
+		_, err := s.bundleUploadDao.UpdateBundleUploadStatus(upload.UploadId, database.BundleUploadStatusCompleting, database.BundleUploadStatusUploading)
+		if err != nil {
+			util.Logger.Errorf("update bundle upload status error, upload=%s, err=%s", upload.UploadId, err.Error())
+		}
+		return err
+	}
+
+	moved, err := s.bundleUploadDao.UpdateBundleUploadStatus(upload.UploadId, database.BundleUploadStatusCompleting, database.BundleUploadStatusCompleted)
+	if err != nil {
+		util.Logger.Errorf("update bundle upload status error, upload=%s, err=%s", upload.UploadId, err.Error())
+		return err
+	}
+	if !moved {
+		// the completion took so long that the upload is requeued, its chunks are kept for the next completion
+		util.Logger.Warnf("bundle upload is no longer completing, upload=%s", upload.UploadId)
+		return nil
+	}
+
+	// the record of the completed upload is kept until it expires, so completing it again succeeds
+	if err := s.fileManager.DeleteUploadChunks(ctx, upload.UploadId, upload.Chunks()); err != nil {
+		util.Logger.Errorf("delete bundle upload chunks error, upload=%s, err=%s", upload.UploadId, err.Error())
+	}
+	return nil
+}
+
+// RequeueStaleBundleUploadCompletions moves the bundle uploads which have been completing for longer than
+// BundleUploadCompletionTimeout back to uploading, their completions are interrupted, e.g. by a restart of the
+// server, and would otherwise block the uploads until they expire
+func (s *BundleUploadService) RequeueStaleBundleUploadCompletions() (int64, error) {
+	requeued, err := s.bundleUploadDao.RequeueBundleUploadsCompletingBefore(time.Now().Add(-BundleUploadCompletionTimeout))
+	if err != nil {
+		util.Logger.Errorf("requeue stale bundle upload completions error, err=%s", err.Error())
+		return 0, err
+	}
+	return requeued, nil
+}
+
+// CollectExpiredBundleUploads deletes the chunks and records of the bundle uploads which are not updated within
+// BundleUploadExpiry, it returns the number of deleted uploads. The record of an upload is only deleted if it is still
+// expired, and its chunks are deleted afterwards, so an upload refreshed concurrently keeps its chunks.
+func (s *BundleUploadService) CollectExpiredBundleUploads(ctx context.Context) (int, error) {
+	collected := 0
+	for {
+		expiredBefore := time.Now().Add(-BundleUploadExpiry)
+		uploads, err := s.bundleUploadDao.GetBundleUploadsUpdatedBefore(expiredBefore, bundleUploadGCBatchSize)
+		if err != nil {
+			util.Logger.Errorf("get expired bundle uploads error, err=%s", err.Error())
+			return collected, err
+		}
+		if len(uploads) == 0 {
+			return collected, nil
+		}
+
+		for _, upload := range uploads {
+			deleted, err := s.bundleUploadDao.DeleteExpiredBundleUpload(upload.UploadId, upload.Status, expiredBefore)
+			if err != nil {
+				util.Logger.Errorf("delete bundle upload error, upload=%s, err=%s", upload.UploadId, err.Error())
+				return collected, err
+			}
+			if !deleted {
+				// the upload is updated since it is listed, it is collected once it expires again
+				continue
+			}
+			if err := s.fileManager.DeleteUploadChunks(ctx, upload.UploadId, upload.Chunks()); err != nil {
+				util.Logger.Errorf("delete bundle upload chunks error, upload=%s, err=%s", upload.UploadId, err.Error())
+			}
+			collected++
+		}
+	}
+}
+
+// RunBundleUploadGC requeues the stale completions and collects the expired bundle uploads periodically until the
+// context is canceled
+func (s *BundleUploadService) RunBundleUploadGC(ctx context.Context) {
+	ticker := time.NewTicker(bundleUploadGCInterval)
+	defer ticker.Stop()
+	for {
+		requeued, err := s.RequeueStaleBundleUploadCompletions()
+		if err == nil && requeued > 0 {
+			util.Logger.Infof("requeued stale bundle upload completions, count=%d", requeued)
+		}
+
+		collected, err := s.CollectExpiredBundleUploads(ctx)
+		if err == nil && collected > 0 {
+			util.Logger.Infof("collected expired bundle uploads, count=%d", collected)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// newBundleUploadId returns a random hex id of 16 bytes
+func newBundleUploadId() (string, error) {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(id), nil
+}
@@ -7,5 +7,9 @@ import (
 var BundleSvc Bundle
 var BundleRuleSvc BundleRule
 var ObjectSvc Object
+var BundleUploadSvc BundleUpload
+var UnbundleSvc Unbundle
 var UserBundlerAccountSvc UserBundlerAccount
+var RequestNonceSvc RequestNonce
+var SessionKeySvc SessionKey
 var GnfdClient client.IClient
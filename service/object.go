@@ -1,21 +1,52 @@
 package service
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"io"
 
+	bundleTypes "github.com/bnb-chain/greenfield-bundle-sdk/types"
+
 	"github.com/node-real/greenfield-bundle-service/dao"
 	"github.com/node-real/greenfield-bundle-service/database"
+	"github.com/node-real/greenfield-bundle-service/metrics"
 	"github.com/node-real/greenfield-bundle-service/storage"
+	"github.com/node-real/greenfield-bundle-service/types"
 	"github.com/node-real/greenfield-bundle-service/util"
 )
 
+const (
+	// ObjectNameConflictLatest allows several bundles of a bucket to contain objects with the same name, resolving an
+	// object by its name returns the latest uploaded one
+	ObjectNameConflictLatest = "latest"
+	// ObjectNameConflictReject rejects uploading an object whose name is already used by an object of the bucket
+	ObjectNameConflictReject = "reject"
+)
+
+// ErrBundleLimitExceeded is returned when objects do not fit in the max files or size of a bundle created by the user
+var ErrBundleLimitExceeded = errors.New("bundle size or files exceeds limit")
+
+// objectTagBackfillBatchSize is the number of objects whose tags are indexed per batch during the backfill
+const objectTagBackfillBatchSize = 500
+
 type Object interface {
-	CreateObjectForBundling(newObject database.Object) (database.Object, error)
+	CreateObjectForBundling(ctx context.Context, newObject database.Object, file io.ReadCloser) (database.Object, error)
+	PlanObjectsForBundling(bundlingBundle database.Bundle, objects []database.Object) ([]database.Bundle, error)
+	CreateObjectsForBundling(ctx context.Context, bundlingBundle database.Bundle, newBundles []database.Bundle, objects []database.Object, files []io.Reader) error
+	OverwriteObjectForBundling(ctx context.Context, newObject database.Object, file io.ReadCloser) (database.Object, error)
+	DeleteObjectForBundling(ctx context.Context, bucket string, bundle string, object string) (database.Object, error)
+	TombstoneObject(ctx context.Context, bucket string, bundle string, object string) (database.Object, error)
 	GetObject(bucket string, bundle string, object string) (database.Object, error)
-	GetObjectFile(bucket string, bundle string, object string) (io.ReadCloser, error)
-	StoreObjectFile(bucketName, bundleName string, objectName string, file io.ReadCloser) (string, int64, error)
-	GetBundleFile(bucket string, bundle string) (io.ReadCloser, error)
-	StoreBundleFile(bucket string, bundle string, file io.ReadCloser) (string, int64, error)
+	GetLatestObject(bucket string, object string) (database.Object, error)
+	GetLatestObjects(bucket string, objects []string) ([]*database.Object, error)
+	CheckObjectNameConflicts(bucket string, objects []string) ([]string, error)
+	ListObjects(bucket string, filter dao.ObjectFilter, cursor string, limit int) ([]*database.Object, string, error)
+	BackfillObjectTags() error
+	GetObjectFile(ctx context.Context, bucket string, bundle string, object string, off, limit int64) (io.ReadCloser, error)
+	GetBundleFile(ctx context.Context, bucket string, bundle string) (io.ReadCloser, error)
+	StoreBundleFile(ctx context.Context, bucket string, bundle string, file io.ReadCloser) (string, int64, error)
+	HashObjectFile(file io.Reader) (bundleTypes.HashAlgo, []byte, error)
 }
 
 type ObjectService struct {
@@ -24,39 +55,223 @@ type ObjectService struct {
 	userBundlerDao dao.UserBundlerAccountDao
 	bundleDao      dao.BundleDao
 	objectDao      dao.ObjectDao
+	hashAlgo       bundleTypes.HashAlgo
 }
 
 // NewObjectService returns a new ObjectService
 func NewObjectService(config *util.ServerConfig, fileManager *storage.FileManager, bundleDao dao.BundleDao, objectDao dao.ObjectDao, userBundlerDao dao.UserBundlerAccountDao) Object {
+	hashAlgo, err := types.ParseHashAlgo(config.BundleConfig.HashAlgo)
+	if err != nil {
+		panic(err)
+	}
+
 	return &ObjectService{
 		config:         config,
 		fileManager:    fileManager,
 		bundleDao:      bundleDao,
 		objectDao:      objectDao,
 		userBundlerDao: userBundlerDao,
+		hashAlgo:       hashAlgo,
 	}
 }
 
-// CreateObjectForBundling creates a new object for bundling
-func (s *ObjectService) CreateObjectForBundling(newObject database.Object) (database.Object, error) {
-	// create object
-	object, err := s.objectDao.CreateObjectForBundling(newObject)
+// CreateObjectForBundling creates a new object for bundling with the file. Like the overwritten objects, the file is
+// staged before the bundle is locked and swapped in while it is locked, and it is removed if the object fails to be
+// created, so a failed upload does not leave a file behind.
+func (s *ObjectService) CreateObjectForBundling(ctx context.Context, newObject database.Object, file io.ReadCloser) (database.Object, error) {
+	replacement, size, err := s.fileManager.StageObjectReplacement(ctx, newObject.Bucket, newObject.BundleName, newObject.ObjectName, file)
+	if err != nil {
+		util.Logger.Errorf("store object file error, bucket=%s, bundle=%s, object=%s, err=%s", newObject.Bucket, newObject.BundleName, newObject.ObjectName, err.Error())
+		return database.Object{}, err
+	}
+	defer replacement.Cleanup(ctx)
+	metrics.UploadSizeBytes.WithLabelValues(metrics.UploadTypeObject).Observe(float64(size))
+
+	newObject.Size = size
+	swapped := false
+	object, err := s.objectDao.CreateObjectForBundling(newObject, func() error {
+		swapped = true
+		return replacement.Swap(ctx)
+	})
 	if err != nil {
 		util.Logger.Errorf("create object error, object=%+v, err=%s", newObject, err.Error())
+		if swapped {
+			if restoreErr := replacement.Restore(ctx); restoreErr != nil {
+				util.Logger.Errorf("restore object file error, bucket=%s, bundle=%s, object=%s, err=%s", newObject.Bucket, newObject.BundleName, newObject.ObjectName, restoreErr.Error())
+			}
+		}
+		return database.Object{}, err
+	}
+
+	return object, nil
+}
+
+// PlanObjectsForBundling sets the bundle of the objects, which are added to the bundling bundle in order as long as
+// they fit in its max files and size. If the bundling bundle is auto generated, the rest of the objects roll over to
+// new auto generated bundles, which are returned to be created with the objects. A bundle gets at least one object
+// even if the object alone exceeds the max size.
+func (s *ObjectService) PlanObjectsForBundling(bundlingBundle database.Bundle, objects []database.Object) ([]database.Bundle, error) {
+	var (
+		newBundles []database.Bundle
+		nonce      = bundlingBundle.Nonce
+		current    = bundlingBundle
+	)
+	for i := range objects {
+		if current.Files > 0 && (current.Files+1 > current.MaxFiles || current.Size+objects[i].Size > current.MaxSize) {
+			if !IsAutoGeneratedBundleName(bundlingBundle.Name) {
+				return nil, ErrBundleLimitExceeded
+			}
+
+			// the nonce of the new bundles is increased from the max nonce of the bucket, like CreateBundle does
+			if len(newBundles) == 0 {
+				previousBundle, err := s.bundleDao.QueryBundleWithMaxNonce(bundlingBundle.Bucket)
+				if err != nil {
+					util.Logger.Errorf("get bundle with max nonce error, bucket=%s, err=%s", bundlingBundle.Bucket, err.Error())
+					return nil, err
+				}
+				if previousBundle.Nonce > nonce {
+					nonce = previousBundle.Nonce
+				}
+			}
+			nonce++
+
+			current = database.Bundle{
+				Owner:           bundlingBundle.Owner,
+				Bucket:          bundlingBundle.Bucket,
+				Name:            fmt.Sprintf(BundleNameFormat, nonce),
+				BundlerAccount:  bundlingBundle.BundlerAccount,
+				MaxFiles:        bundlingBundle.MaxFiles,
+				MaxSize:         bundlingBundle.MaxSize,
+				MaxFinalizeTime: bundlingBundle.MaxFinalizeTime,
+				Nonce:           nonce,
+			}
+			newBundles = append(newBundles, current)
+		}
+
+		objects[i].BundleName = current.Name
+		current.Files++
+		current.Size += objects[i].Size
+	}
+	return newBundles, nil
+}
+
+// CreateObjectsForBundling creates the objects planned by PlanObjectsForBundling and the new bundles in one transaction
+// with the files of the objects. The files are staged before the bundling bundle is locked and swapped in while it is
+// locked, so either all the objects are created with their files or none of the files is left in place.
+func (s *ObjectService) CreateObjectsForBundling(ctx context.Context, bundlingBundle database.Bundle, newBundles []database.Bundle, objects []database.Object, files []io.Reader) error {
+	replacements := make([]*storage.ObjectReplacement, 0, len(files))
+	defer func() {
+		for _, replacement := range replacements {
+			replacement.Cleanup(ctx)
+		}
+	}()
+	for i, file := range files {
+		replacement, size, err := s.fileManager.StageObjectReplacement(ctx, objects[i].Bucket, objects[i].BundleName, objects[i].ObjectName, file)
+		if err != nil {
+			util.Logger.Errorf("store object file error, bucket=%s, bundle=%s, object=%s, err=%s", objects[i].Bucket, objects[i].BundleName, objects[i].ObjectName, err.Error())
+			return err
+		}
+		replacements = append(replacements, replacement)
+		metrics.UploadSizeBytes.WithLabelValues(metrics.UploadTypeObject).Observe(float64(size))
+	}
+
+	var swapped []*storage.ObjectReplacement
+	err := s.objectDao.CreateObjectsForBundling(bundlingBundle, newBundles, objects, func() error {
+		for _, replacement := range replacements {
+			swapped = append(swapped, replacement)
+			if err := replacement.Swap(ctx); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		util.Logger.Errorf("create objects error, bucket=%s, bundle=%s, objects=%d, err=%s", bundlingBundle.Bucket, bundlingBundle.Name, len(objects), err.Error())
+		for _, replacement := range swapped {
+			if restoreErr := replacement.Restore(ctx); restoreErr != nil {
+				util.Logger.Errorf("restore object file error, bucket=%s, err=%s", bundlingBundle.Bucket, restoreErr.Error())
+			}
+		}
+		return err
+	}
+	return nil
+}
+
+// OverwriteObjectForBundling replaces the object with the same name in the bundling bundle with the file. The file is
+// staged before the bundle is locked and swapped in while it is locked, and the replaced file is restored if the
+// records fail to be updated.
+func (s *ObjectService) OverwriteObjectForBundling(ctx context.Context, newObject database.Object, file io.ReadCloser) (database.Object, error) {
+	replacement, size, err := s.fileManager.StageObjectReplacement(ctx, newObject.Bucket, newObject.BundleName, newObject.ObjectName, file)
+	if err != nil {
+		util.Logger.Errorf("store object replacement error, bucket=%s, bundle=%s, object=%s, err=%s", newObject.Bucket, newObject.BundleName, newObject.ObjectName, err.Error())
 		return database.Object{}, err
 	}
+	defer replacement.Cleanup(ctx)
+	metrics.UploadSizeBytes.WithLabelValues(metrics.UploadTypeObject).Observe(float64(size))
 
+	newObject.Size = size
+	swapped := false
+	object, err := s.objectDao.ReplaceObjectForBundling(newObject, func() error {
+		swapped = true
+		return replacement.Swap(ctx)
+	})
+	if err != nil {
+		util.Logger.Errorf("overwrite object error, bucket=%s, bundle=%s, object=%s, err=%s", newObject.Bucket, newObject.BundleName, newObject.ObjectName, err.Error())
+		if swapped {
+			if restoreErr := replacement.Restore(ctx); restoreErr != nil {
+				util.Logger.Errorf("restore object file error, bucket=%s, bundle=%s, object=%s, err=%s", newObject.Bucket, newObject.BundleName, newObject.ObjectName, restoreErr.Error())
+			}
+		}
+		return database.Object{}, err
+	}
 	return object, nil
 }
 
-// StoreObjectFile stores the object file to local storage
-func (s *ObjectService) StoreObjectFile(bucketName, bundleName string, objectName string, file io.ReadCloser) (string, int64, error) {
-	return s.fileManager.StoreObject(bucketName, bundleName, objectName, file)
+// DeleteObjectForBundling deletes the object from the bundling bundle of the bucket and its staged file, the id of the
+// returned object is 0 if the bundling bundle has no such object. A named bundle should be the bundling bundle, or
+// dao.ErrBundleNotBundling is returned.
+func (s *ObjectService) DeleteObjectForBundling(ctx context.Context, bucket string, bundle string, object string) (database.Object, error) {
+	deleted, err := s.objectDao.DeleteObjectForBundling(bucket, bundle, object)
+	if err != nil {
+		if !errors.Is(err, dao.ErrBundleNotBundling) {
+			util.Logger.Errorf("delete object error, bucket=%s, bundle=%s, object=%s, err=%s", bucket, bundle, object, err.Error())
+		}
+		return database.Object{}, err
+	}
+	if deleted.Id == 0 {
+		return deleted, nil
+	}
+
+	// the object is not part of the bundle anymore, so a staged file which fails to be deleted is only left behind
+	if err := s.fileManager.DeleteObject(ctx, deleted.Bucket, deleted.BundleName, deleted.ObjectName); err != nil {
+		util.Logger.Errorf("delete object file error, bucket=%s, bundle=%s, object=%s, err=%s", deleted.Bucket, deleted.BundleName, deleted.ObjectName, err.Error())
+	}
+	return deleted, nil
+}
+
+// TombstoneObject marks the object of a sealed bundle as deleted and deletes its cached file, the id of the returned
+// object is 0 if the bundle has no such object. The object data is removed when the bundle is compacted.
+func (s *ObjectService) TombstoneObject(ctx context.Context, bucket string, bundle string, object string) (database.Object, error) {
+	tombstoned, err := s.objectDao.TombstoneObject(bucket, bundle, object)
+	if err != nil {
+		if !errors.Is(err, dao.ErrBundleNotSealed) {
+			util.Logger.Errorf("tombstone object error, bucket=%s, bundle=%s, object=%s, err=%s", bucket, bundle, object, err.Error())
+		}
+		return database.Object{}, err
+	}
+	if tombstoned.Id == 0 {
+		return tombstoned, nil
+	}
+
+	if err := s.fileManager.DeleteObject(ctx, bucket, bundle, object); err != nil {
+		util.Logger.Errorf("delete object file error, bucket=%s, bundle=%s, object=%s, err=%s", bucket, bundle, object, err.Error())
+	}
+	return tombstoned, nil
 }
 
-// GetObjectFile gets the object file
-func (s *ObjectService) GetObjectFile(bucket string, bundle string, object string) (io.ReadCloser, error) {
-	return s.fileManager.GetObject(bucket, bundle, object)
+// GetObjectFile gets the object file, starting at off and reading at most limit bytes if limit > 0
+func (s *ObjectService) GetObjectFile(ctx context.Context, bucket string, bundle string, object string, off, limit int64) (io.ReadCloser, error) {
+	return s.fileManager.GetObject(ctx, bucket, bundle, object, off, limit)
 }
 
 // GetObject gets an object from database
@@ -64,12 +279,95 @@ func (s *ObjectService) GetObject(bucket string, bundle string, object string) (
 	return s.objectDao.GetObject(bucket, bundle, object)
 }
 
+// ListObjects returns a page of at most limit objects of the bucket matching the filter, starting after the cursor.
+// It also returns the cursor of the next page, which is empty if there are no more objects.
+func (s *ObjectService) ListObjects(bucket string, filter dao.ObjectFilter, cursor string, limit int) ([]*database.Object, string, error) {
+	afterId, err := decodeCursor(cursor)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// query one more object to know whether there is a next page
+	objects, err := s.objectDao.ListObjects(bucket, filter, afterId, limit+1)
+	if err != nil {
+		util.Logger.Errorf("list objects error, bucket=%s, err=%s", bucket, err.Error())
+		return nil, "", err
+	}
+
+	if len(objects) <= limit {
+		return objects, "", nil
+	}
+	objects = objects[:limit]
+	return objects, encodeCursor(objects[limit-1].Id), nil
+}
+
+// BackfillObjectTags indexes the tags of the objects created before tags were indexed
+func (s *ObjectService) BackfillObjectTags() error {
+	indexed, err := s.objectDao.BackfillObjectTags(objectTagBackfillBatchSize)
+	if err != nil {
+		util.Logger.Errorf("backfill object tags error, indexed=%d, err=%s", indexed, err.Error())
+		return err
+	}
+	if indexed > 0 {
+		util.Logger.Infof("backfilled the tags of %d objects", indexed)
+	}
+	return nil
+}
+
+// GetLatestObject gets the latest object with the name across the bundles of the bucket from database
+func (s *ObjectService) GetLatestObject(bucket string, object string) (database.Object, error) {
+	return s.objectDao.GetLatestObject(bucket, object)
+}
+
+// GetLatestObjects gets the latest objects with any of the names across the bundles of the bucket from database
+func (s *ObjectService) GetLatestObjects(bucket string, objects []string) ([]*database.Object, error) {
+	return s.objectDao.GetLatestObjects(bucket, objects)
+}
+
+// CheckObjectNameConflicts returns the names already used by objects of the bucket if the configured object name
+// conflict policy rejects them, otherwise nothing is returned as the latest uploaded object wins
+func (s *ObjectService) CheckObjectNameConflicts(bucket string, objects []string) ([]string, error) {
+	if s.config.BundleConfig.ObjectNameConflictPolicy != ObjectNameConflictReject {
+		return nil, nil
+	}
+
+	existing, err := s.objectDao.GetLatestObjects(bucket, objects)
+	if err != nil {
+		util.Logger.Errorf("get latest objects error, bucket=%s, err=%s", bucket, err.Error())
+		return nil, err
+	}
+
+	conflicts := make([]string, 0, len(existing))
+	for _, object := range existing {
+		conflicts = append(conflicts, object.ObjectName)
+	}
+	return conflicts, nil
+}
+
+// HashObjectFile returns the configured hash algorithm and the hash of the object file computed with it
+func (s *ObjectService) HashObjectFile(file io.Reader) (bundleTypes.HashAlgo, []byte, error) {
+	hash, err := types.ComputeHash(s.hashAlgo, file)
+	if err != nil {
+		return bundleTypes.HashAlgo_Unknown, nil, err
+	}
+	return s.hashAlgo, hash, nil
+}
+
 // GetBundleFile gets the bundle file
-func (s *ObjectService) GetBundleFile(bucket string, bundle string) (io.ReadCloser, error) {
-	return s.fileManager.GetBundle(bucket, bundle)
+func (s *ObjectService) GetBundleFile(ctx context.Context, bucket string, bundle string) (io.ReadCloser, error) {
+	return s.fileManager.GetBundle(ctx, bucket, bundle)
 }
 
-// StoreBundleFile stores the bundle file to local storage
-func (s *ObjectService) StoreBundleFile(bucket string, bundle string, file io.ReadCloser) (string, int64, error) {
-	return s.fileManager.StoreBundle(bucket, bundle, file)
+// StoreBundleFile stores the bundle file to local storage, a partly stored file is deleted if it fails to be stored.
+// It is called while the new bundle is being created, so the file does not belong to another bundle.
+func (s *ObjectService) StoreBundleFile(ctx context.Context, bucket string, bundle string, file io.ReadCloser) (string, int64, error) {
+	key, size, err := s.fileManager.StoreBundle(ctx, bucket, bundle, file)
+	if err != nil {
+		if deleteErr := s.fileManager.DeleteBundle(ctx, bucket, bundle); deleteErr != nil {
+			util.Logger.Errorf("delete bundle file error, bucket=%s, bundle=%s, err=%s", bucket, bundle, deleteErr.Error())
+		}
+		return "", 0, err
+	}
+	metrics.UploadSizeBytes.WithLabelValues(metrics.UploadTypeBundle).Observe(float64(size))
+	return key, size, nil
 }
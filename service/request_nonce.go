@@ -0,0 +1,58 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/node-real/greenfield-bundle-service/dao"
+	"github.com/node-real/greenfield-bundle-service/util"
+)
+
+const requestNonceGCInterval = 10 * time.Minute
+
+type RequestNonce interface {
+	UseNonce(signer string, nonce string, expireAt time.Time) (bool, error)
+	RunRequestNonceGC(ctx context.Context)
+}
+
+type RequestNonceService struct {
+	requestNonceDao dao.RequestNonceDao
+}
+
+// NewRequestNonceService returns a new RequestNonceService
+func NewRequestNonceService(requestNonceDao dao.RequestNonceDao) RequestNonce {
+	return &RequestNonceService{
+		requestNonceDao: requestNonceDao,
+	}
+}
+
+// UseNonce records the nonce of a signed request until the request expires, it returns false if the nonce is already
+// used by a request of the signer which has not expired
+func (s *RequestNonceService) UseNonce(signer string, nonce string, expireAt time.Time) (bool, error) {
+	ok, err := s.requestNonceDao.UseNonce(signer, nonce, expireAt)
+	if err != nil {
+		util.Logger.Errorf("use request nonce error, signer=%s, err=%s", signer, err.Error())
+		return false, err
+	}
+	return ok, nil
+}
+
+// RunRequestNonceGC deletes the records of the expired nonces periodically until the context is canceled
+func (s *RequestNonceService) RunRequestNonceGC(ctx context.Context) {
+	ticker := time.NewTicker(requestNonceGCInterval)
+	defer ticker.Stop()
+	for {
+		deleted, err := s.requestNonceDao.DeleteExpiredNonces(time.Now())
+		if err != nil {
+			util.Logger.Errorf("delete expired request nonces error, err=%s", err.Error())
+		} else if deleted > 0 {
+			util.Logger.Infof("deleted expired request nonces, count=%d", deleted)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package service
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/node-real/greenfield-bundle-service/dao"
+	"github.com/node-real/greenfield-bundle-service/database"
+	"github.com/node-real/greenfield-bundle-service/types"
+	"github.com/node-real/greenfield-bundle-service/util"
+)
+
+type SessionKey interface {
+	CreateSessionKey(sessionKey database.SessionKey) (database.SessionKey, error)
+	RevokeSessionKey(owner string, address string) (bool, error)
+	UseSessionKey(sessionKey string, bucket string, operation string, size int64) (string, error)
+}
+
+type SessionKeyService struct {
+	sessionKeyDao dao.SessionKeyDao
+}
+
+// NewSessionKeyService returns a new SessionKeyService
+func NewSessionKeyService(sessionKeyDao dao.SessionKeyDao) SessionKey {
+	return &SessionKeyService{
+		sessionKeyDao: sessionKeyDao,
+	}
+}
+
+// CreateSessionKey registers a session key, it returns dao.ErrSessionKeyExists if the address is already registered
+func (s *SessionKeyService) CreateSessionKey(sessionKey database.SessionKey) (database.SessionKey, error) {
+	sessionKey, err := s.sessionKeyDao.CreateSessionKey(sessionKey)
+	if err != nil {
+		if !errors.Is(err, dao.ErrSessionKeyExists) {
+			util.Logger.Errorf("create session key error, owner=%s, session_key=%s, err=%s", sessionKey.Owner, sessionKey.Address, err.Error())
+		}
+		return database.SessionKey{}, err
+	}
+	util.Logger.Infof("session key created, owner=%s, session_key=%s, buckets=%s, scopes=%s", sessionKey.Owner, sessionKey.Address, sessionKey.Buckets, sessionKey.Scopes)
+	return sessionKey, nil
+}
+
+// RevokeSessionKey revokes the session key of the owner, it returns false if the owner has no such session key
+func (s *SessionKeyService) RevokeSessionKey(owner string, address string) (bool, error) {
+	revoked, err := s.sessionKeyDao.RevokeSessionKey(owner, address)
+	if err != nil {
+		util.Logger.Errorf("revoke session key error, owner=%s, session_key=%s, err=%s", owner, address, err.Error())
+		return false, err
+	}
+	if revoked {
+		util.Logger.Infof("session key revoked, owner=%s, session_key=%s", owner, address)
+	}
+	return revoked, nil
+}
+
+// UseSessionKey checks that the session key is permitted to perform the operation on the bucket, consumes its quotas
+// and records the action for audit, it returns the owner of the session key. The size is the content length of the
+// request, which is unknown if it is negative and then rejected by the session keys with an upload size quota.
+func (s *SessionKeyService) UseSessionKey(sessionKey string, bucket string, operation string, size int64) (string, error) {
+	key, err := s.sessionKeyDao.GetSessionKey(sessionKey)
+	if err != nil {
+		util.Logger.Errorf("get session key error, session_key=%s, err=%s", sessionKey, err.Error())
+		return "", err
+	}
+	if key.Id == 0 || key.Revoked || !key.ExpireAt.After(time.Now()) {
+		return "", types.ErrSessionKeyNotPermitted
+	}
+	if !containsItem(key.Buckets, bucket) {
+		return "", types.ErrSessionKeyNotPermitted
+	}
+	scope, ok := types.GetSessionKeyScope(operation)
+	if !ok || !containsItem(key.Scopes, scope) {
+		return "", types.ErrSessionKeyNotPermitted
+	}
+
+	if size < 0 {
+		if key.MaxUploadSize > 0 {
+			return "", types.ErrSessionKeyQuotaExceeded
+		}
+		size = 0
+	}
+
+	err = s.sessionKeyDao.UseSessionKey(database.SessionKeyAction{
+		SessionKey: key.Address,
+		Owner:      key.Owner,
+		Bucket:     bucket,
+		Operation:  operation,
+		Size:       size,
+	})
+	if errors.Is(err, dao.ErrSessionKeyQuotaExceeded) {
+		util.Logger.Infof("session key quota exceeded, owner=%s, session_key=%s, bucket=%s, operation=%s", key.Owner, key.Address, bucket, operation)
+		return "", types.ErrSessionKeyQuotaExceeded
+	}
+	if err != nil {
+		util.Logger.Errorf("use session key error, session_key=%s, err=%s", sessionKey, err.Error())
+		return "", err
+	}
+
+	util.Logger.Infof("session key used, owner=%s, session_key=%s, bucket=%s, operation=%s, size=%d", key.Owner, key.Address, bucket, operation, size)
+	return key.Owner, nil
+}
+
+// containsItem returns whether the item is in the list separated by commas
+func containsItem(list string, item string) bool {
+	for _, i := range strings.Split(list, ",") {
+		if i == item {
+			return true
+		}
+	}
+	return false
+}
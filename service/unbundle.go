@@ -0,0 +1,107 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/bnb-chain/greenfield-go-sdk/client"
+
+	"github.com/node-real/greenfield-bundle-service/dao"
+	"github.com/node-real/greenfield-bundle-service/database"
+	"github.com/node-real/greenfield-bundle-service/metrics"
+	"github.com/node-real/greenfield-bundle-service/util"
+)
+
+type Unbundle interface {
+	UnbundleObject(bundle database.Bundle, object database.Object) (database.UnbundleJob, error)
+	GetUnbundleJob(objectId int64) (database.UnbundleJob, error)
+	GetMigratedObjectURL(ctx context.Context, bucket string, object string, download bool) (string, error)
+}
+
+type UnbundleService struct {
+	gnfdClient     client.IClient
+	unbundleJobDao dao.UnbundleJobDao
+}
+
+// NewUnbundleService returns a new UnbundleService
+func NewUnbundleService(gnfdClient client.IClient, unbundleJobDao dao.UnbundleJobDao) Unbundle {
+	return &UnbundleService{
+		gnfdClient:     gnfdClient,
+		unbundleJobDao: unbundleJobDao,
+	}
+}
+
+// UnbundleObject creates the job which promotes the object of the sealed bundle to a standalone object on Greenfield,
+// the job is run by the bundler account of the bundle. The existing job of the object is returned if there is one.
+func (s *UnbundleService) UnbundleObject(bundle database.Bundle, object database.Object) (database.UnbundleJob, error) {
+	job, err := s.unbundleJobDao.CreateUnbundleJob(database.UnbundleJob{
+		ObjectId:       object.Id,
+		Owner:          bundle.Owner,
+		Bucket:         object.Bucket,
+		BundleName:     object.BundleName,
+		ObjectName:     object.ObjectName,
+		BundlerAccount: bundle.BundlerAccount,
+	})
+	if err != nil {
+		util.Logger.Errorf("create unbundle job error, bucket=%s, bundle=%s, object=%s, err=%s", object.Bucket, object.BundleName, object.ObjectName, err.Error())
+		return database.UnbundleJob{}, err
+	}
+	return job, nil
+}
+
+// GetUnbundleJob gets the unbundle job of an object, the id of the returned job is 0 if it does not exist
+func (s *UnbundleService) GetUnbundleJob(objectId int64) (database.UnbundleJob, error) {
+	job, err := s.unbundleJobDao.GetUnbundleJob(objectId)
+	if err != nil {
+		util.Logger.Errorf("get unbundle job error, object=%d, err=%s", objectId, err.Error())
+		return database.UnbundleJob{}, err
+	}
+	return job, nil
+}
+
+// GetMigratedObjectURL returns the URL of the standalone object on the primary storage provider of the bucket, the
+// object is viewed or downloaded through the universal endpoint of the storage provider
+func (s *UnbundleService) GetMigratedObjectURL(ctx context.Context, bucket string, object string, download bool) (string, error) {
+	endpoint, err := s.primarySPEndpoint(ctx, bucket)
+	if err != nil {
+		util.Logger.Errorf("get primary sp endpoint error, bucket=%s, err=%s", bucket, err.Error())
+		return "", err
+	}
+
+	action := "view"
+	if download {
+		action = "download"
+	}
+	return url.JoinPath(endpoint, action, bucket, object)
+}
+
+func (s *UnbundleService) primarySPEndpoint(ctx context.Context, bucket string) (string, error) {
+	start := time.Now()
+	bucketInfo, err := s.gnfdClient.HeadBucket(ctx, bucket)
+	metrics.ObserveGnfdRequest("head_bucket", start, err)
+	if err != nil {
+		return "", err
+	}
+
+	start = time.Now()
+	family, err := s.gnfdClient.QueryVirtualGroupFamily(ctx, bucketInfo.GlobalVirtualGroupFamilyId)
+	metrics.ObserveGnfdRequest("query_virtual_group_family", start, err)
+	if err != nil {
+		return "", err
+	}
+
+	start = time.Now()
+	sps, err := s.gnfdClient.ListStorageProviders(ctx, true)
+	metrics.ObserveGnfdRequest("list_storage_providers", start, err)
+	if err != nil {
+		return "", err
+	}
+	for _, sp := range sps {
+		if sp.Id == family.PrimarySpId {
+			return sp.Endpoint, nil
+		}
+	}
+	return "", fmt.Errorf("primary storage provider %d is not in service", family.PrimarySpId)
+}
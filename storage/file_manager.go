@@ -3,24 +3,35 @@ package storage
 import (
 	"bytes"
 	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
 	"fmt"
+	"hash"
 	"io"
 	"os"
 	"path/filepath"
+	"strconv"
 	"time"
 
-	"github.com/bnb-chain/greenfield-bundle-sdk/bundle"
+	bundleTypes "github.com/bnb-chain/greenfield-bundle-sdk/types"
 	"github.com/bnb-chain/greenfield-go-sdk/client"
 	"github.com/bnb-chain/greenfield-go-sdk/types"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/node-real/greenfield-bundle-service/dao"
 	"github.com/node-real/greenfield-bundle-service/database"
+	"github.com/node-real/greenfield-bundle-service/metrics"
+	btypes "github.com/node-real/greenfield-bundle-service/types"
 	"github.com/node-real/greenfield-bundle-service/util"
 )
 
 const (
 	LocalPathObjectPrefix = "object"
 	LocalPathBundlePrefix = "bundle"
+	LocalPathUploadPrefix = "upload"
+
+	RemotePathUploadPrefix = "_upload"
 )
 
 func GetObjectPath(storagePath, bucket, bundle, object string) string {
@@ -38,87 +49,159 @@ func GetBundleKeyInOss(bucket string, bundle string) string {
 	return fmt.Sprintf("%s/%s", bucket, bundle)
 }
 
-type FileManager struct {
-	config          *util.ServerConfig
-	useLocalStorage bool
-	ossStore        *OssStore
-	objectDao       dao.ObjectDao
-	bundleDao       dao.BundleDao
-	gnfdClient      client.IClient
+// keyLayout is the key layout of the files in an object store. Every backend keeps its own layout, so files written
+// by earlier versions of the service stay addressable.
+type keyLayout interface {
+	objectKey(bucket, bundle, object string) string
+	bundleKey(bucket, bundle string) string
+	uploadChunkKey(upload string, chunk int64) string
 }
 
-func NewFileManager(config *util.ServerConfig, objectDao dao.ObjectDao, bundleDao dao.BundleDao, gnfdClient client.IClient) *FileManager {
-	fileManager := &FileManager{
-		config:     config,
-		objectDao:  objectDao,
-		bundleDao:  bundleDao,
-		gnfdClient: gnfdClient,
+func newKeyLayout(backend string) keyLayout {
+	if backend == StorageBackendLocal {
+		return localKeyLayout{}
 	}
+	return remoteKeyLayout{}
+}
 
-	if config.BundleConfig.LocalStoragePath != "" {
-		fileManager.useLocalStorage = true
-	}
+// localKeyLayout is the key layout of the local disk, objects and bundles are kept in different directories since a
+// bundle file would otherwise collide with the directory of its objects
+type localKeyLayout struct{}
 
-	if config.BundleConfig.OssBucketUrl != "" {
-		util.Logger.Infof("use oss storage, bucketUrl=%s", config.BundleConfig.OssBucketUrl)
-		fileManager.useLocalStorage = false
-		ossStore, err := NewOssStoreFromConfig(config.BundleConfig)
-		if err != nil {
-			panic(err)
-		}
-		fileManager.ossStore = ossStore
-	}
+func (localKeyLayout) objectKey(bucket, bundle, object string) string {
+	return filepath.Join(LocalPathObjectPrefix, bucket, bundle, object)
+}
 
-	util.Logger.Infof("use local storage %v", fileManager.useLocalStorage)
+func (localKeyLayout) bundleKey(bucket, bundle string) string {
+	return filepath.Join(LocalPathBundlePrefix, bucket, bundle)
+}
 
-	return fileManager
+// uploadChunkKey returns the key of the upload chunk, chunks are kept in their own directory as well
+func (localKeyLayout) uploadChunkKey(upload string, chunk int64) string {
+	return filepath.Join(LocalPathUploadPrefix, upload, strconv.FormatInt(chunk, 10))
 }
 
-// GetObject returns the object file
-func (f *FileManager) GetObject(bucket string, bundle string, object string) (io.ReadCloser, error) {
-	if f.useLocalStorage {
-		return f.GetObjectLocal(bucket, bundle, object)
-	}
-	return f.GetObjectFromOss(bucket, bundle, object)
+// remoteKeyLayout is the key layout of the cloud object stores, objects and bundles share one flat namespace
+type remoteKeyLayout struct{}
+
+func (remoteKeyLayout) objectKey(bucket, bundle, object string) string {
+	return GetObjectKeyInOss(bucket, bundle, object)
 }
 
-// GetBundle returns the bundle file
-func (f *FileManager) GetBundle(bucket string, bundle string) (io.ReadCloser, error) {
-	if f.useLocalStorage {
-		return f.GetBundleLocal(bucket, bundle)
+func (remoteKeyLayout) bundleKey(bucket, bundle string) string {
+	return GetBundleKeyInOss(bucket, bundle)
+}
+
+// uploadChunkKey returns the key of the upload chunk, the prefix can not collide with the objects and bundles since
+// bucket names do not contain underscores
+func (remoteKeyLayout) uploadChunkKey(upload string, chunk int64) string {
+	return fmt.Sprintf("%s/%s/%d", RemotePathUploadPrefix, upload, chunk)
+}
+
+type FileManager struct {
+	config     *util.ServerConfig
+	store      ObjectStore
+	keys       keyLayout
+	objectDao  dao.ObjectDao
+	bundleDao  dao.BundleDao
+	gnfdClient client.IClient
+}
+
+func NewFileManager(config *util.ServerConfig, objectDao dao.ObjectDao, bundleDao dao.BundleDao, gnfdClient client.IClient) *FileManager {
+	store, err := NewObjectStoreFromConfig(config.BundleConfig)
+	if err != nil {
+		panic(err)
+	}
+	util.Logger.Infof("use object store %s", store.String())
+
+	return &FileManager{
+		config:     config,
+		store:      store,
+		keys:       newKeyLayout(storageBackend(config.BundleConfig)),
+		objectDao:  objectDao,
+		bundleDao:  bundleDao,
+		gnfdClient: gnfdClient,
 	}
-	return f.GetBundleFromOss(bucket, bundle)
 }
 
-// GetBundleLocal returns the bundle file from local storage
-func (f *FileManager) GetBundleLocal(bucket string, bundle string) (io.ReadCloser, error) {
-	filePath := GetBundlePath(f.config.BundleConfig.LocalStoragePath, bucket, bundle)
+// WithGnfdClient returns a copy of the file manager which reads the bundles on Greenfield with the client. Greenfield
+// requests are signed by the default account of the client, so the bundler reads with the client of its account.
+func (f *FileManager) WithGnfdClient(gnfdClient client.IClient) *FileManager {
+	withClient := *f
+	withClient.gnfdClient = gnfdClient
+	return &withClient
+}
+
+// GetObject returns the object file, starting at off and reading at most limit bytes if limit > 0. If the object
+// file is not in the object store, it will be read from the stored bundle, or the bundle on Greenfield if the sealed
+// bundle is not stored, and the whole object file is checked against the hash of the object and then cached in the
+// object store, ranges of the object are read without being verified or cached.
+func (f *FileManager) GetObject(ctx context.Context, bucket string, bundle string, object string, off, limit int64) (io.ReadCloser, error) {
+	objectKey := f.keys.objectKey(bucket, bundle, object)
 
-	if _, err := os.Stat(filePath); os.IsNotExist(err) {
+	startTime := time.Now()
+	objectFile, err := f.store.GetObject(ctx, objectKey, off, limit)
+	if err == nil {
+		util.Logger.Infof("get object from %s, bucket=%s, bundle=%s, object=%s, time=%s", f.store.String(), bucket, bundle, object, time.Since(startTime).String())
+		return objectFile, nil
+	}
+	if !IsNoSuchKey(err) {
 		return nil, err
 	}
 
-	file, err := os.Open(filePath)
+	queriedBundle, err := f.bundleDao.QueryBundle(bucket, bundle)
 	if err != nil {
 		return nil, err
 	}
+	if queriedBundle.Id == 0 {
+		return nil, fmt.Errorf("bundle not found, bucket=%s, bundle=%s", bucket, bundle)
+	}
 
-	return file, nil
-}
-
-// GetBundleFromOss returns the bundle file from oss
-func (f *FileManager) GetBundleFromOss(bucket string, bundle string) (io.ReadCloser, error) {
-	bundleKey := GetBundleKeyInOss(bucket, bundle)
+	startTime = time.Now()
+	// the bundle file of a bundle which is not sealed yet is only in the object store
+	if queriedBundle.Status == database.BundleStatusFinalized || queriedBundle.Status == database.BundleStatusCreatedOnChain {
+		objectFile, err = f.GetObjectFromStoredBundle(ctx, bucket, bundle, object, off, limit)
+		if err != nil {
+			util.Logger.Errorf("failed to get object from stored bundle, bucket=%s, bundle=%s, object=%s, err=%s", bucket, bundle, object, err.Error())
+			return nil, err
+		}
+		util.Logger.Infof("get object from stored bundle, bucket=%s, bundle=%s, object=%s, time=%s", bucket, bundle, object, time.Since(startTime).String())
+	} else if objectFile, err = f.GetObjectFromStoredBundle(ctx, bucket, bundle, object, off, limit); err == nil {
+		util.Logger.Infof("get object from stored bundle, bucket=%s, bundle=%s, object=%s, time=%s", bucket, bundle, object, time.Since(startTime).String())
+	} else if !IsNoSuchKey(err) {
+		util.Logger.Errorf("failed to get object from stored bundle, bucket=%s, bundle=%s, object=%s, err=%s", bucket, bundle, object, err.Error())
+		return nil, err
+	} else {
+		// the sealed bundle which is not stored, e.g. an imported one, is read from Greenfield
+		objectFile, err = f.GetObjectFromGnfdBundle(ctx, bucket, bundle, object, off, limit)
+		if err != nil {
+			util.Logger.Errorf("failed to get object from gnfd bundle, bucket=%s, bundle=%s, object=%s, err=%s", bucket, bundle, object, err.Error())
+			return nil, err
+		}
+		util.Logger.Infof("get object from gnfd bundle, bucket=%s, bundle=%s, object=%s, time=%s", bucket, bundle, object, time.Since(startTime).String())
+	}
+	if off > 0 || limit > 0 {
+		return objectFile, nil
+	}
 
-	bundleFile, err := f.ossStore.GetObject(context.Background(), bundleKey, 0, 0)
+	// cache object in the object store once it is read to the end, which is after its hash is checked
+	cachedFile, err := newCachingReader(ctx, f.store, objectKey, objectFile)
 	if err != nil {
+		_ = objectFile.Close()
+		util.Logger.Errorf("failed to cache object, bucket=%s, bundle=%s, object=%s, err=%s", bucket, bundle, object, err.Error())
 		return nil, err
 	}
-	return bundleFile, nil
+	return cachedFile, nil
 }
 
-// GetObjectFromGnfdBundle returns the object file from gnfd
-func (f *FileManager) GetObjectFromGnfdBundle(bucket string, bundle string, object string) (io.ReadCloser, error) {
+// GetBundle returns the bundle file
+func (f *FileManager) GetBundle(ctx context.Context, bucket string, bundle string) (io.ReadCloser, error) {
+	return f.store.GetObject(ctx, f.keys.bundleKey(bucket, bundle), 0, 0)
+}
+
+// GetObjectFromGnfdBundle returns the object file from gnfd, starting at off of the object and reading at most limit
+// bytes if limit > 0, the whole object file is checked against the hash of the object
+func (f *FileManager) GetObjectFromGnfdBundle(ctx context.Context, bucket string, bundle string, object string, off, limit int64) (io.ReadCloser, error) {
 	// get object from database
 	dbObject, err := f.objectDao.GetObject(bucket, bundle, object)
 	if err != nil {
@@ -127,77 +210,90 @@ func (f *FileManager) GetObjectFromGnfdBundle(bucket string, bundle string, obje
 	if dbObject.Id == 0 {
 		return nil, fmt.Errorf("object not found, bucket=%s, bundle=%s, object=%s", bucket, bundle, object)
 	}
+
+	start, length := objectRangeInBundle(dbObject, off, limit)
+	if length == 0 {
+		return io.NopCloser(bytes.NewReader(nil)), nil
+	}
+
 	// query object from gnfd
 	getObjectOption := types.GetObjectOptions{}
-	err = getObjectOption.SetRange(dbObject.OffsetInBundle, dbObject.OffsetInBundle+dbObject.Size-1) // [start, end]
+	err = getObjectOption.SetRange(start, start+length-1) // [start, end]
 	if err != nil {
 		util.Logger.Errorf("failed to set range for object, bucket=%s, bundle=%s, object=%s, err=%s", bucket, bundle, object, err.Error())
 		return nil, err
 	}
 
-	objectFile, _, err := f.gnfdClient.GetObject(context.Background(), bucket, bundle, getObjectOption)
+	startTime := time.Now()
+	objectFile, _, err := f.gnfdClient.GetObject(ctx, bucket, bundle, getObjectOption)
+	metrics.ObserveGnfdRequest("get_object", startTime, err)
 	if err != nil {
 		return nil, err
 	}
+	if length == dbObject.Size {
+		return verifyObjectFile(dbObject, objectFile)
+	}
 
 	return objectFile, nil
 }
 
-// GetObjectFromOss returns the object file from oss
-func (f *FileManager) GetObjectFromOss(bucket string, bundle string, object string) (io.ReadCloser, error) {
-	objectKey := GetObjectKeyInOss(bucket, bundle, object)
+// GetBundleMetaFromGnfd reads the meta of a bundle of size bytes on gnfd, and returns it with the size of the object
+// data before the meta. The version and the meta size at the end of the bundle are read first, then the meta.
+func (f *FileManager) GetBundleMetaFromGnfd(ctx context.Context, bucket string, bundle string, size int64) (*bundleTypes.BundleMeta, int64, error) {
+	footerLength := int64(bundleTypes.MetaSizeLength + bundleTypes.VersionLength)
+	if size < footerLength {
+		return nil, 0, fmt.Errorf("bundle is too small, size=%d", size)
+	}
+
+	footer, err := f.getGnfdObjectRange(ctx, bucket, bundle, size-footerLength, footerLength)
+	if err != nil {
+		return nil, 0, err
+	}
+	version := binary.BigEndian.Uint64(footer[bundleTypes.MetaSizeLength:])
+	if version != uint64(bundleTypes.BundleVersion_V1) {
+		return nil, 0, fmt.Errorf("invalid bundle version %d", version)
+	}
+	metaSize := binary.BigEndian.Uint64(footer[:bundleTypes.MetaSizeLength])
+	if metaSize == 0 || metaSize > uint64(size-footerLength) {
+		return nil, 0, fmt.Errorf("invalid bundle meta size %d", metaSize)
+	}
+
+	metaData, err := f.getGnfdObjectRange(ctx, bucket, bundle, size-footerLength-int64(metaSize), int64(metaSize))
+	if err != nil {
+		return nil, 0, err
+	}
+	meta := &bundleTypes.BundleMeta{}
+	if err := proto.Unmarshal(metaData, meta); err != nil {
+		return nil, 0, fmt.Errorf("unmarshal bundle meta failed: %v", err)
+	}
+	return meta, size - footerLength - int64(metaSize), nil
+}
+
+// getGnfdObjectRange reads length bytes of the object on gnfd starting at off
+func (f *FileManager) getGnfdObjectRange(ctx context.Context, bucket string, object string, off, length int64) ([]byte, error) {
+	getObjectOption := types.GetObjectOptions{}
+	if err := getObjectOption.SetRange(off, off+length-1); err != nil {
+		return nil, err
+	}
 
 	startTime := time.Now()
-	objectFile, err := f.ossStore.GetObject(context.Background(), objectKey, 0, 0)
+	objectFile, _, err := f.gnfdClient.GetObject(ctx, bucket, object, getObjectOption)
+	metrics.ObserveGnfdRequest("get_object", startTime, err)
 	if err != nil {
-		if IsNoSuchKey(err) {
-			queriedBundle, err := f.bundleDao.QueryBundle(bucket, bundle)
-			if err != nil {
-				return nil, err
-			}
-			if queriedBundle.Id == 0 {
-				return nil, fmt.Errorf("bundle not found, bucket=%s, bundle=%s", bucket, bundle)
-			}
-
-			var objectFile io.ReadCloser
-
-			startTime = time.Now()
-			if queriedBundle.Status == database.BundleStatusFinalized {
-				objectFile, err = f.GetObjectFromOssBundle(bucket, bundle, object)
-				if err != nil {
-					util.Logger.Errorf("failed to get object from oss bundle, bucket=%s, bundle=%s, object=%s, err=%s", bucket, bundle, object, err.Error())
-					return nil, err
-				}
-				util.Logger.Infof("get object from oss bundle, bucket=%s, bundle=%s, object=%s, time=%s", bucket, bundle, object, time.Since(startTime).String())
-			} else {
-				objectFile, err = f.GetObjectFromGnfdBundle(bucket, bundle, object)
-				if err != nil {
-					util.Logger.Errorf("failed to get object from gnfd bundle, bucket=%s, bundle=%s, object=%s, err=%s", bucket, bundle, object, err.Error())
-					return nil, err
-				}
-				util.Logger.Infof("get object from gnfd bundle, bucket=%s, bundle=%s, object=%s, time=%s", bucket, bundle, object, time.Since(startTime).String())
-			}
-
-			var buf bytes.Buffer
-			tempFile := io.TeeReader(objectFile, &buf)
-
-			// store object to oss
-			err = f.ossStore.PutObject(context.Background(), objectKey, tempFile)
-			if err != nil {
-				util.Logger.Errorf("failed to store object to oss, bucket=%s, bundle=%s, object=%s, err=%s", bucket, bundle, object, err.Error())
-				return nil, err
-			}
-
-			return io.NopCloser(&buf), nil
-		}
 		return nil, err
 	}
-	util.Logger.Infof("get object from oss, bucket=%s, bundle=%s, object=%s, time=%s", bucket, bundle, object, time.Since(startTime).String())
-	return objectFile, nil
+	defer objectFile.Close()
+
+	buf := make([]byte, length)
+	if _, err := io.ReadFull(objectFile, buf); err != nil {
+		return nil, fmt.Errorf("read object range failed, bucket=%s, object=%s, err=%v", bucket, object, err)
+	}
+	return buf, nil
 }
 
-// GetObjectFromOssBundle returns the object file from oss bundle
-func (f *FileManager) GetObjectFromOssBundle(bucket string, bundle string, object string) (io.ReadCloser, error) {
+// GetObjectFromStoredBundle returns the object file from the bundle file in the object store, starting at off of the
+// object and reading at most limit bytes if limit > 0, the whole object file is checked against the hash of the object
+func (f *FileManager) GetObjectFromStoredBundle(ctx context.Context, bucket string, bundle string, object string, off, limit int64) (io.ReadCloser, error) {
 	// get object from database
 	dbObject, err := f.objectDao.GetObject(bucket, bundle, object)
 	if err != nil {
@@ -207,187 +303,282 @@ func (f *FileManager) GetObjectFromOssBundle(bucket string, bundle string, objec
 		return nil, fmt.Errorf("object not found, bucket=%s, bundle=%s, object=%s", bucket, bundle, object)
 	}
 
-	bundleKey := GetBundleKeyInOss(bucket, bundle)
+	start, length := objectRangeInBundle(dbObject, off, limit)
+	if length == 0 {
+		return io.NopCloser(bytes.NewReader(nil)), nil
+	}
 
-	objectFile, err := f.ossStore.GetObject(context.Background(), bundleKey, dbObject.OffsetInBundle, dbObject.Size)
+	bundleKey := f.keys.bundleKey(bucket, bundle)
+
+	objectFile, err := f.store.GetObject(ctx, bundleKey, start, length)
 	if err != nil {
 		return nil, err
 	}
+	if length == dbObject.Size {
+		return verifyObjectFile(dbObject, objectFile)
+	}
 	return objectFile, nil
 }
 
-// GetObjectFromLocalBundle returns the object file from local bundle
-func (f *FileManager) GetObjectFromLocalBundle(bucket string, bundleName string, object string) (io.ReadCloser, error) {
-	bundlePath := GetBundlePath(f.config.BundleConfig.LocalStoragePath, bucket, bundleName)
-	if _, err := os.Stat(bundlePath); os.IsNotExist(err) {
-		return nil, fmt.Errorf("bundle file does not exist: %v", err)
+// verifyObjectFile returns a reader of the object file which hashes the file while it is read and checks it against
+// the hash of the object at the end, a mismatch is returned in place of io.EOF as an error wrapping
+// btypes.ErrHashMismatch. The object file of an object whose hash is unknown is not verified.
+func verifyObjectFile(object database.Object, objectFile io.ReadCloser) (io.ReadCloser, error) {
+	if object.HashAlgo == bundleTypes.HashAlgo_Unknown || len(object.Hash) == 0 {
+		return objectFile, nil
 	}
-
-	bdl, err := bundle.NewBundleFromFile(bundlePath)
+	h, err := btypes.NewHash(object.HashAlgo)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create bundle from file: %v", err)
+		_ = objectFile.Close()
+		return nil, err
 	}
+	return &hashVerifyingReader{ReadCloser: objectFile, object: object, hash: h}, nil
+}
 
-	obj, _, err := bdl.GetObject(object)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get object from bundle: %v", err)
+// hashVerifyingReader checks the object file against the hash of the object when it is read to the end
+type hashVerifyingReader struct {
+	io.ReadCloser
+	object database.Object
+	hash   hash.Hash
+}
+
+func (r *hashVerifyingReader) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	r.hash.Write(p[:n])
+	if err == io.EOF {
+		if verifyErr := btypes.CheckHash(r.object.HashAlgo, r.object.Hash, r.hash.Sum(nil)); verifyErr != nil {
+			util.Logger.Errorf("object file does not match its hash, bucket=%s, bundle=%s, object=%s, err=%s", r.object.Bucket, r.object.BundleName, r.object.ObjectName, verifyErr.Error())
+			return n, verifyErr
+		}
 	}
+	return n, err
+}
 
-	return obj, nil
+// cachingReader writes the object file to a temp file while it is read, and puts it into the object store when the
+// object file is read to the end without an error, so only the verified object files are cached
+type cachingReader struct {
+	io.ReadCloser
+	ctx      context.Context
+	store    ObjectStore
+	key      string
+	tempFile *os.File
+	done     bool // whether the cache is put or dropped
 }
 
-// GetObjectLocal returns the object file from local storage
-func (f *FileManager) GetObjectLocal(bucket string, bundle string, object string) (io.ReadCloser, error) {
-	filePath := GetObjectPath(f.config.BundleConfig.LocalStoragePath, bucket, bundle, object)
+func newCachingReader(ctx context.Context, store ObjectStore, key string, objectFile io.ReadCloser) (*cachingReader, error) {
+	tempFile, err := os.CreateTemp(os.TempDir(), "tmp-object-")
+	if err != nil {
+		return nil, err
+	}
+	return &cachingReader{ReadCloser: objectFile, ctx: ctx, store: store, key: key, tempFile: tempFile}, nil
+}
 
-	if _, err := os.Stat(filePath); os.IsNotExist(err) {
-		queriedBundle, err := f.bundleDao.QueryBundle(bucket, bundle)
-		if err != nil {
-			return nil, err
+func (r *cachingReader) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	if n > 0 && !r.done {
+		if _, writeErr := r.tempFile.Write(p[:n]); writeErr != nil {
+			util.Logger.Warnf("write object cache failed, key=%s, err=%v", r.key, writeErr)
+			r.done = true
 		}
-		if queriedBundle.Id == 0 {
-			return nil, fmt.Errorf("bundle not found, bucket=%s, bundle=%s", bucket, bundle)
-		}
-
-		var objectFile io.ReadCloser
-		if queriedBundle.Status == database.BundleStatusFinalized {
-			objectFile, err = f.GetObjectFromLocalBundle(bucket, bundle, object)
-			if err != nil {
-				return nil, err
-			}
-		} else {
-			objectFile, err = f.GetObjectFromGnfdBundle(bucket, bundle, object)
-			if err != nil {
-				return nil, err
-			}
+	}
+	if err == io.EOF && !r.done {
+		r.done = true
+		if _, seekErr := r.tempFile.Seek(0, io.SeekStart); seekErr != nil {
+			util.Logger.Warnf("put object cache failed, key=%s, err=%v", r.key, seekErr)
+		} else if putErr := r.store.PutObject(r.ctx, r.key, r.tempFile); putErr != nil {
+			util.Logger.Warnf("put object cache failed, key=%s, err=%v", r.key, putErr)
 		}
+	}
+	if err != nil && err != io.EOF {
+		r.done = true
+	}
+	return n, err
+}
 
-		// store object to local
-		_, _, err = f.storeLocalFile(filePath, objectFile)
-		if err != nil {
-			util.Logger.Errorf("failed to store object to local, bucket=%s, bundle=%s, object=%s, err=%s", bucket, bundle, object, err.Error())
-			return nil, err
-		}
+// Close closes the object file and deletes the temp file
+func (r *cachingReader) Close() error {
+	err := r.ReadCloser.Close()
+	_ = r.tempFile.Close()
+	_ = os.Remove(r.tempFile.Name())
+	return err
+}
 
-		file, err := os.Open(filePath)
-		if err != nil {
-			return nil, err
-		}
-		return file, nil
+// objectRangeInBundle returns the offset in the bundle and the length of the range of the object starting at off and
+// of at most limit bytes if limit > 0, the range is clipped to the object
+func objectRangeInBundle(object database.Object, off, limit int64) (int64, int64) {
+	if off > object.Size {
+		off = object.Size
 	}
-
-	file, err := os.Open(filePath)
-	if err != nil {
-		return nil, err
+	length := object.Size - off
+	if limit > 0 && limit < length {
+		length = limit
 	}
+	return object.OffsetInBundle + off, length
+}
 
-	return file, nil
+// StoreUploadChunk stores a chunk of a resumable bundle upload, it replaces the chunk if it is stored already
+func (f *FileManager) StoreUploadChunk(ctx context.Context, upload string, chunk int64, in io.Reader) (int64, error) {
+	_, size, err := f.storeFile(ctx, f.keys.uploadChunkKey(upload, chunk), in)
+	return size, err
 }
 
-// StoreObject stores the object file
-func (f *FileManager) StoreObject(bucket string, bundle string, object string, in io.ReadCloser) (string, int64, error) {
-	if f.useLocalStorage {
-		util.Logger.Infof("store object to local, bucket=%s, bundle=%s, object=%s", bucket, bundle, object)
-		return f.StoreObjectLocal(bucket, bundle, object, in)
-	}
-	util.Logger.Infof("store object to oss, bucket=%s, bundle=%s, object=%s", bucket, bundle, object)
-	return f.StoreObjectToOss(bucket, bundle, object, in)
+// GetUploadChunk returns a chunk of a resumable bundle upload
+func (f *FileManager) GetUploadChunk(ctx context.Context, upload string, chunk int64) (io.ReadCloser, error) {
+	return f.store.GetObject(ctx, f.keys.uploadChunkKey(upload, chunk), 0, 0)
 }
 
-// StoreBundle stores the bundle file
-func (f *FileManager) StoreBundle(bucket string, bundle string, in io.ReadCloser) (string, int64, error) {
-	if f.useLocalStorage {
-		util.Logger.Infof("store bundle to local, bundle=%s", bundle)
-		return f.StoreBundleLocal(bucket, bundle, in)
+// DeleteUploadChunks deletes the chunks numbered from 1 to chunks of a resumable bundle upload, missing chunks are
+// skipped
+func (f *FileManager) DeleteUploadChunks(ctx context.Context, upload string, chunks int64) error {
+	for chunk := int64(1); chunk <= chunks; chunk++ {
+		if err := f.store.DeleteObject(ctx, f.keys.uploadChunkKey(upload, chunk)); err != nil && !IsNoSuchKey(err) {
+			return err
+		}
 	}
-	util.Logger.Infof("store bundle to oss, bundle=%s", bundle)
-	return f.StoreBundleToOss(bucket, bundle, in)
+	return nil
 }
 
-// StoreBundleLocal stores the bundle file to local storage
-func (f *FileManager) StoreBundleLocal(bucket, bundle string, in io.ReadCloser) (string, int64, error) {
-	filePath := GetBundlePath(f.config.BundleConfig.LocalStoragePath, bucket, bundle)
-
-	return f.storeLocalFile(filePath, in)
+// ObjectReplacement is a file which replaces a staged object file, it is stored under a key of its own first, so it
+// can be uploaded without holding the lock of the bundle, and then copied over the object file by Swap
+type ObjectReplacement struct {
+	store     ObjectStore
+	objectKey string
+	key       string
+	backupKey string
+	backedUp  bool
 }
 
-// StoreBundleToOss stores the bundle file to oss
-func (f *FileManager) StoreBundleToOss(bucket string, bundle string, in io.ReadCloser) (string, int64, error) {
-	bundleKey := GetBundleKeyInOss(bucket, bundle)
+// StageObjectReplacement stores the file which replaces the staged object file next to it, and returns the
+// replacement and the size of the file. The caller should clean up the replacement once it is swapped in or dropped.
+func (f *FileManager) StageObjectReplacement(ctx context.Context, bucket string, bundle string, object string, in io.Reader) (*ObjectReplacement, int64, error) {
+	suffix := make([]byte, 8)
+	if _, err := rand.Read(suffix); err != nil {
+		return nil, 0, err
+	}
+	objectKey := f.keys.objectKey(bucket, bundle, object)
+	replacement := &ObjectReplacement{
+		store:     f.store,
+		objectKey: objectKey,
+		key:       objectKey + ".replacement-" + hex.EncodeToString(suffix),
+		backupKey: objectKey + ".backup-" + hex.EncodeToString(suffix),
+	}
 
-	buf := new(bytes.Buffer)
-	size, err := io.Copy(buf, in)
+	util.Logger.Infof("store object replacement to %s, bucket=%s, bundle=%s, object=%s", f.store.String(), bucket, bundle, object)
+	_, size, err := f.storeFile(ctx, replacement.key, in)
 	if err != nil {
-		return "", 0, err
+		return nil, 0, err
 	}
+	return replacement, size, nil
+}
 
-	err = f.ossStore.PutObject(context.Background(), bundleKey, buf)
+// Swap copies the replacement over the object file, the replaced file is backed up first so it can be restored
+func (r *ObjectReplacement) Swap(ctx context.Context) error {
+	backedUp, err := copyStoreFile(ctx, r.store, r.objectKey, r.backupKey)
 	if err != nil {
-		return "", 0, err
+		return fmt.Errorf("back up object file failed: %v", err)
 	}
+	r.backedUp = backedUp
 
-	return bundleKey, size, nil
+	if _, err := copyStoreFile(ctx, r.store, r.key, r.objectKey); err != nil {
+		return fmt.Errorf("swap object file failed: %v", err)
+	}
+	return nil
 }
 
-// StoreObjectToOss stores the object file to oss
-func (f *FileManager) StoreObjectToOss(bucket string, bundle string, object string, in io.ReadCloser) (string, int64, error) {
-	objectKey := GetObjectKeyInOss(bucket, bundle, object)
+// Restore restores the object file replaced by Swap, or deletes it if there was no object file before
+func (r *ObjectReplacement) Restore(ctx context.Context) error {
+	if !r.backedUp {
+		err := r.store.DeleteObject(ctx, r.objectKey)
+		if err != nil && !IsNoSuchKey(err) {
+			return err
+		}
+		return nil
+	}
+	_, err := copyStoreFile(ctx, r.store, r.backupKey, r.objectKey)
+	return err
+}
 
-	buf := new(bytes.Buffer)
-	size, err := io.Copy(buf, in)
-	if err != nil {
-		return "", 0, err
+// Cleanup deletes the replacement and the backup of the replaced file
+func (r *ObjectReplacement) Cleanup(ctx context.Context) {
+	for _, key := range []string{r.key, r.backupKey} {
+		if err := r.store.DeleteObject(ctx, key); err != nil && !IsNoSuchKey(err) {
+			util.Logger.Warnf("delete object replacement failed, key=%s, err=%v", key, err)
+		}
 	}
+}
 
-	err = f.ossStore.PutObject(context.Background(), objectKey, buf)
+// copyStoreFile copies the file of a key to another key in the store, it returns false if the source does not exist
+func copyStoreFile(ctx context.Context, store ObjectStore, from string, to string) (bool, error) {
+	in, err := store.GetObject(ctx, from, 0, 0)
+	if IsNoSuchKey(err) {
+		return false, nil
+	}
 	if err != nil {
-		return "", 0, err
+		return false, err
 	}
+	defer in.Close()
 
-	return objectKey, size, nil
+	if err := store.PutObject(ctx, to, in); err != nil {
+		return false, err
+	}
+	return true, nil
 }
 
-// StoreObjectLocal stores the object file to local storage
-func (f *FileManager) StoreObjectLocal(bucket string, bundle string, object string, in io.ReadCloser) (string, int64, error) {
-	filePath := GetObjectPath(f.config.BundleConfig.LocalStoragePath, bucket, bundle, object)
+// DeleteObject deletes the staged object file, it succeeds if the file does not exist
+func (f *FileManager) DeleteObject(ctx context.Context, bucket string, bundle string, object string) error {
+	err := f.store.DeleteObject(ctx, f.keys.objectKey(bucket, bundle, object))
+	if err != nil && !IsNoSuchKey(err) {
+		return err
+	}
+	return nil
+}
 
-	return f.storeLocalFile(filePath, in)
+// DeleteBundle deletes the stored bundle file, it succeeds if the file does not exist
+func (f *FileManager) DeleteBundle(ctx context.Context, bucket string, bundle string) error {
+	err := f.store.DeleteObject(ctx, f.keys.bundleKey(bucket, bundle))
+	if err != nil && !IsNoSuchKey(err) {
+		return err
+	}
+	return nil
 }
 
-// storeLocalFile stores the file to local storage
-func (f *FileManager) storeLocalFile(filePath string, in io.ReadCloser) (string, int64, error) {
-	if !f.localFileExists(filePath) {
-		if err := os.MkdirAll(filepath.Dir(filePath), os.ModePerm); err != nil {
-			return "", 0, err
-		}
+// StoreBundle stores the bundle file
+func (f *FileManager) StoreBundle(ctx context.Context, bucket string, bundle string, in io.ReadCloser) (string, int64, error) {
+	util.Logger.Infof("store bundle to %s, bundle=%s", f.store.String(), bundle)
+	return f.storeFile(ctx, f.keys.bundleKey(bucket, bundle), in)
+}
 
-		file, err := os.Create(filePath)
+// storeFile stores the file to the object store and returns the key and size of the file. Seekable inputs like temp
+// files are handed to the object store as they are, so the store can upload them in parts without buffering.
+func (f *FileManager) storeFile(ctx context.Context, key string, in io.Reader) (string, int64, error) {
+	if rs, ok := in.(io.ReadSeeker); ok {
+		size, err := seekableSize(rs)
 		if err != nil {
 			return "", 0, err
 		}
-		defer file.Close()
-
-		size, err := io.Copy(file, in)
+		err = f.store.PutObject(ctx, key, rs)
 		if err != nil {
 			return "", 0, err
 		}
-
-		util.Logger.Infof("file stored to local, path=%s, size=%d", filePath, size)
-		if err := in.Close(); err != nil {
-			// don't return error here
-			util.Logger.Errorf("failed to close file: %v", err)
-		}
+		return key, size, nil
 	}
 
-	fileInfo, err := os.Stat(filePath)
+	counter := &countingReader{Reader: in}
+	err := f.store.PutObject(ctx, key, counter)
 	if err != nil {
 		return "", 0, err
 	}
 
-	return filePath, fileInfo.Size(), nil
+	return key, counter.n, nil
+}
+
+type countingReader struct {
+	io.Reader
+	n int64
 }
 
-func (f *FileManager) localFileExists(filePath string) bool {
-	_, err := os.Stat(filePath)
-	return !os.IsNotExist(err)
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.Reader.Read(p)
+	c.n += int64(n)
+	return n, err
 }
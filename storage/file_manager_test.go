@@ -0,0 +1,183 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"path/filepath"
+	"testing"
+
+	bundleTypes "github.com/bnb-chain/greenfield-bundle-sdk/types"
+	"github.com/bnb-chain/greenfield-go-sdk/client"
+	"github.com/bnb-chain/greenfield-go-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/node-real/greenfield-bundle-service/dao"
+	"github.com/node-real/greenfield-bundle-service/database"
+	btypes "github.com/node-real/greenfield-bundle-service/types"
+	"github.com/node-real/greenfield-bundle-service/util"
+)
+
+func TestFileManager_GetObjectVerifiesHash(t *testing.T) {
+	db, err := database.ConnectDBWithConfig(&util.DBConfig{
+		DBDialect: "sqlite3",
+		DBPath:    filepath.Join(t.TempDir(), "file_manager.sqlite3"),
+	})
+	require.NoError(t, err)
+	store, err := NewLocalStore(t.TempDir())
+	require.NoError(t, err)
+
+	bundleDao := dao.NewBundleDao(db)
+	f := &FileManager{
+		store:     store,
+		keys:      localKeyLayout{},
+		objectDao: dao.NewObjectDao(db),
+		bundleDao: bundleDao,
+	}
+
+	good, bad := []byte("good object"), []byte("bad object")
+	goodHash, badHash := sha256.Sum256(good), sha256.Sum256([]byte("another object"))
+	_, err = bundleDao.InsertObjectsInOneTransaction(database.Bundle{Bucket: "bucket", Name: "bundle", Status: database.BundleStatusFinalized}, []database.Object{
+		{Bucket: "bucket", BundleName: "bundle", ObjectName: "good", Size: int64(len(good)), HashAlgo: bundleTypes.HashAlgo_SHA256, Hash: goodHash[:]},
+		{Bucket: "bucket", BundleName: "bundle", ObjectName: "bad", OffsetInBundle: int64(len(good)), Size: int64(len(bad)), HashAlgo: bundleTypes.HashAlgo_SHA256, Hash: badHash[:]},
+	}, nil)
+	require.NoError(t, err)
+	ctx := context.Background()
+	require.NoError(t, store.PutObject(ctx, f.keys.bundleKey("bucket", "bundle"), bytes.NewReader(append(append([]byte{}, good...), bad...))))
+
+	objectFile, err := f.GetObject(ctx, "bucket", "bundle", "good", 0, 0)
+	require.NoError(t, err)
+	content, err := io.ReadAll(objectFile)
+	require.NoError(t, err)
+	require.NoError(t, objectFile.Close())
+	assert.Equal(t, good, content)
+	_, err = store.HeadObject(ctx, f.keys.objectKey("bucket", "bundle", "good"))
+	assert.NoError(t, err, "the object which matches its hash should be cached once it is read")
+
+	// the mismatch is returned at the end of the object file in place of io.EOF
+	objectFile, err = f.GetObject(ctx, "bucket", "bundle", "bad", 0, 0)
+	require.NoError(t, err)
+	_, err = io.ReadAll(objectFile)
+	assert.ErrorIs(t, err, btypes.ErrHashMismatch)
+	require.NoError(t, objectFile.Close())
+	_, err = store.HeadObject(ctx, f.keys.objectKey("bucket", "bundle", "bad"))
+	assert.True(t, IsNoSuchKey(err), "the object which does not match its hash should not be cached")
+
+	// ranges of an object are not verified
+	objectFile, err = f.GetObject(ctx, "bucket", "bundle", "bad", 4, 3)
+	require.NoError(t, err)
+	content, err = io.ReadAll(objectFile)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("obj"), content)
+}
+
+func TestFileManager_ObjectReplacement(t *testing.T) {
+	store, err := NewLocalStore(t.TempDir())
+	require.NoError(t, err)
+	f := &FileManager{store: store, keys: localKeyLayout{}}
+	ctx := context.Background()
+	objectKey := f.keys.objectKey("bucket", "bundle", "object")
+	require.NoError(t, store.PutObject(ctx, objectKey, bytes.NewReader([]byte("old"))))
+
+	readObject := func() string {
+		objectFile, err := store.GetObject(ctx, objectKey, 0, 0)
+		require.NoError(t, err)
+		defer objectFile.Close()
+		content, err := io.ReadAll(objectFile)
+		require.NoError(t, err)
+		return string(content)
+	}
+
+	// the staged replacement does not touch the object file until it is swapped in
+	replacement, size, err := f.StageObjectReplacement(ctx, "bucket", "bundle", "object", bytes.NewReader([]byte("new content")))
+	require.NoError(t, err)
+	assert.Equal(t, int64(11), size)
+	assert.Equal(t, "old", readObject())
+
+	require.NoError(t, replacement.Swap(ctx))
+	assert.Equal(t, "new content", readObject())
+
+	// the replaced file is restored if the replacement is rolled back
+	require.NoError(t, replacement.Restore(ctx))
+	assert.Equal(t, "old", readObject())
+
+	replacement.Cleanup(ctx)
+	_, err = store.HeadObject(ctx, replacement.key)
+	assert.True(t, IsNoSuchKey(err))
+	_, err = store.HeadObject(ctx, replacement.backupKey)
+	assert.True(t, IsNoSuchKey(err))
+
+	// restoring a replacement of a new object deletes the object file
+	replacement, _, err = f.StageObjectReplacement(ctx, "bucket", "bundle", "another", bytes.NewReader([]byte("new")))
+	require.NoError(t, err)
+	defer replacement.Cleanup(ctx)
+	require.NoError(t, replacement.Swap(ctx))
+	require.NoError(t, replacement.Restore(ctx))
+	_, err = store.HeadObject(ctx, f.keys.objectKey("bucket", "bundle", "another"))
+	assert.True(t, IsNoSuchKey(err))
+}
+
+// readerGnfdClient is a Greenfield client of the reader account which serves the ranges of the bundles on Greenfield
+type readerGnfdClient struct {
+	client.IClient
+
+	bundles map[string][]byte
+}
+
+func (c *readerGnfdClient) GetObject(ctx context.Context, bucketName, objectName string, opts types.GetObjectOptions) (io.ReadCloser, types.ObjectStat, error) {
+	content, ok := c.bundles[bucketName+"/"+objectName]
+	if !ok {
+		return nil, types.ObjectStat{}, fmt.Errorf("object not found, bucket=%s, object=%s", bucketName, objectName)
+	}
+
+	var start, end int64
+	if _, err := fmt.Sscanf(opts.Range, "bytes=%d-%d", &start, &end); err != nil {
+		return nil, types.ObjectStat{}, err
+	}
+	return io.NopCloser(bytes.NewReader(content[start : end+1])), types.ObjectStat{}, nil
+}
+
+func TestFileManager_GetObjectOfSealedBundle(t *testing.T) {
+	db, err := database.ConnectDBWithConfig(&util.DBConfig{
+		DBDialect: "sqlite3",
+		DBPath:    filepath.Join(t.TempDir(), "file_manager.sqlite3"),
+	})
+	require.NoError(t, err)
+	store, err := NewLocalStore(t.TempDir())
+	require.NoError(t, err)
+
+	bundleDao := dao.NewBundleDao(db)
+	readerClient := &readerGnfdClient{bundles: make(map[string][]byte)}
+	f := &FileManager{
+		store:      store,
+		keys:       localKeyLayout{},
+		objectDao:  dao.NewObjectDao(db),
+		bundleDao:  bundleDao,
+		gnfdClient: readerClient,
+	}
+
+	content := []byte("sealed object")
+	hash := sha256.Sum256(content)
+	for _, bundle := range []string{"stored", "imported"} {
+		_, err = bundleDao.InsertObjectsInOneTransaction(database.Bundle{Bucket: "bucket", Name: bundle, Status: database.BundleStatusSealedOnChain}, []database.Object{
+			{Bucket: "bucket", BundleName: bundle, ObjectName: "object", Size: int64(len(content)), HashAlgo: bundleTypes.HashAlgo_SHA256, Hash: hash[:]},
+		}, nil)
+		require.NoError(t, err)
+	}
+	ctx := context.Background()
+	require.NoError(t, store.PutObject(ctx, f.keys.bundleKey("bucket", "stored"), bytes.NewReader(content)))
+	readerClient.bundles["bucket/imported"] = content
+
+	// the stored bundle is read before the bundle on Greenfield, so the sealed bundles which are stored can be served
+	// without a Greenfield account granted to read them
+	for _, bundle := range []string{"stored", "imported"} {
+		objectFile, err := f.GetObject(ctx, "bucket", bundle, "object", 0, 0)
+		require.NoError(t, err)
+		read, err := io.ReadAll(objectFile)
+		require.NoError(t, err)
+		assert.Equal(t, content, read)
+	}
+}
@@ -0,0 +1,164 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/node-real/greenfield-bundle-service/util"
+)
+
+// LocalStore is an ObjectStore backed by the local disk
+type LocalStore struct {
+	root string
+}
+
+// NewLocalStore returns a new LocalStore that keeps files under the root path
+func NewLocalStore(root string) (*LocalStore, error) {
+	if root == "" {
+		return nil, fmt.Errorf("local storage path is empty")
+	}
+	return &LocalStore{
+		root: root,
+	}, nil
+}
+
+func (l *LocalStore) String() string {
+	return fmt.Sprintf("file://%s", l.root)
+}
+
+func (l *LocalStore) path(key string) string {
+	return filepath.Join(l.root, key)
+}
+
+func (l *LocalStore) GetObject(ctx context.Context, key string, off, limit int64) (io.ReadCloser, error) {
+	file, err := os.Open(l.path(key))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, noSuchKeyError(key)
+		}
+		return nil, err
+	}
+
+	if off > 0 {
+		if _, err := file.Seek(off, io.SeekStart); err != nil {
+			_ = file.Close()
+			return nil, err
+		}
+	}
+	if limit > 0 {
+		return &limitedReadCloser{Reader: io.LimitReader(file, limit), Closer: file}, nil
+	}
+	return file, nil
+}
+
+// PutObject writes the content to a temporary file first and renames it, so readers never see a partial file
+func (l *LocalStore) PutObject(ctx context.Context, key string, in io.Reader) error {
+	filePath := l.path(key)
+	if err := os.MkdirAll(filepath.Dir(filePath), os.ModePerm); err != nil {
+		return err
+	}
+
+	tmpFile, err := os.CreateTemp(filepath.Dir(filePath), filepath.Base(filePath)+".tmp-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpFile.Name())
+
+	size, err := io.Copy(tmpFile, in)
+	if err != nil {
+		_ = tmpFile.Close()
+		return err
+	}
+	if err := tmpFile.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmpFile.Name(), filePath); err != nil {
+		return err
+	}
+
+	util.Logger.Infof("file stored to local, path=%s, size=%d", filePath, size)
+	return nil
+}
+
+func (l *LocalStore) DeleteObject(ctx context.Context, key string) error {
+	err := os.Remove(l.path(key))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+func (l *LocalStore) HeadObject(ctx context.Context, key string) (*ObjectInfo, error) {
+	fileInfo, err := os.Stat(l.path(key))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, noSuchKeyError(key)
+		}
+		return nil, err
+	}
+	if fileInfo.IsDir() {
+		return nil, noSuchKeyError(key)
+	}
+
+	return &ObjectInfo{
+		Key:          key,
+		Size:         fileInfo.Size(),
+		LastModified: fileInfo.ModTime(),
+	}, nil
+}
+
+func (l *LocalStore) ListObjects(ctx context.Context, prefix string) ([]*ObjectInfo, error) {
+	var objects []*ObjectInfo
+
+	// walk from the deepest directory covered by the prefix, then filter by the full prefix
+	walkRoot := l.path(prefix)
+	if fileInfo, err := os.Stat(walkRoot); err != nil || !fileInfo.IsDir() {
+		walkRoot = filepath.Dir(walkRoot)
+	}
+
+	err := filepath.WalkDir(walkRoot, func(filePath string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+
+		key, err := filepath.Rel(l.root, filePath)
+		if err != nil {
+			return err
+		}
+		if !strings.HasPrefix(key, prefix) {
+			return nil
+		}
+
+		fileInfo, err := d.Info()
+		if err != nil {
+			return err
+		}
+		objects = append(objects, &ObjectInfo{
+			Key:          key,
+			Size:         fileInfo.Size(),
+			LastModified: fileInfo.ModTime(),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return objects, nil
+}
+
+type limitedReadCloser struct {
+	io.Reader
+	io.Closer
+}
@@ -0,0 +1,65 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/node-real/greenfield-bundle-service/metrics"
+)
+
+// instrumentedStore is an ObjectStore observing the latency of the calls to the wrapped store
+type instrumentedStore struct {
+	ObjectStore
+	backend string
+}
+
+func newInstrumentedStore(store ObjectStore, backend string) ObjectStore {
+	return &instrumentedStore{
+		ObjectStore: store,
+		backend:     backend,
+	}
+}
+
+func (s *instrumentedStore) observe(method string, start time.Time, err error) {
+	// a missing key is an expected result rather than a failure of the store
+	if IsNoSuchKey(err) {
+		err = nil
+	}
+	metrics.ObserveStoreRequest(s.backend, method, start, err)
+}
+
+func (s *instrumentedStore) GetObject(ctx context.Context, key string, off, limit int64) (io.ReadCloser, error) {
+	start := time.Now()
+	reader, err := s.ObjectStore.GetObject(ctx, key, off, limit)
+	s.observe("get", start, err)
+	return reader, err
+}
+
+func (s *instrumentedStore) PutObject(ctx context.Context, key string, in io.Reader) error {
+	start := time.Now()
+	err := s.ObjectStore.PutObject(ctx, key, in)
+	s.observe("put", start, err)
+	return err
+}
+
+func (s *instrumentedStore) DeleteObject(ctx context.Context, key string) error {
+	start := time.Now()
+	err := s.ObjectStore.DeleteObject(ctx, key)
+	s.observe("delete", start, err)
+	return err
+}
+
+func (s *instrumentedStore) HeadObject(ctx context.Context, key string) (*ObjectInfo, error) {
+	start := time.Now()
+	info, err := s.ObjectStore.HeadObject(ctx, key)
+	s.observe("head", start, err)
+	return info, err
+}
+
+func (s *instrumentedStore) ListObjects(ctx context.Context, prefix string) ([]*ObjectInfo, error) {
+	start := time.Now()
+	objects, err := s.ObjectStore.ListObjects(ctx, prefix)
+	s.observe("list", start, err)
+	return objects, err
+}
@@ -0,0 +1,108 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/node-real/greenfield-bundle-service/util"
+)
+
+const (
+	// StorageBackendLocal stores files on the local disk
+	StorageBackendLocal = "local"
+	// StorageBackendOss stores files in Alibaba Cloud OSS
+	StorageBackendOss = "oss"
+	// StorageBackendS3 stores files in AWS S3 or any S3-compatible service like MinIO
+	StorageBackendS3 = "s3"
+)
+
+// ErrNoSuchKey is returned by an ObjectStore when the requested key does not exist
+var ErrNoSuchKey = errors.New("no such key")
+
+// ObjectInfo describes an object stored in an ObjectStore
+type ObjectInfo struct {
+	Key          string
+	Size         int64
+	LastModified time.Time
+}
+
+// ObjectStore is the storage backend used by FileManager to keep object and bundle files, the keys of the files are
+// laid out by FileManager.
+type ObjectStore interface {
+	String() string
+
+	// GetObject returns the content of the key, starting at off and reading at most limit bytes if limit > 0
+	GetObject(ctx context.Context, key string, off, limit int64) (io.ReadCloser, error)
+	PutObject(ctx context.Context, key string, in io.Reader) error
+	DeleteObject(ctx context.Context, key string) error
+	HeadObject(ctx context.Context, key string) (*ObjectInfo, error)
+	ListObjects(ctx context.Context, prefix string) ([]*ObjectInfo, error)
+}
+
+// NewObjectStoreFromConfig creates the ObjectStore selected by the storage backend of the config.
+//
+// If the storage backend is not set, oss is used when an oss bucket url is configured, and local storage otherwise.
+func NewObjectStoreFromConfig(config *util.BundleConfig) (ObjectStore, error) {
+	backend := storageBackend(config)
+
+	var (
+		store ObjectStore
+		err   error
+	)
+	switch backend {
+	case StorageBackendLocal:
+		store, err = NewLocalStore(config.LocalStoragePath)
+	case StorageBackendOss:
+		store, err = NewOssStoreFromConfig(config)
+	case StorageBackendS3:
+		store, err = NewS3StoreFromConfig(config)
+	default:
+		return nil, fmt.Errorf("invalid storage backend: %s", backend)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return newInstrumentedStore(store, backend), nil
+}
+
+// storageBackend returns the storage backend selected by the config
+func storageBackend(config *util.BundleConfig) string {
+	if config.StorageBackend != "" {
+		return config.StorageBackend
+	}
+	if config.OssBucketUrl != "" {
+		return StorageBackendOss
+	}
+	return StorageBackendLocal
+}
+
+// IsNoSuchKey returns true if the error means the key does not exist in the store
+func IsNoSuchKey(err error) bool {
+	if err == nil {
+		return false
+	}
+	return errors.Is(err, ErrNoSuchKey)
+}
+
+func noSuchKeyError(key string) error {
+	return fmt.Errorf("%w: %s", ErrNoSuchKey, key)
+}
+
+// seekableSize returns the size of the content from the current offset to the end, the offset is kept unchanged
+func seekableSize(rs io.Seeker) (int64, error) {
+	current, err := rs.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return 0, err
+	}
+	end, err := rs.Seek(0, io.SeekEnd)
+	if err != nil {
+		return 0, err
+	}
+	if _, err = rs.Seek(current, io.SeekStart); err != nil {
+		return 0, err
+	}
+	return end - current, nil
+}
@@ -1,6 +1,7 @@
 package storage
 
 import (
+	"bytes"
 	"context"
 	"crypto/tls"
 	"errors"
@@ -11,6 +12,7 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
@@ -39,9 +41,15 @@ const (
 	SAIAMType = "SA"
 )
 
+// OssStore is an ObjectStore backed by Alibaba Cloud OSS
 type OssStore struct {
 	client *oss.Client
 	bucket *oss.Bucket
+
+	// partSize is the size of the parts of multipart uploads, files larger than it are uploaded in parts
+	partSize int64
+	// partParallelism is the number of parts uploaded concurrently
+	partParallelism int
 }
 
 type ossStorageSecretKey struct {
@@ -61,14 +69,44 @@ func getOSSSecretKeyFromEnv(accessId, secretKey string) *ossStorageSecretKey {
 }
 
 func NewOssStoreFromConfig(config *util.BundleConfig) (*OssStore, error) {
+	var (
+		store *OssStore
+		err   error
+	)
 	if config.OssIAMType == AKSKIAMType {
 		key := getOSSSecretKeyFromEnv(OSSAccessId, OSSSecretKey)
-		return NewOssStoreAKSK(config.OssBucketUrl, key.accessKey, key.secretKey)
+		store, err = NewOssStoreAKSK(config.OssBucketUrl, key.accessKey, key.secretKey)
 	} else if config.OssIAMType == SAIAMType {
-		return NewOssStoreSA(config.OssBucketUrl)
+		store, err = NewOssStoreSA(config.OssBucketUrl)
 	} else {
 		panic("invalid oss iam type")
 	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = store.SetMultipart(config.OssPartSize, config.OssPartParallelism)
+	if err != nil {
+		return nil, err
+	}
+	return store, nil
+}
+
+// SetMultipart sets the part size and the parallelism of multipart uploads, zero values keep the defaults
+func (o *OssStore) SetMultipart(partSize int64, parallelism int) error {
+	if partSize != 0 {
+		if partSize < oss.MinPartSize || partSize > oss.MaxPartSize {
+			return fmt.Errorf("invalid oss part size %d, should be in [%d, %d]", partSize, oss.MinPartSize, oss.MaxPartSize)
+		}
+		o.partSize = partSize
+	}
+	if parallelism < 0 {
+		return fmt.Errorf("invalid oss part parallelism %d", parallelism)
+	}
+	if parallelism != 0 {
+		o.partParallelism = parallelism
+	}
+	return nil
 }
 
 func NewOssStoreSA(bucketURL string) (*OssStore, error) {
@@ -103,8 +141,10 @@ func NewOssStoreSA(bucketURL string) (*OssStore, error) {
 	cli.Config.UserAgent = "GREENFIELD-BUNDLE-SERVICE"
 
 	return &OssStore{
-		client: cli,
-		bucket: bucket,
+		client:          cli,
+		bucket:          bucket,
+		partSize:        DefaultOssPartSize,
+		partParallelism: DefaultOssPartParallelism,
 	}, nil
 }
 
@@ -217,8 +257,10 @@ func NewOssStoreAKSK(bucketURL string, accessKeyId string, accessKeySecret strin
 	}
 
 	return &OssStore{
-		client: cli,
-		bucket: bucket,
+		client:          cli,
+		bucket:          bucket,
+		partSize:        DefaultOssPartSize,
+		partParallelism: DefaultOssPartParallelism,
 	}, nil
 }
 
@@ -322,33 +364,114 @@ func (o *OssStore) GetObject(ctx context.Context, key string, off, limit int64)
 				resp.(*oss.Response).Headers.Get(oss.HTTPHeaderOssMetaPrefix+ChecksumAlgo))
 		}
 	}
+	if isOssNotFound(err) {
+		return nil, noSuchKeyError(key)
+	}
 	return resp, err
 }
 
+// PutObject uploads the content in a single request if it is not larger than the part size, and by a multipart
+// upload otherwise. Seekable inputs are uploaded part by part from the input directly, other inputs are buffered
+// at most one part per parallel upload, so the memory used does not grow with the file size.
 func (o *OssStore) PutObject(ctx context.Context, key string, in io.Reader) error {
-	var (
-		option     []oss.Option
-		respHeader http.Header
-	)
+	var options []oss.Option
 	if rs, ok := in.(io.ReadSeeker); ok {
-		option = append(option, oss.Meta(ChecksumAlgo, generateChecksum(rs)))
+		size, err := seekableSize(rs)
+		if err != nil {
+			return err
+		}
+		options = append(options, oss.Meta(ChecksumAlgo, generateChecksum(rs)))
+		if size <= o.partSize {
+			return o.putObject(ctx, key, rs, options...)
+		}
+		if ra, ok := in.(io.ReaderAt); ok {
+			return o.putObjectMultipart(ctx, key, o.sectionParts(ra, size), options...)
+		}
+	}
+
+	var first bytes.Buffer
+	if _, err := first.ReadFrom(io.LimitReader(in, o.partSize)); err != nil {
+		return err
+	}
+	// probe one more byte to tell whether the content fits in a single part
+	probe := make([]byte, 1)
+	n, err := io.ReadFull(in, probe)
+	if errors.Is(err, io.EOF) {
+		content := bytes.NewReader(first.Bytes())
+		if len(options) == 0 {
+			options = append(options, oss.Meta(ChecksumAlgo, generateChecksum(content)))
+		}
+		return o.putObject(ctx, key, content, options...)
+	}
+	if err != nil {
+		return err
 	}
-	option = append(option, oss.GetResponseHeader(&respHeader))
-	err := o.bucket.PutObject(key, in, option...)
-	return err
+	return o.putObjectMultipart(ctx, key, o.streamParts(first.Bytes(), io.MultiReader(bytes.NewReader(probe[:n]), in)), options...)
+}
+
+func (o *OssStore) putObject(ctx context.Context, key string, in io.Reader, options ...oss.Option) error {
+	var respHeader http.Header
+	options = append(options, oss.GetResponseHeader(&respHeader), oss.WithContext(ctx))
+	return o.bucket.PutObject(key, in, options...)
 }
 
 func (o *OssStore) DeleteObject(ctx context.Context, key string) error {
 	return o.bucket.DeleteObject(key)
 }
 
-func IsNoSuchKey(err error) bool {
+func (o *OssStore) HeadObject(ctx context.Context, key string) (*ObjectInfo, error) {
+	header, err := o.bucket.GetObjectDetailedMeta(key)
+	if err != nil {
+		if isOssNotFound(err) {
+			return nil, noSuchKeyError(key)
+		}
+		return nil, err
+	}
+
+	size, err := strconv.ParseInt(header.Get(oss.HTTPHeaderContentLength), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid content length of %s: %v", key, err)
+	}
+	lastModified, _ := http.ParseTime(header.Get(oss.HTTPHeaderLastModified))
+
+	return &ObjectInfo{
+		Key:          key,
+		Size:         size,
+		LastModified: lastModified,
+	}, nil
+}
+
+func (o *OssStore) ListObjects(ctx context.Context, prefix string) ([]*ObjectInfo, error) {
+	var (
+		objects []*ObjectInfo
+		token   string
+	)
+	for {
+		result, err := o.bucket.ListObjectsV2(oss.Prefix(prefix), oss.ContinuationToken(token))
+		if err != nil {
+			return nil, err
+		}
+		for _, object := range result.Objects {
+			objects = append(objects, &ObjectInfo{
+				Key:          object.Key,
+				Size:         object.Size,
+				LastModified: object.LastModified,
+			})
+		}
+		if !result.IsTruncated {
+			return objects, nil
+		}
+		token = result.NextContinuationToken
+	}
+}
+
+func isOssNotFound(err error) bool {
 	if err == nil {
 		return false
 	}
 	var e oss.ServiceError
 	if errors.As(err, &e) {
-		return e.Code == "NoSuchKey"
+		return e.Code == "NoSuchKey" || e.StatusCode == http.StatusNotFound
 	}
 	return false
 }
@@ -0,0 +1,163 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+
+	"github.com/aliyun/aliyun-oss-go-sdk/oss"
+
+	"github.com/node-real/greenfield-bundle-service/util"
+)
+
+const (
+	// DefaultOssPartSize is the default part size of oss multipart uploads, files larger than it are uploaded in parts
+	DefaultOssPartSize = 16 * 1024 * 1024
+	// DefaultOssPartParallelism is the default number of parts uploaded concurrently
+	DefaultOssPartParallelism = 4
+	// MaxOssPartNumber is the max number of parts of an oss multipart upload
+	MaxOssPartNumber = 10000
+)
+
+// ossPart is a part to upload, the reader is either a section of a seekable input or a buffered chunk of a stream
+type ossPart struct {
+	number int
+	reader io.Reader
+	size   int64
+}
+
+// putObjectMultipart uploads the parts produced by nextPart with at most partParallelism parts in flight, the
+// multipart upload is aborted if any part fails so no orphan parts are left in the bucket.
+//
+// nextPart returns io.EOF once all parts are produced, it is always called from the same goroutine.
+func (o *OssStore) putObjectMultipart(ctx context.Context, key string, nextPart func(number int) (*ossPart, error), options ...oss.Option) error {
+	imur, err := o.bucket.InitiateMultipartUpload(key, append(options, oss.WithContext(ctx))...)
+	if err != nil {
+		return fmt.Errorf("initiate multipart upload failed, key=%s, err=%v", key, err)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		parts    []oss.UploadPart
+		firstErr error
+	)
+	setErr := func(err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if firstErr == nil {
+			firstErr = err
+			cancel()
+		}
+	}
+
+	slots := make(chan struct{}, o.partParallelism)
+	for number := 1; ctx.Err() == nil; number++ {
+		if number > MaxOssPartNumber {
+			setErr(fmt.Errorf("too many parts, the part size %d is too small", o.partSize))
+			break
+		}
+
+		// wait for a free slot before producing the part, so buffered parts are bounded by the parallelism
+		slots <- struct{}{}
+		part, err := nextPart(number)
+		if err != nil {
+			<-slots
+			if !errors.Is(err, io.EOF) {
+				setErr(err)
+			}
+			break
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-slots }()
+
+			uploaded, err := o.bucket.UploadPart(imur, part.reader, part.size, part.number, oss.WithContext(ctx))
+			if err != nil {
+				setErr(fmt.Errorf("upload part failed, key=%s, part=%d, err=%v", key, part.number, err))
+				return
+			}
+			mu.Lock()
+			parts = append(parts, uploaded)
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	if firstErr == nil && ctx.Err() != nil {
+		firstErr = ctx.Err()
+	}
+	if firstErr != nil {
+		// the upload context may be canceled already, abort with a fresh one
+		if err := o.bucket.AbortMultipartUpload(imur); err != nil {
+			util.Logger.Errorf("abort multipart upload failed, key=%s, uploadId=%s, err=%s", key, imur.UploadID, err.Error())
+		}
+		return firstErr
+	}
+
+	sort.Slice(parts, func(i, j int) bool {
+		return parts[i].PartNumber < parts[j].PartNumber
+	})
+	_, err = o.bucket.CompleteMultipartUpload(imur, parts, oss.WithContext(ctx))
+	if err != nil {
+		if err := o.bucket.AbortMultipartUpload(imur); err != nil {
+			util.Logger.Errorf("abort multipart upload failed, key=%s, uploadId=%s, err=%s", key, imur.UploadID, err.Error())
+		}
+		return fmt.Errorf("complete multipart upload failed, key=%s, err=%v", key, err)
+	}
+
+	util.Logger.Infof("file stored to oss by multipart upload, key=%s, parts=%d", key, len(parts))
+	return nil
+}
+
+// sectionParts returns a part producer reading the parts directly from a seekable input of the given size,
+// the parts are read concurrently without being buffered in memory
+func (o *OssStore) sectionParts(in io.ReaderAt, size int64) func(number int) (*ossPart, error) {
+	return func(number int) (*ossPart, error) {
+		offset := int64(number-1) * o.partSize
+		if offset >= size {
+			return nil, io.EOF
+		}
+		partSize := o.partSize
+		if offset+partSize > size {
+			partSize = size - offset
+		}
+		return &ossPart{
+			number: number,
+			reader: io.NewSectionReader(in, offset, partSize),
+			size:   partSize,
+		}, nil
+	}
+}
+
+// streamParts returns a part producer buffering the stream part by part, the first part is already read by the
+// caller to decide whether a multipart upload is needed
+func (o *OssStore) streamParts(first []byte, in io.Reader) func(number int) (*ossPart, error) {
+	return func(number int) (*ossPart, error) {
+		if number == 1 {
+			return &ossPart{number: number, reader: bytes.NewReader(first), size: int64(len(first))}, nil
+		}
+
+		buf := make([]byte, o.partSize)
+		n, err := io.ReadFull(in, buf)
+		if n == 0 {
+			if err == nil || errors.Is(err, io.EOF) {
+				return nil, io.EOF
+			}
+			return nil, err
+		}
+		if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil, err
+		}
+		return &ossPart{number: number, reader: bytes.NewReader(buf[:n]), size: int64(n)}, nil
+	}
+}
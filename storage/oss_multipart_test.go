@@ -0,0 +1,160 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/aliyun/aliyun-oss-go-sdk/oss"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeOss is an in-memory OSS server supporting the path style requests of simple and multipart uploads
+type fakeOss struct {
+	mu       sync.Mutex
+	objects  map[string][]byte
+	uploads  map[string]map[int][]byte
+	failPart int
+	aborted  int
+	partPuts int
+}
+
+func (f *fakeOss) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	// path is /{bucket}/{key}
+	parts := strings.SplitN(strings.TrimPrefix(r.URL.Path, "/"), "/", 2)
+	key := ""
+	if len(parts) == 2 {
+		key = parts[1]
+	}
+	query := r.URL.Query()
+	uploadId := query.Get("uploadId")
+
+	switch {
+	case r.Method == http.MethodPost && query.Has("uploads"):
+		uploadId = strconv.Itoa(len(f.uploads) + 1)
+		f.uploads[uploadId] = make(map[int][]byte)
+		_ = xml.NewEncoder(w).Encode(oss.InitiateMultipartUploadResult{Bucket: parts[0], Key: key, UploadID: uploadId})
+	case r.Method == http.MethodPut && uploadId != "":
+		number, _ := strconv.Atoi(query.Get("partNumber"))
+		data, _ := io.ReadAll(r.Body)
+		f.partPuts++
+		if number == f.failPart {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		f.uploads[uploadId][number] = data
+		w.Header().Set("ETag", fmt.Sprintf("\"etag-%d\"", number))
+		w.WriteHeader(http.StatusOK)
+	case r.Method == http.MethodPost && uploadId != "":
+		var complete struct {
+			Parts []oss.UploadPart `xml:"Part"`
+		}
+		_ = xml.NewDecoder(r.Body).Decode(&complete)
+		var content []byte
+		for _, part := range complete.Parts {
+			content = append(content, f.uploads[uploadId][part.PartNumber]...)
+		}
+		f.objects[key] = content
+		delete(f.uploads, uploadId)
+		_ = xml.NewEncoder(w).Encode(oss.CompleteMultipartUploadResult{Bucket: parts[0], Key: key})
+	case r.Method == http.MethodDelete && uploadId != "":
+		f.aborted++
+		delete(f.uploads, uploadId)
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodPut:
+		data, _ := io.ReadAll(r.Body)
+		f.objects[key] = data
+		w.WriteHeader(http.StatusOK)
+	case r.Method == http.MethodGet:
+		data, ok := f.objects[key]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write(data)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func newTestOssStore(t *testing.T, fake *fakeOss) *OssStore {
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	cli, err := oss.New(server.URL, "test", "test", oss.ForcePathStyle(true))
+	require.NoError(t, err)
+	cli.Config.IsEnableCRC = false
+	bucket, err := cli.Bucket("test-bucket")
+	require.NoError(t, err)
+
+	store := &OssStore{client: cli, bucket: bucket, partSize: DefaultOssPartSize, partParallelism: DefaultOssPartParallelism}
+	require.NoError(t, store.SetMultipart(oss.MinPartSize, 3))
+	return store
+}
+
+func TestOssStore_PutObjectMultipart(t *testing.T) {
+	fake := &fakeOss{objects: make(map[string][]byte), uploads: make(map[string]map[int][]byte)}
+	store := newTestOssStore(t, fake)
+
+	content := make([]byte, 3*oss.MinPartSize+123)
+	_, err := rand.Read(content)
+	require.NoError(t, err)
+
+	// seekable input is uploaded by sections of the file
+	tempFile, err := os.CreateTemp("", "oss_multipart_test")
+	require.NoError(t, err)
+	defer os.Remove(tempFile.Name())
+	defer tempFile.Close()
+	_, err = tempFile.Write(content)
+	require.NoError(t, err)
+	_, err = tempFile.Seek(0, io.SeekStart)
+	require.NoError(t, err)
+
+	require.NoError(t, store.PutObject(context.Background(), "file", tempFile))
+	assert.Equal(t, content, fake.objects["file"])
+	assert.Equal(t, 4, fake.partPuts)
+
+	// streams are buffered part by part
+	require.NoError(t, store.PutObject(context.Background(), "stream", io.MultiReader(bytes.NewReader(content))))
+	assert.Equal(t, content, fake.objects["stream"])
+
+	// small streams are uploaded in a single request
+	require.NoError(t, store.PutObject(context.Background(), "small", io.MultiReader(strings.NewReader("small"))))
+	assert.Equal(t, []byte("small"), fake.objects["small"])
+
+	keys := make([]string, 0, len(fake.objects))
+	for key := range fake.objects {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	assert.Equal(t, []string{"file", "small", "stream"}, keys)
+	assert.Empty(t, fake.uploads)
+}
+
+func TestOssStore_PutObjectMultipartAbort(t *testing.T) {
+	fake := &fakeOss{objects: make(map[string][]byte), uploads: make(map[string]map[int][]byte), failPart: 2}
+	store := newTestOssStore(t, fake)
+	store.client.Config.RetryTimes = 0
+
+	content := make([]byte, 3*oss.MinPartSize)
+	err := store.PutObject(context.Background(), "failed", bytes.NewReader(content))
+	assert.Error(t, err)
+	assert.Equal(t, 1, fake.aborted)
+	assert.Empty(t, fake.uploads)
+	assert.NotContains(t, fake.objects, "failed")
+}
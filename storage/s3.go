@@ -0,0 +1,155 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+
+	"github.com/node-real/greenfield-bundle-service/util"
+)
+
+// S3Store is an ObjectStore backed by AWS S3 or any S3-compatible service like MinIO
+type S3Store struct {
+	client   *s3.S3
+	uploader *s3manager.Uploader
+	bucket   string
+}
+
+// NewS3StoreFromConfig returns a new S3Store, the credentials are resolved by the default AWS credential chain,
+// e.g. AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY env variables or the web identity of the service account
+func NewS3StoreFromConfig(config *util.BundleConfig) (*S3Store, error) {
+	if config.S3Bucket == "" {
+		return nil, fmt.Errorf("s3 bucket is empty")
+	}
+
+	awsConfig := &aws.Config{
+		Region:           aws.String(config.S3Region),
+		S3ForcePathStyle: aws.Bool(config.S3ForcePathStyle),
+		MaxRetries:       aws.Int(3),
+		HTTPClient:       getHTTPClient(false),
+	}
+	if config.S3Endpoint != "" {
+		awsConfig.Endpoint = aws.String(config.S3Endpoint)
+	}
+
+	sess, err := session.NewSession(awsConfig)
+	if err != nil {
+		util.Logger.Errorf("create s3 session error, err=%s", err.Error())
+		return nil, err
+	}
+
+	return NewS3Store(sess, config.S3Bucket), nil
+}
+
+// NewS3Store returns a new S3Store for the bucket using the given session
+func NewS3Store(sess *session.Session, bucket string) *S3Store {
+	return &S3Store{
+		client:   s3.New(sess),
+		uploader: s3manager.NewUploader(sess),
+		bucket:   bucket,
+	}
+}
+
+func (s *S3Store) String() string {
+	return fmt.Sprintf("s3://%s/", s.bucket)
+}
+
+func (s *S3Store) GetObject(ctx context.Context, key string, off, limit int64) (io.ReadCloser, error) {
+	input := &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+	}
+	if off > 0 || limit > 0 {
+		if limit > 0 {
+			input.Range = aws.String(fmt.Sprintf("bytes=%d-%d", off, off+limit-1))
+		} else {
+			input.Range = aws.String(fmt.Sprintf("bytes=%d-", off))
+		}
+	}
+
+	output, err := s.client.GetObjectWithContext(ctx, input)
+	if err != nil {
+		if isS3NotFound(err) {
+			return nil, noSuchKeyError(key)
+		}
+		return nil, err
+	}
+	return output.Body, nil
+}
+
+func (s *S3Store) PutObject(ctx context.Context, key string, in io.Reader) error {
+	_, err := s.uploader.UploadWithContext(ctx, &s3manager.UploadInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+		Body:   in,
+	})
+	return err
+}
+
+func (s *S3Store) DeleteObject(ctx context.Context, key string) error {
+	_, err := s.client.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+	})
+	return err
+}
+
+func (s *S3Store) HeadObject(ctx context.Context, key string) (*ObjectInfo, error) {
+	output, err := s.client.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		if isS3NotFound(err) {
+			return nil, noSuchKeyError(key)
+		}
+		return nil, err
+	}
+
+	return &ObjectInfo{
+		Key:          key,
+		Size:         aws.Int64Value(output.ContentLength),
+		LastModified: aws.TimeValue(output.LastModified),
+	}, nil
+}
+
+func (s *S3Store) ListObjects(ctx context.Context, prefix string) ([]*ObjectInfo, error) {
+	var objects []*ObjectInfo
+	err := s.client.ListObjectsV2PagesWithContext(ctx, &s3.ListObjectsV2Input{
+		Bucket: aws.String(s.bucket),
+		Prefix: aws.String(prefix),
+	}, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		for _, content := range page.Contents {
+			objects = append(objects, &ObjectInfo{
+				Key:          aws.StringValue(content.Key),
+				Size:         aws.Int64Value(content.Size),
+				LastModified: aws.TimeValue(content.LastModified),
+			})
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return objects, nil
+}
+
+func isS3NotFound(err error) bool {
+	var reqErr awserr.RequestFailure
+	if errors.As(err, &reqErr) && reqErr.StatusCode() == http.StatusNotFound {
+		return true
+	}
+	var aErr awserr.Error
+	if errors.As(err, &aErr) {
+		return aErr.Code() == s3.ErrCodeNoSuchKey || aErr.Code() == "NotFound"
+	}
+	return false
+}
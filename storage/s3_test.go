@@ -0,0 +1,169 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeS3 is an in-memory S3 server supporting the path style requests used by S3Store
+type fakeS3 struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+}
+
+type fakeS3ListResult struct {
+	XMLName     xml.Name `xml:"ListBucketResult"`
+	IsTruncated bool     `xml:"IsTruncated"`
+	Contents    []struct {
+		Key          string    `xml:"Key"`
+		Size         int64     `xml:"Size"`
+		LastModified time.Time `xml:"LastModified"`
+	} `xml:"Contents"`
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	// path is /{bucket}/{key}
+	parts := strings.SplitN(strings.TrimPrefix(r.URL.Path, "/"), "/", 2)
+	key := ""
+	if len(parts) == 2 {
+		key = parts[1]
+	}
+
+	switch {
+	case r.Method == http.MethodGet && key == "":
+		var result fakeS3ListResult
+		var keys []string
+		for k := range f.objects {
+			if strings.HasPrefix(k, r.URL.Query().Get("prefix")) {
+				keys = append(keys, k)
+			}
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			result.Contents = append(result.Contents, struct {
+				Key          string    `xml:"Key"`
+				Size         int64     `xml:"Size"`
+				LastModified time.Time `xml:"LastModified"`
+			}{Key: k, Size: int64(len(f.objects[k])), LastModified: time.Now().UTC()})
+		}
+		_ = xml.NewEncoder(w).Encode(result)
+	case r.Method == http.MethodPut:
+		data, _ := io.ReadAll(r.Body)
+		f.objects[key] = data
+		w.WriteHeader(http.StatusOK)
+	case r.Method == http.MethodDelete:
+		delete(f.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodGet || r.Method == http.MethodHead:
+		data, ok := f.objects[key]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			if r.Method == http.MethodGet {
+				_, _ = fmt.Fprint(w, `<Error><Code>NoSuchKey</Code><Message>not found</Message></Error>`)
+			}
+			return
+		}
+		status := http.StatusOK
+		if rng := r.Header.Get("Range"); rng != "" {
+			var start, end int
+			if _, err := fmt.Sscanf(rng, "bytes=%d-%d", &start, &end); err != nil {
+				end = len(data) - 1
+			}
+			if end >= len(data) {
+				end = len(data) - 1
+			}
+			data = data[start : end+1]
+			status = http.StatusPartialContent
+		}
+		w.Header().Set("Content-Length", fmt.Sprintf("%d", len(data)))
+		w.Header().Set("Last-Modified", time.Now().UTC().Format(http.TimeFormat))
+		w.WriteHeader(status)
+		if r.Method == http.MethodGet {
+			_, _ = w.Write(data)
+		}
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func newTestS3Store(t *testing.T) *S3Store {
+	server := httptest.NewServer(&fakeS3{objects: make(map[string][]byte)})
+	t.Cleanup(server.Close)
+
+	sess, err := session.NewSession(&aws.Config{
+		Region:           aws.String("us-east-1"),
+		Endpoint:         aws.String(server.URL),
+		S3ForcePathStyle: aws.Bool(true),
+		Credentials:      credentials.NewStaticCredentials("test", "test", ""),
+	})
+	require.NoError(t, err)
+
+	return NewS3Store(sess, "test-bucket")
+}
+
+func TestS3Store(t *testing.T) {
+	store := newTestS3Store(t)
+	ctx := context.Background()
+
+	objectKey := remoteKeyLayout{}.objectKey("bucket", "bundle-0", "dir/object.txt")
+	bundleKey := remoteKeyLayout{}.bundleKey("bucket", "bundle-0")
+	assert.Equal(t, "bucket/bundle-0/dir/object.txt", objectKey)
+	assert.Equal(t, "bucket/bundle-0", bundleKey)
+
+	// objects missing from the store are reported as no such key
+	_, err := store.GetObject(ctx, objectKey, 0, 0)
+	assert.True(t, IsNoSuchKey(err))
+	_, err = store.HeadObject(ctx, objectKey)
+	assert.True(t, IsNoSuchKey(err))
+
+	content := []byte("This is some test data")
+	require.NoError(t, store.PutObject(ctx, objectKey, bytes.NewReader(content)))
+	require.NoError(t, store.PutObject(ctx, bundleKey, bytes.NewReader(content)))
+
+	reader, err := store.GetObject(ctx, objectKey, 0, 0)
+	require.NoError(t, err)
+	data, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	_ = reader.Close()
+	assert.Equal(t, content, data)
+
+	// ranged read of an object inside the bundle
+	reader, err = store.GetObject(ctx, bundleKey, 5, 2)
+	require.NoError(t, err)
+	data, err = io.ReadAll(reader)
+	require.NoError(t, err)
+	_ = reader.Close()
+	assert.Equal(t, []byte("is"), data)
+
+	info, err := store.HeadObject(ctx, objectKey)
+	require.NoError(t, err)
+	assert.Equal(t, int64(len(content)), info.Size)
+
+	objects, err := store.ListObjects(ctx, "bucket/bundle-0/")
+	require.NoError(t, err)
+	require.Len(t, objects, 1)
+	assert.Equal(t, objectKey, objects[0].Key)
+
+	require.NoError(t, store.DeleteObject(ctx, objectKey))
+	_, err = store.GetObject(ctx, objectKey, 0, 0)
+	assert.True(t, IsNoSuchKey(err))
+}
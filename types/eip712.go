@@ -0,0 +1,128 @@
+package types
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"net/http"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+
+	"github.com/node-real/greenfield-bundle-service/util"
+)
+
+const (
+	// AuthSchemeEIP712 prefixes the Authorization header of the requests signed as EIP-712 typed data, e.g.
+	// "EIP712 0x<signature>", the requests without a prefix are signed over their canonical request
+	AuthSchemeEIP712 = "EIP712 "
+
+	EIP712DomainName    = "Greenfield Bundle Service"
+	EIP712DomainVersion = "1"
+	EIP712PrimaryType   = "BundleRequest"
+)
+
+// eip712RequestFields are the fields of the typed data of a request, which are read from the request headers
+var eip712RequestFields = []struct {
+	name   string
+	header string
+}{
+	{"bucketName", HTTPHeaderBucketName},
+	{"bundleName", HTTPHeaderBundleName},
+	{"fileName", HTTPHeaderBundleFileName},
+	{"contentType", HTTPHeaderBundleContentType},
+	{"fileSha256", HTTPHeaderFileSHA256},
+	{"contentSha256", HTTPHeaderContentSHA256},
+	{"manifestSha256", HTTPHeaderManifestSHA256},
+	{"chunkSha256", HTTPHeaderChunkSHA256},
+	{"fileSize", HTTPHeaderFileSize},
+	{"tags", HTTPHeaderTags},
+	{"overwrite", HTTPHeaderOverwrite},
+	{"maxBundleFiles", HTTPHeaderMaxBundleFiles},
+	{"maxBundleSize", HTTPHeaderMaxBundleSize},
+	{"maxFinalizeTime", HTTPHeaderMaxFinalizeTime},
+	{"nonce", HTTPHeaderNonce},
+	{"sessionKey", HTTPHeaderSessionKey},
+	{"sessionKeyAddress", HTTPHeaderSessionKeyAddress},
+	{"sessionKeyBuckets", HTTPHeaderSessionKeyBuckets},
+	{"sessionKeyScopes", HTTPHeaderSessionKeyScopes},
+	{"sessionKeyExpiry", HTTPHeaderSessionKeyExpiry},
+	{"sessionKeyMaxRequests", HTTPHeaderSessionKeyMaxRequests},
+	{"sessionKeyMaxUploadSize", HTTPHeaderSessionKeyMaxUploadSize},
+	{"presignExpiry", HTTPHeaderPresignExpiry},
+}
+
+// eip712ChainId is the chain id of the EIP-712 domain, the EIP-712 signatures are rejected until it is set
+var eip712ChainId *big.Int
+
+// SetEIP712ChainId sets the chain id of the EIP-712 domain of the signed requests, which is the EIP-155 chain id of
+// the Greenfield chain, so the signatures for another chain are rejected
+func SetEIP712ChainId(chainId *big.Int) {
+	eip712ChainId = chainId
+}
+
+// GetEIP712TypedData returns the EIP-712 typed data of the request to sign, which covers the method, the path, the
+// expiry timestamp and the bundle headers of the request, the missing headers are empty strings
+func GetEIP712TypedData(req *http.Request) (apitypes.TypedData, error) {
+	if eip712ChainId == nil {
+		return apitypes.TypedData{}, errors.New("chain id of the EIP-712 domain is not set")
+	}
+
+	requestType := []apitypes.Type{
+		{Name: "method", Type: "string"},
+		{Name: "path", Type: "string"},
+	}
+	message := apitypes.TypedDataMessage{
+		"method": req.Method,
+		"path":   req.URL.Path,
+	}
+	for _, field := range eip712RequestFields {
+		requestType = append(requestType, apitypes.Type{Name: field.name, Type: "string"})
+		message[field.name] = req.Header.Get(field.header)
+	}
+	requestType = append(requestType, apitypes.Type{Name: "expiryTimestamp", Type: "uint256"})
+	message["expiryTimestamp"] = req.Header.Get(HTTPHeaderExpiryTimestamp)
+
+	return apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": {
+				{Name: "name", Type: "string"},
+				{Name: "version", Type: "string"},
+				{Name: "chainId", Type: "uint256"},
+			},
+			EIP712PrimaryType: requestType,
+		},
+		PrimaryType: EIP712PrimaryType,
+		Domain: apitypes.TypedDataDomain{
+			Name:    EIP712DomainName,
+			Version: EIP712DomainVersion,
+			ChainId: (*math.HexOrDecimal256)(new(big.Int).Set(eip712ChainId)),
+		},
+		Message: message,
+	}, nil
+}
+
+// verifyEIP712Signature verifies the hex encoded signature of the EIP-712 typed data of the request and returns the
+// signer's address, the recovery id of the signature can be 27 or 28 as returned by the wallets
+func verifyEIP712Signature(req *http.Request, signature string) (common.Address, error) {
+	typedData, err := GetEIP712TypedData(req)
+	if err != nil {
+		return common.Address{}, err
+	}
+	hash, _, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	sigBytes, err := hex.DecodeString(strings.TrimPrefix(signature, "0x"))
+	if err != nil {
+		return common.Address{}, err
+	}
+	if len(sigBytes) == 65 && sigBytes[64] >= 27 {
+		sigBytes[64] -= 27
+	}
+
+	return util.RecoverAddress(common.BytesToHash(hash), sigBytes)
+}
@@ -0,0 +1,129 @@
+package types_test
+
+import (
+	"crypto/ecdsa"
+	"encoding/hex"
+	"math/big"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/node-real/greenfield-bundle-service/types"
+)
+
+func newSignedRequest(t *testing.T) *http.Request {
+	req, err := http.NewRequest(http.MethodPost, "http://localhost:8080/uploadObject", nil)
+	require.NoError(t, err)
+	req.Header.Set(types.HTTPHeaderBucketName, "bucket")
+	req.Header.Set(types.HTTPHeaderBundleFileName, "a.txt")
+	req.Header.Set(types.HTTPHeaderFileSHA256, "b94d27b9934d3e08a52e52d7da7dabfac484efe37a5380ee9088f7ace2efcde9")
+	req.Header.Set(types.HTTPHeaderTags, `{"kind":"doc"}`)
+	req.Header.Set(types.HTTPHeaderExpiryTimestamp, strconv.FormatInt(time.Now().Add(time.Hour).Unix(), 10))
+	return req
+}
+
+func signEIP712(t *testing.T, req *http.Request, chainId int64) common.Address {
+	privateKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	return signEIP712WithKey(t, req, chainId, privateKey)
+}
+
+func signEIP712WithKey(t *testing.T, req *http.Request, chainId int64, privateKey *ecdsa.PrivateKey) common.Address {
+	types.SetEIP712ChainId(big.NewInt(chainId))
+	typedData, err := types.GetEIP712TypedData(req)
+	require.NoError(t, err)
+	hash, _, err := apitypes.TypedDataAndHash(typedData)
+	require.NoError(t, err)
+	signature, err := crypto.Sign(hash, privateKey)
+	require.NoError(t, err)
+
+	// the wallets return the signatures with the recovery id 27 or 28
+	signature[64] += 27
+	req.Header.Set(types.HTTPHeaderAuthorization, types.AuthSchemeEIP712+"0x"+hex.EncodeToString(signature))
+	return crypto.PubkeyToAddress(privateKey.PublicKey)
+}
+
+func TestVerifySignature_EIP712(t *testing.T) {
+	req := newSignedRequest(t)
+	signer := signEIP712(t, req, 5600)
+
+	address, merr := types.ValidateHeaders(req)
+	require.Nil(t, merr)
+	assert.Equal(t, signer, address)
+
+	// the signature does not cover another file
+	req.Header.Set(types.HTTPHeaderFileSHA256, "0000000000000000000000000000000000000000000000000000000000000000")
+	address, err := types.VerifySignature(req)
+	if err == nil {
+		assert.NotEqual(t, signer, address)
+	}
+
+	// the signature for another chain is not valid
+	req = newSignedRequest(t)
+	signer = signEIP712(t, req, 1017)
+	types.SetEIP712ChainId(big.NewInt(5600))
+	address, err = types.VerifySignature(req)
+	if err == nil {
+		assert.NotEqual(t, signer, address)
+	}
+}
+
+func TestVerifySignature_PersonalSign(t *testing.T) {
+	privateKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	// the requests without a scheme prefix are still signed over the canonical request
+	req := newSignedRequest(t)
+	signature, err := crypto.Sign(types.TextHash(types.GetMsgToSignInBundleAuth(req)), privateKey)
+	require.NoError(t, err)
+	req.Header.Set(types.HTTPHeaderAuthorization, hex.EncodeToString(signature))
+
+	address, err := types.VerifySignature(req)
+	require.NoError(t, err)
+	assert.Equal(t, crypto.PubkeyToAddress(privateKey.PublicKey), address)
+}
+
+func TestVerifySignature_ContentSha256(t *testing.T) {
+	privateKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	// the content hash of an uploaded bundle is signed, so the body can not be swapped
+	req := newSignedRequest(t)
+	req.Header.Set(types.HTTPHeaderContentSHA256, "b94d27b9934d3e08a52e52d7da7dabfac484efe37a5380ee9088f7ace2efcde9")
+	signature, err := crypto.Sign(types.TextHash(types.GetMsgToSignInBundleAuth(req)), privateKey)
+	require.NoError(t, err)
+	req.Header.Set(types.HTTPHeaderAuthorization, hex.EncodeToString(signature))
+
+	req.Header.Set(types.HTTPHeaderContentSHA256, "0000000000000000000000000000000000000000000000000000000000000000")
+	address, err := types.VerifySignature(req)
+	if err == nil {
+		assert.NotEqual(t, crypto.PubkeyToAddress(privateKey.PublicKey), address)
+	}
+
+	req = newSignedRequest(t)
+	req.Header.Set(types.HTTPHeaderContentSHA256, "b94d27b9934d3e08a52e52d7da7dabfac484efe37a5380ee9088f7ace2efcde9")
+	signer := signEIP712(t, req, 5600)
+	req.Header.Set(types.HTTPHeaderContentSHA256, "0000000000000000000000000000000000000000000000000000000000000000")
+	address, err = types.VerifySignature(req)
+	if err == nil {
+		assert.NotEqual(t, signer, address)
+	}
+}
+
+func TestGetCanonicalRequest_SignsBundleHeaders(t *testing.T) {
+	req := newSignedRequest(t)
+	req.Header.Set(types.HTTPHeaderBundleName, "bundle-1")
+	req.Header.Set(types.HTTPHeaderMaxBundleFiles, "100")
+
+	canonicalRequest := types.GetCanonicalRequest(req)
+	assert.Contains(t, canonicalRequest, strings.ToLower(types.HTTPHeaderBundleName)+":bundle-1")
+	assert.Contains(t, canonicalRequest, strings.ToLower(types.HTTPHeaderMaxBundleFiles)+":100")
+}
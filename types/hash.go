@@ -0,0 +1,75 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+
+	bundleTypes "github.com/bnb-chain/greenfield-bundle-sdk/types"
+)
+
+// DefaultHashAlgo is the hash algorithm of the objects if none is configured
+const DefaultHashAlgo = bundleTypes.HashAlgo_SHA256
+
+// ErrHashMismatch is returned when the content of an object does not match its hash
+var ErrHashMismatch = errors.New("object hash mismatch")
+
+// ParseHashAlgo returns the hash algorithm named like in the bundle sdk, e.g. "SHA256", the default hash algorithm is
+// returned for an empty name
+func ParseHashAlgo(name string) (bundleTypes.HashAlgo, error) {
+	if name == "" {
+		return DefaultHashAlgo, nil
+	}
+	value, ok := bundleTypes.HashAlgo_value[name]
+	if !ok || bundleTypes.HashAlgo(value) == bundleTypes.HashAlgo_Unknown {
+		return bundleTypes.HashAlgo_Unknown, fmt.Errorf("unsupported hash algorithm %s", name)
+	}
+	return bundleTypes.HashAlgo(value), nil
+}
+
+// NewHash returns a new hash computing the hash algorithm
+func NewHash(algo bundleTypes.HashAlgo) (hash.Hash, error) {
+	switch algo {
+	case bundleTypes.HashAlgo_SHA256:
+		return sha256.New(), nil
+	default:
+		return nil, fmt.Errorf("unsupported hash algorithm %s", algo.String())
+	}
+}
+
+// ComputeHash returns the hash of the content read from r with the hash algorithm
+func ComputeHash(algo bundleTypes.HashAlgo, r io.Reader) ([]byte, error) {
+	h, err := NewHash(algo)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := io.Copy(h, r); err != nil {
+		return nil, err
+	}
+	return h.Sum(nil), nil
+}
+
+// VerifyHash checks the content against the hash computed with the hash algorithm, it returns ErrHashMismatch if they
+// do not match. The content of an object whose hash is unknown is not verified.
+func VerifyHash(algo bundleTypes.HashAlgo, expected []byte, content []byte) error {
+	if algo == bundleTypes.HashAlgo_Unknown || len(expected) == 0 {
+		return nil
+	}
+	actual, err := ComputeHash(algo, bytes.NewReader(content))
+	if err != nil {
+		return err
+	}
+	return CheckHash(algo, expected, actual)
+}
+
+// CheckHash checks the hash computed with the hash algorithm against the expected one, it returns ErrHashMismatch if
+// they do not match
+func CheckHash(algo bundleTypes.HashAlgo, expected []byte, actual []byte) error {
+	if !bytes.Equal(actual, expected) {
+		return fmt.Errorf("%w, algo=%s, expected=%x, actual=%x", ErrHashMismatch, algo.String(), expected, actual)
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package types_test
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	bundleTypes "github.com/bnb-chain/greenfield-bundle-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/node-real/greenfield-bundle-service/types"
+)
+
+func TestParseHashAlgo(t *testing.T) {
+	algo, err := types.ParseHashAlgo("")
+	require.NoError(t, err)
+	assert.Equal(t, bundleTypes.HashAlgo_SHA256, algo)
+
+	algo, err = types.ParseHashAlgo("SHA256")
+	require.NoError(t, err)
+	assert.Equal(t, bundleTypes.HashAlgo_SHA256, algo)
+
+	_, err = types.ParseHashAlgo("Unknown")
+	assert.Error(t, err)
+	_, err = types.ParseHashAlgo("MD5")
+	assert.Error(t, err)
+}
+
+func TestVerifyHash(t *testing.T) {
+	content := []byte("hello world")
+	hash := sha256.Sum256(content)
+
+	assert.NoError(t, types.VerifyHash(bundleTypes.HashAlgo_SHA256, hash[:], content))
+	assert.ErrorIs(t, types.VerifyHash(bundleTypes.HashAlgo_SHA256, hash[:], []byte("hello world!")), types.ErrHashMismatch)
+
+	// the content of an object without a hash is not verified
+	assert.NoError(t, types.VerifyHash(bundleTypes.HashAlgo_Unknown, nil, content))
+	assert.NoError(t, types.VerifyHash(bundleTypes.HashAlgo_SHA256, nil, content))
+}
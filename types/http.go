@@ -3,6 +3,7 @@ package types
 import (
 	"bytes"
 	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
@@ -15,6 +16,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/go-openapi/runtime/middleware"
 	"golang.org/x/crypto/sha3"
 
 	"github.com/node-real/greenfield-bundle-service/models"
@@ -27,13 +29,31 @@ const (
 	HTTPHeaderUnsignedMsg = "X-Bundle-Unsigned-Msg"
 
 	HTTPHeaderFileSHA256        = "X-Bundle-File-Sha256"
+	HTTPHeaderContentSHA256     = "X-Bundle-Content-Sha256"
+	HTTPHeaderManifestSHA256    = "X-Bundle-Manifest-Sha256"
+	HTTPHeaderChunkSHA256       = "X-Bundle-Chunk-Sha256"
+	HTTPHeaderFileSize          = "X-Bundle-File-Size"
 	HTTPHeaderBucketName        = "X-Bundle-Bucket-Name"
 	HTTPHeaderTags              = "X-Bundle-Tags"
 	HTTPHeaderMaxBundleSize     = "X-Bundle-Max-Bundle-Size"
 	HTTPHeaderMaxFileSize       = "X-Bundle-Max-File-Size"
+	HTTPHeaderMaxBundleFiles    = "X-Bundle-Max-Bundle-Files"
 	HTTPHeaderMaxFinalizeTime   = "X-Bundle-Max-Finalize-Time"
 	HTTPHeaderBundleFileName    = "X-Bundle-File-Name"
 	HTTPHeaderBundleContentType = "X-Bundle-Content-Type"
+	HTTPHeaderOverwrite         = "X-Bundle-Overwrite"
+	HTTPHeaderBundleName        = "X-Bundle-Name"
+	HTTPHeaderNonce             = "X-Bundle-Nonce"
+
+	HTTPHeaderSessionKey              = "X-Bundle-Session-Key"
+	HTTPHeaderSessionKeyAddress       = "X-Bundle-Session-Key-Address"
+	HTTPHeaderSessionKeyBuckets       = "X-Bundle-Session-Key-Buckets"
+	HTTPHeaderSessionKeyScopes        = "X-Bundle-Session-Key-Scopes"
+	HTTPHeaderSessionKeyExpiry        = "X-Bundle-Session-Key-Expiry"
+	HTTPHeaderSessionKeyMaxRequests   = "X-Bundle-Session-Key-Max-Requests"
+	HTTPHeaderSessionKeyMaxUploadSize = "X-Bundle-Session-Key-Max-Upload-Size"
+
+	HTTPHeaderPresignExpiry = "X-Bundle-Presign-Expiry"
 
 	// HTTPHeaderExpiryTimestamp defines the expiry timestamp, which is the ISO 8601 datetime string (e.g. 2021-09-30T16:25:24Z), and the maximum Timestamp since the request sent must be less than MaxExpiryAgeInSec (seven days).
 	HTTPHeaderExpiryTimestamp = "X-Bundle-Expiry-Timestamp"
@@ -44,16 +64,32 @@ const (
 
 var supportedHeaders = []string{
 	HTTPHeaderFileSHA256,
+	HTTPHeaderContentSHA256,
+	HTTPHeaderManifestSHA256,
+	HTTPHeaderChunkSHA256,
+	HTTPHeaderFileSize,
 	HTTPHeaderContentType,
 	HTTPHeaderUnsignedMsg,
 	HTTPHeaderBucketName,
+	HTTPHeaderBundleName,
 	HTTPHeaderBundleFileName,
 	HTTPHeaderBundleContentType,
+	HTTPHeaderOverwrite,
 	HTTPHeaderTags,
 	HTTPHeaderMaxBundleSize,
 	HTTPHeaderMaxFileSize,
+	HTTPHeaderMaxBundleFiles,
 	HTTPHeaderMaxFinalizeTime,
 	HTTPHeaderExpiryTimestamp,
+	HTTPHeaderNonce,
+	HTTPHeaderSessionKey,
+	HTTPHeaderSessionKeyAddress,
+	HTTPHeaderSessionKeyBuckets,
+	HTTPHeaderSessionKeyScopes,
+	HTTPHeaderSessionKeyExpiry,
+	HTTPHeaderSessionKeyMaxRequests,
+	HTTPHeaderSessionKeyMaxUploadSize,
+	HTTPHeaderPresignExpiry,
 }
 
 func initSupportHeaders() map[string]struct{} {
@@ -211,13 +247,17 @@ func TextAndHash(data []byte) ([]byte, string) {
 	return hasher.Sum(nil), msg
 }
 
-// VerifySignature verifies the signature of the given message hash and returns the signer's address.
+// VerifySignature verifies the signature of the given message hash and returns the signer's address. The requests
+// whose Authorization header starts with AuthSchemeEIP712 are verified as EIP-712 typed data instead.
 func VerifySignature(req *http.Request) (common.Address, error) {
+	requestSignature := req.Header.Get(HTTPHeaderAuthorization)
+	if strings.HasPrefix(requestSignature, AuthSchemeEIP712) {
+		return verifyEIP712Signature(req, strings.TrimPrefix(requestSignature, AuthSchemeEIP712))
+	}
+
 	messageToSign := GetMsgToSignInBundleAuth(req)
 	messageHash := TextHash(messageToSign)
 
-	requestSignature := req.Header.Get(HTTPHeaderAuthorization)
-
 	sigBytes, err := hex.DecodeString(requestSignature)
 	if err != nil {
 		return common.Address{}, err
@@ -230,6 +270,38 @@ func VerifySignature(req *http.Request) (common.Address, error) {
 	return address, nil
 }
 
+// maxExpiryAges are the maximum expiry ages in seconds of the requests per api operation, which are shorter than
+// MaxExpiryAgeInSec
+var maxExpiryAges map[string]int64
+
+// SetMaxExpiryAges sets the maximum expiry ages in seconds of the requests per api operation id, the requests of the
+// other operations expire within MaxExpiryAgeInSec
+func SetMaxExpiryAges(ages map[string]int64) error {
+	for operation, age := range ages {
+		if age <= 0 || age > MaxExpiryAgeInSec {
+			return fmt.Errorf("max expiry age of %s should be between 1 and %d seconds", operation, MaxExpiryAgeInSec)
+		}
+	}
+	maxExpiryAges = ages
+	return nil
+}
+
+// getOperationId returns the id of the api operation of the request, it is empty if the request is not routed
+func getOperationId(req *http.Request) string {
+	if route := middleware.MatchedRouteFrom(req); route != nil && route.Operation != nil {
+		return route.Operation.ID
+	}
+	return ""
+}
+
+// getMaxExpiryAge returns the maximum expiry age in seconds of the request, which depends on its api operation
+func getMaxExpiryAge(req *http.Request) int64 {
+	if age, ok := maxExpiryAges[getOperationId(req)]; ok {
+		return age
+	}
+	return MaxExpiryAgeInSec
+}
+
 // ValidateExpiryTimestamp validates the expiry timestamp
 func ValidateExpiryTimestamp(req *http.Request) error {
 	expiryTimestamp := req.Header.Get(HTTPHeaderExpiryTimestamp)
@@ -246,7 +318,7 @@ func ValidateExpiryTimestamp(req *http.Request) error {
 	if expiryTime < time.Now().Unix() {
 		return fmt.Errorf("expiry timestamp is expired")
 	}
-	if expiryTime-time.Now().Unix() > MaxExpiryAgeInSec {
+	if expiryTime-time.Now().Unix() > getMaxExpiryAge(req) {
 		return fmt.Errorf("expiry timestamp is too far in the future")
 	}
 	return nil
@@ -284,6 +356,16 @@ func ValidateHeaders(req *http.Request) (common.Address, *models.Error) {
 		}
 	}
 
+	// the nonce is used at last, so it is not used up by an invalid request
+	if merr := validateNonce(req, signerAddress); merr != nil {
+		return common.Address{}, merr
+	}
+
+	// the requests signed by a session key act on behalf of its owner
+	if req.Header.Get(HTTPHeaderSessionKey) != "" {
+		return validateSessionKey(req, signerAddress)
+	}
+
 	return signerAddress, nil
 }
 
@@ -292,6 +374,22 @@ func ValidateTags(tags string) *models.Error {
 		return InvalidTagsErrorWithError(fmt.Errorf("tags length should be less than %d", MaxTagsLength))
 	}
 
+	var parsed map[string]string
+	if err := json.Unmarshal([]byte(tags), &parsed); err != nil {
+		return InvalidTagsErrorWithError(err)
+	}
+
+	return ValidateTagKeys(parsed)
+}
+
+// ValidateTagKeys validates the keys of the tags, which are indexed to query the objects by tag
+func ValidateTagKeys(tags map[string]string) *models.Error {
+	for key := range tags {
+		if len(key) > MaxTagKeyLength {
+			return InvalidTagsErrorWithError(fmt.Errorf("tag key length should not be greater than %d", MaxTagKeyLength))
+		}
+	}
+
 	return nil
 }
 
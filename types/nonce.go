@@ -0,0 +1,52 @@
+package types
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/node-real/greenfield-bundle-service/models"
+)
+
+// NonceStore records the nonces of the signed requests, UseNonce returns false if the nonce is already used by a
+// request of the signer which has not expired
+type NonceStore interface {
+	UseNonce(signer string, nonce string, expireAt time.Time) (bool, error)
+}
+
+// nonceStore records the nonces of the signed requests, the nonces are not checked until it is set
+var nonceStore NonceStore
+
+// SetNonceStore sets the store of the nonces of the signed requests
+func SetNonceStore(store NonceStore) {
+	nonceStore = store
+}
+
+// validateNonce checks that the optional nonce of the signed request is not used by another request of the signer,
+// and records it until the request expires, so the request can not be replayed
+func validateNonce(req *http.Request, signerAddress common.Address) *models.Error {
+	nonce := req.Header.Get(HTTPHeaderNonce)
+	if nonce == "" || nonceStore == nil {
+		return nil
+	}
+	if len(nonce) > MaxNonceLength {
+		return ErrorInvalidNonce
+	}
+
+	// the expiry timestamp is validated before
+	expiryTime, err := strconv.ParseInt(req.Header.Get(HTTPHeaderExpiryTimestamp), 10, 64)
+	if err != nil {
+		return ErrorInvalidExpiryTimestamp
+	}
+
+	ok, err := nonceStore.UseNonce(signerAddress.String(), nonce, time.Unix(expiryTime, 0))
+	if err != nil {
+		return InternalErrorWithError(err)
+	}
+	if !ok {
+		return ErrorNonceUsed
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package types_test
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/node-real/greenfield-bundle-service/types"
+)
+
+type memoryNonceStore struct {
+	mu     sync.Mutex
+	nonces map[string]time.Time
+}
+
+func (s *memoryNonceStore) UseNonce(signer string, nonce string, expireAt time.Time) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := signer + "/" + nonce
+	if _, ok := s.nonces[key]; ok {
+		return false, nil
+	}
+	s.nonces[key] = expireAt
+	return true, nil
+}
+
+func TestValidateHeaders_Nonce(t *testing.T) {
+	types.SetNonceStore(&memoryNonceStore{nonces: make(map[string]time.Time)})
+	defer types.SetNonceStore(nil)
+
+	sign := func(nonce string) *http.Request {
+		req := newSignedRequest(t)
+		req.Header.Set(types.HTTPHeaderNonce, nonce)
+		signEIP712(t, req, 5600)
+		return req
+	}
+
+	_, merr := types.ValidateHeaders(sign("nonce-1"))
+	require.Nil(t, merr)
+
+	// a replayed request is rejected
+	req := sign("nonce-2")
+	_, merr = types.ValidateHeaders(req)
+	require.Nil(t, merr)
+	_, merr = types.ValidateHeaders(req)
+	assert.Equal(t, types.ErrorNonceUsed, merr)
+
+	_, merr = types.ValidateHeaders(sign(strings.Repeat("n", types.MaxNonceLength+1)))
+	assert.Equal(t, types.ErrorInvalidNonce, merr)
+
+	// the nonce is optional
+	_, merr = types.ValidateHeaders(sign(""))
+	assert.Nil(t, merr)
+}
+
+func TestSetMaxExpiryAges(t *testing.T) {
+	assert.NoError(t, types.SetMaxExpiryAges(map[string]int64{"deleteBundle": 300}))
+	assert.Error(t, types.SetMaxExpiryAges(map[string]int64{"deleteBundle": 0}))
+	assert.Error(t, types.SetMaxExpiryAges(map[string]int64{"deleteBundle": types.MaxExpiryAgeInSec + 1}))
+	assert.NoError(t, types.SetMaxExpiryAges(nil))
+}
@@ -0,0 +1,114 @@
+package types
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// the query parameters of a presigned url of an object
+	PresignQuerySigner     = "presignSigner"
+	PresignQueryBundleName = "presignBundleName"
+	PresignQueryExpiry     = "presignExpiry"
+	PresignQuerySignature  = "presignSignature"
+
+	MaxPresignExpiryAge = MaxExpiryAgeInSec // 7 days
+
+	MinPresignSecretLength = 32 // bytes of the shortest presign secret, the length of a HMAC-SHA256 key
+)
+
+// presignSecret is the key of the signatures of the presigned urls, the presigned urls are rejected until it is set
+var presignSecret []byte
+
+// SetPresignSecret sets the key of the signatures of the presigned urls, which should be shared by the servers, an
+// empty secret disables the presigned urls
+func SetPresignSecret(secret []byte) error {
+	if len(secret) != 0 && len(secret) < MinPresignSecretLength {
+		return fmt.Errorf("presign secret should be at least %d bytes", MinPresignSecretLength)
+	}
+	presignSecret = secret
+	return nil
+}
+
+// PresignObject returns the query parameters of a url which reads the object of the bucket on behalf of the signer
+// until the expiry timestamp. The url reads the object in the bundle, or the latest object with the name in the bucket
+// if the bundle name is empty.
+func PresignObject(signerAddress common.Address, bucketName string, bundleName string, objectName string, expiryTimestamp int64) (url.Values, error) {
+	signature, err := presignSignature(signerAddress, bucketName, bundleName, objectName, expiryTimestamp)
+	if err != nil {
+		return nil, err
+	}
+
+	query := url.Values{}
+	query.Set(PresignQuerySigner, signerAddress.String())
+	if bundleName != "" {
+		query.Set(PresignQueryBundleName, bundleName)
+	}
+	query.Set(PresignQueryExpiry, strconv.FormatInt(expiryTimestamp, 10))
+	query.Set(PresignQuerySignature, signature)
+	return query, nil
+}
+
+// IsPresignedRequest returns whether the request is sent to a presigned url
+func IsPresignedRequest(req *http.Request) bool {
+	return req.URL.Query().Get(PresignQuerySignature) != ""
+}
+
+// VerifyPresignedRequest verifies that the request is sent to an unexpired presigned url of the object in the bundle
+// of the bucket, and returns the signer who requested the url
+func VerifyPresignedRequest(req *http.Request, bucketName string, bundleName string, objectName string) (common.Address, error) {
+	query := req.URL.Query()
+	signer := query.Get(PresignQuerySigner)
+	if !common.IsHexAddress(signer) {
+		return common.Address{}, errors.New("presigned signer is invalid")
+	}
+	expiryTimestamp, err := strconv.ParseInt(query.Get(PresignQueryExpiry), 10, 64)
+	if err != nil {
+		return common.Address{}, errors.New("presigned expiry timestamp is invalid")
+	}
+	if expiryTimestamp < time.Now().Unix() {
+		return common.Address{}, errors.New("presigned url is expired")
+	}
+
+	// a url presigned without a bundle name reads the latest object with the name in any bundle
+	presignedBundleName := query.Get(PresignQueryBundleName)
+	if presignedBundleName != "" && presignedBundleName != bundleName {
+		return common.Address{}, errors.New("presigned url is not for the bundle")
+	}
+
+	signerAddress := common.HexToAddress(signer)
+	expected, err := presignSignature(signerAddress, bucketName, presignedBundleName, objectName, expiryTimestamp)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if !hmac.Equal([]byte(expected), []byte(query.Get(PresignQuerySignature))) {
+		return common.Address{}, errors.New("presigned signature is invalid")
+	}
+	return signerAddress, nil
+}
+
+// presignSignature returns the hex encoded HMAC-SHA256 of the fields of a presigned url, the fields are encoded as a
+// JSON array so they can not be shifted into each other
+func presignSignature(signerAddress common.Address, bucketName string, bundleName string, objectName string, expiryTimestamp int64) (string, error) {
+	if len(presignSecret) == 0 {
+		return "", errors.New("presigned urls are not enabled")
+	}
+
+	message, err := json.Marshal([]string{signerAddress.String(), bucketName, bundleName, objectName, strconv.FormatInt(expiryTimestamp, 10)})
+	if err != nil {
+		return "", err
+	}
+	mac := hmac.New(sha256.New, presignSecret)
+	mac.Write(message)
+	return hex.EncodeToString(mac.Sum(nil)), nil
+}
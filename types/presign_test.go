@@ -0,0 +1,74 @@
+package types_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/node-real/greenfield-bundle-service/types"
+)
+
+func TestVerifyPresignedRequest(t *testing.T) {
+	signer := common.HexToAddress("0x0000000000000000000000000000000000000001")
+	expiry := time.Now().Add(time.Hour).Unix()
+
+	// the presigned urls are disabled without a secret
+	_, err := types.PresignObject(signer, "bucket", "", "a.txt", expiry)
+	assert.Error(t, err)
+
+	// the secret which is too short to sign the urls is rejected
+	assert.Error(t, types.SetPresignSecret([]byte("secret")))
+	_, err = types.PresignObject(signer, "bucket", "", "a.txt", expiry)
+	assert.Error(t, err)
+
+	require.NoError(t, types.SetPresignSecret([]byte("0123456789abcdef0123456789abcdef")))
+	defer types.SetPresignSecret(nil)
+
+	newRequest := func(bundleName string, objectName string, expiryTimestamp int64) *http.Request {
+		query, err := types.PresignObject(signer, "bucket", bundleName, objectName, expiryTimestamp)
+		require.NoError(t, err)
+		req, err := http.NewRequest(http.MethodGet, "http://localhost:8080/v1/view/bucket/"+objectName+"?"+query.Encode(), nil)
+		require.NoError(t, err)
+		require.True(t, types.IsPresignedRequest(req))
+		return req
+	}
+
+	// a url presigned without a bundle reads the object in any bundle
+	address, err := types.VerifyPresignedRequest(newRequest("", "a.txt", expiry), "bucket", "bundle", "a.txt")
+	require.NoError(t, err)
+	assert.Equal(t, signer, address)
+
+	// a url presigned with a bundle reads the object in the bundle only
+	req := newRequest("bundle", "a.txt", expiry)
+	_, err = types.VerifyPresignedRequest(req, "bucket", "bundle", "a.txt")
+	assert.NoError(t, err)
+	_, err = types.VerifyPresignedRequest(req, "bucket", "other", "a.txt")
+	assert.Error(t, err)
+
+	// the url can not be used for another object or bucket
+	_, err = types.VerifyPresignedRequest(newRequest("", "a.txt", expiry), "bucket", "bundle", "b.txt")
+	assert.Error(t, err)
+	_, err = types.VerifyPresignedRequest(newRequest("", "a.txt", expiry), "other", "bundle", "a.txt")
+	assert.Error(t, err)
+
+	// the expiry and the signer of the url can not be changed
+	req = newRequest("", "a.txt", expiry)
+	query := req.URL.Query()
+	query.Set(types.PresignQueryExpiry, "9999999999")
+	req.URL.RawQuery = query.Encode()
+	_, err = types.VerifyPresignedRequest(req, "bucket", "bundle", "a.txt")
+	assert.Error(t, err)
+	req = newRequest("", "a.txt", expiry)
+	query = req.URL.Query()
+	query.Set(types.PresignQuerySigner, "0x0000000000000000000000000000000000000002")
+	req.URL.RawQuery = query.Encode()
+	_, err = types.VerifyPresignedRequest(req, "bucket", "bundle", "a.txt")
+	assert.Error(t, err)
+
+	_, err = types.VerifyPresignedRequest(newRequest("", "a.txt", time.Now().Add(-time.Minute).Unix()), "bucket", "bundle", "a.txt")
+	assert.Error(t, err)
+}
@@ -0,0 +1,136 @@
+package types
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	HTTPHeaderRange           = "Range"
+	HTTPHeaderIfRange         = "If-Range"
+	HTTPHeaderIfNoneMatch     = "If-None-Match"
+	HTTPHeaderIfModifiedSince = "If-Modified-Since"
+)
+
+// ErrRangeNotSatisfiable is returned when none of the requested bytes are in the file
+var ErrRangeNotSatisfiable = errors.New("range not satisfiable")
+
+// ByteRange is the range of Length bytes of a file starting at Start
+type ByteRange struct {
+	Start  int64
+	Length int64
+}
+
+// ContentRange returns the Content-Range header value of the range of a file of the size
+func (r *ByteRange) ContentRange(size int64) string {
+	return fmt.Sprintf("bytes %d-%d/%d", r.Start, r.Start+r.Length-1, size)
+}
+
+// ParseRange parses the Range header for a file of the size. Only a single byte range is supported, nil is returned
+// for an empty or malformed header and for several ranges, in which case the whole file should be served.
+func ParseRange(header string, size int64) (*ByteRange, error) {
+	const unit = "bytes="
+	if len(header) < len(unit) || !strings.EqualFold(header[:len(unit)], unit) {
+		return nil, nil
+	}
+	spec := strings.TrimSpace(header[len(unit):])
+	if strings.Contains(spec, ",") {
+		return nil, nil
+	}
+	first, last, found := strings.Cut(spec, "-")
+	if !found {
+		return nil, nil
+	}
+	first, last = strings.TrimSpace(first), strings.TrimSpace(last)
+
+	// suffix range of the last bytes of the file
+	if first == "" {
+		suffix, err := strconv.ParseInt(last, 10, 64)
+		if err != nil || suffix < 0 {
+			return nil, nil
+		}
+		if suffix == 0 || size == 0 {
+			return nil, ErrRangeNotSatisfiable
+		}
+		if suffix > size {
+			suffix = size
+		}
+		return &ByteRange{Start: size - suffix, Length: suffix}, nil
+	}
+
+	start, err := strconv.ParseInt(first, 10, 64)
+	if err != nil || start < 0 {
+		return nil, nil
+	}
+	end := size - 1
+	if last != "" {
+		end, err = strconv.ParseInt(last, 10, 64)
+		if err != nil || end < start {
+			return nil, nil
+		}
+	}
+	if start >= size {
+		return nil, ErrRangeNotSatisfiable
+	}
+	if end >= size {
+		end = size - 1
+	}
+	return &ByteRange{Start: start, Length: end - start + 1}, nil
+}
+
+// ObjectETag returns the entity tag of an object derived from its hash, it is empty if the hash of the object is
+// unknown
+func ObjectETag(hash []byte) string {
+	if len(hash) == 0 {
+		return ""
+	}
+	return strconv.Quote(hex.EncodeToString(hash))
+}
+
+// CheckNotModified returns true if the conditional headers of a GET request mean the copy of the client is still
+// valid, If-None-Match takes precedence over If-Modified-Since
+func CheckNotModified(header http.Header, etag string, lastModified time.Time) bool {
+	if ifNoneMatch := header.Get(HTTPHeaderIfNoneMatch); ifNoneMatch != "" {
+		for _, tag := range strings.Split(ifNoneMatch, ",") {
+			tag = strings.TrimSpace(tag)
+			if tag == "*" || (etag != "" && strings.TrimPrefix(tag, "W/") == etag) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if ifModifiedSince := header.Get(HTTPHeaderIfModifiedSince); ifModifiedSince != "" && !lastModified.IsZero() {
+		since, err := http.ParseTime(ifModifiedSince)
+		if err != nil {
+			return false
+		}
+		return !lastModified.Truncate(time.Second).After(since)
+	}
+	return false
+}
+
+// CheckIfRange returns true if the Range header of a request should be evaluated, which is the case if there is no
+// If-Range header or the validator in it still matches the file
+func CheckIfRange(header http.Header, etag string, lastModified time.Time) bool {
+	ifRange := header.Get(HTTPHeaderIfRange)
+	if ifRange == "" {
+		return true
+	}
+	if strings.HasPrefix(ifRange, `"`) || strings.HasPrefix(ifRange, "W/") {
+		return etag != "" && ifRange == etag
+	}
+	if lastModified.IsZero() {
+		return false
+	}
+	date, err := http.ParseTime(ifRange)
+	if err != nil {
+		return false
+	}
+	return lastModified.Truncate(time.Second).Equal(date)
+}
@@ -0,0 +1,78 @@
+package types_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/node-real/greenfield-bundle-service/types"
+)
+
+func TestParseRange(t *testing.T) {
+	for _, tc := range []struct {
+		header string
+		want   *types.ByteRange
+		err    error
+	}{
+		{header: "", want: nil},
+		{header: "bytes=0-99", want: &types.ByteRange{Start: 0, Length: 100}},
+		{header: "bytes=100-", want: &types.ByteRange{Start: 100, Length: 900}},
+		{header: "bytes=900-2000", want: &types.ByteRange{Start: 900, Length: 100}},
+		{header: "bytes=-10", want: &types.ByteRange{Start: 990, Length: 10}},
+		{header: "bytes=-5000", want: &types.ByteRange{Start: 0, Length: 1000}},
+		{header: "Bytes= 10 - 19 ", want: &types.ByteRange{Start: 10, Length: 10}},
+		// malformed headers and several ranges are ignored
+		{header: "items=0-9", want: nil},
+		{header: "bytes=9-0", want: nil},
+		{header: "bytes=a-b", want: nil},
+		{header: "bytes=0-9,20-29", want: nil},
+		// ranges out of the file are not satisfiable
+		{header: "bytes=1000-", err: types.ErrRangeNotSatisfiable},
+		{header: "bytes=-0", err: types.ErrRangeNotSatisfiable},
+	} {
+		got, err := types.ParseRange(tc.header, 1000)
+		assert.Equal(t, tc.err, err, tc.header)
+		assert.Equal(t, tc.want, got, tc.header)
+	}
+
+	byteRange, err := types.ParseRange("bytes=10-19", 1000)
+	require.NoError(t, err)
+	assert.Equal(t, "bytes 10-19/1000", byteRange.ContentRange(1000))
+}
+
+func TestConditionalHeaders(t *testing.T) {
+	etag := types.ObjectETag([]byte{0xab, 0xcd})
+	assert.Equal(t, `"abcd"`, etag)
+	assert.Empty(t, types.ObjectETag(nil))
+
+	lastModified := time.Date(2024, 1, 2, 3, 4, 5, 600, time.UTC)
+	header := func(kv ...string) http.Header {
+		h := http.Header{}
+		for i := 0; i < len(kv); i += 2 {
+			h.Set(kv[i], kv[i+1])
+		}
+		return h
+	}
+
+	assert.False(t, types.CheckNotModified(header(), etag, lastModified))
+	assert.True(t, types.CheckNotModified(header(types.HTTPHeaderIfNoneMatch, `"other", W/"abcd"`), etag, lastModified))
+	assert.True(t, types.CheckNotModified(header(types.HTTPHeaderIfNoneMatch, "*"), "", lastModified))
+	assert.False(t, types.CheckNotModified(header(types.HTTPHeaderIfNoneMatch, `"other"`), etag, lastModified))
+	// If-None-Match takes precedence over If-Modified-Since
+	assert.False(t, types.CheckNotModified(header(
+		types.HTTPHeaderIfNoneMatch, `"other"`,
+		types.HTTPHeaderIfModifiedSince, lastModified.Format(http.TimeFormat),
+	), etag, lastModified))
+	assert.True(t, types.CheckNotModified(header(types.HTTPHeaderIfModifiedSince, lastModified.Format(http.TimeFormat)), etag, lastModified))
+	assert.False(t, types.CheckNotModified(header(types.HTTPHeaderIfModifiedSince, lastModified.Add(-time.Hour).Format(http.TimeFormat)), etag, lastModified))
+
+	assert.True(t, types.CheckIfRange(header(), etag, lastModified))
+	assert.True(t, types.CheckIfRange(header(types.HTTPHeaderIfRange, etag), etag, lastModified))
+	assert.False(t, types.CheckIfRange(header(types.HTTPHeaderIfRange, `W/"abcd"`), etag, lastModified))
+	assert.False(t, types.CheckIfRange(header(types.HTTPHeaderIfRange, `"other"`), etag, lastModified))
+	assert.True(t, types.CheckIfRange(header(types.HTTPHeaderIfRange, lastModified.Format(http.TimeFormat)), etag, lastModified))
+	assert.False(t, types.CheckIfRange(header(types.HTTPHeaderIfRange, lastModified.Add(time.Hour).Format(http.TimeFormat)), etag, lastModified))
+}
@@ -0,0 +1,114 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/node-real/greenfield-bundle-service/models"
+)
+
+const (
+	SessionKeyScopeUpload   = "upload"
+	SessionKeyScopeFinalize = "finalize"
+	SessionKeyScopeRules    = "rules"
+)
+
+// sessionKeyOperationScopes are the scopes of the api operations which can be signed by session keys, the other
+// operations, e.g. deleting bundles or registering session keys, can only be signed by the owners
+var sessionKeyOperationScopes = map[string]string{
+	"uploadObject":         SessionKeyScopeUpload,
+	"uploadObjects":        SessionKeyScopeUpload,
+	"uploadBundle":         SessionKeyScopeUpload,
+	"initiateBundleUpload": SessionKeyScopeUpload,
+	"uploadBundleChunk":    SessionKeyScopeUpload,
+	"queryBundleUpload":    SessionKeyScopeUpload,
+	"completeBundleUpload": SessionKeyScopeUpload,
+	"createBundle":         SessionKeyScopeUpload,
+	"finalizeBundle":       SessionKeyScopeFinalize,
+	"setBundleRule":        SessionKeyScopeRules,
+}
+
+var (
+	// ErrSessionKeyNotPermitted is returned by the SessionKeyStore when the session key is not registered, revoked,
+	// expired, or not permitted to perform the operation on the bucket
+	ErrSessionKeyNotPermitted = errors.New("session key is not permitted")
+	// ErrSessionKeyQuotaExceeded is returned by the SessionKeyStore when the quotas of the session key are used up
+	ErrSessionKeyQuotaExceeded = errors.New("session key quota exceeded")
+)
+
+// SessionKeyStore checks and records the requests signed by the session keys, UseSessionKey consumes the quotas of
+// the session key for the operation on the bucket and returns the owner of the session key
+type SessionKeyStore interface {
+	UseSessionKey(sessionKey string, bucket string, operation string, size int64) (string, error)
+}
+
+// sessionKeyStore checks the requests signed by the session keys, which are rejected until it is set
+var sessionKeyStore SessionKeyStore
+
+// SetSessionKeyStore sets the store of the session keys
+func SetSessionKeyStore(store SessionKeyStore) {
+	sessionKeyStore = store
+}
+
+// GetSessionKeyScope returns the scope of the api operation, it returns false if the operation can not be signed by
+// session keys
+func GetSessionKeyScope(operation string) (string, bool) {
+	scope, ok := sessionKeyOperationScopes[operation]
+	return scope, ok
+}
+
+// ParseSessionKeyScopes parses the scopes of a session key separated by commas, the duplicated scopes are removed
+func ParseSessionKeyScopes(scopes string) ([]string, error) {
+	var parsed []string
+	seen := make(map[string]struct{})
+	for _, scope := range strings.Split(scopes, ",") {
+		scope = strings.TrimSpace(scope)
+		switch scope {
+		case SessionKeyScopeUpload, SessionKeyScopeFinalize, SessionKeyScopeRules:
+		default:
+			return nil, fmt.Errorf("invalid session key scope %q", scope)
+		}
+		if _, ok := seen[scope]; ok {
+			continue
+		}
+		seen[scope] = struct{}{}
+		parsed = append(parsed, scope)
+	}
+	return parsed, nil
+}
+
+// validateSessionKey checks that the request is signed by the session key in its header, which is permitted to
+// perform the operation on the bucket of the request, and returns the owner of the session key
+func validateSessionKey(req *http.Request, signerAddress common.Address) (common.Address, *models.Error) {
+	sessionKey := req.Header.Get(HTTPHeaderSessionKey)
+	if !common.IsHexAddress(sessionKey) || common.HexToAddress(sessionKey) != signerAddress {
+		return common.Address{}, ErrorInvalidSessionKey
+	}
+	if sessionKeyStore == nil {
+		return common.Address{}, ErrorInvalidSessionKey
+	}
+
+	bucketName := req.Header.Get(HTTPHeaderBucketName)
+	if bucketName == "" {
+		return common.Address{}, InvalidSessionKeyErrorWithError(errors.New("bucket name is required for session key"))
+	}
+	operation := getOperationId(req)
+	if _, ok := GetSessionKeyScope(operation); !ok {
+		return common.Address{}, InvalidSessionKeyErrorWithError(fmt.Errorf("operation %q is not permitted for session key", operation))
+	}
+
+	owner, err := sessionKeyStore.UseSessionKey(signerAddress.String(), bucketName, operation, req.ContentLength)
+	switch {
+	case errors.Is(err, ErrSessionKeyNotPermitted):
+		return common.Address{}, ErrorInvalidSessionKey
+	case errors.Is(err, ErrSessionKeyQuotaExceeded):
+		return common.Address{}, ErrorSessionKeyQuotaExceeded
+	case err != nil:
+		return common.Address{}, InternalErrorWithError(err)
+	}
+	return common.HexToAddress(owner), nil
+}
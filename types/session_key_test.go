@@ -0,0 +1,68 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/node-real/greenfield-bundle-service/types"
+)
+
+type mockSessionKeyStore struct {
+	owner string
+	err   error
+}
+
+func (s *mockSessionKeyStore) UseSessionKey(_ string, _ string, _ string, _ int64) (string, error) {
+	return s.owner, s.err
+}
+
+func TestValidateHeaders_SessionKey(t *testing.T) {
+	types.SetSessionKeyStore(&mockSessionKeyStore{owner: "0x0000000000000000000000000000000000000001"})
+	defer types.SetSessionKeyStore(nil)
+
+	// the session key header should be the signer of the request
+	req := newSignedRequest(t)
+	req.Header.Set(types.HTTPHeaderSessionKey, "0x0000000000000000000000000000000000000002")
+	signEIP712(t, req, 5600)
+	_, merr := types.ValidateHeaders(req)
+	assert.Equal(t, types.ErrorInvalidSessionKey, merr)
+
+	// the operations which are not in the scopes of the session keys are rejected, e.g. a request which is not routed
+	privateKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	req = newSignedRequest(t)
+	req.Header.Set(types.HTTPHeaderSessionKey, crypto.PubkeyToAddress(privateKey.PublicKey).String())
+	signEIP712WithKey(t, req, 5600, privateKey)
+	_, merr = types.ValidateHeaders(req)
+	require.NotNil(t, merr)
+	assert.Equal(t, types.ErrorInvalidSessionKey.Code, merr.Code)
+
+	// the requests without a session key are not affected
+	req = newSignedRequest(t)
+	signer := signEIP712(t, req, 5600)
+	address, merr := types.ValidateHeaders(req)
+	require.Nil(t, merr)
+	assert.Equal(t, signer, address)
+	assert.NotEqual(t, common.HexToAddress("0x0000000000000000000000000000000000000001"), address)
+}
+
+func TestParseSessionKeyScopes(t *testing.T) {
+	scopes, err := types.ParseSessionKeyScopes("upload, finalize,upload")
+	require.NoError(t, err)
+	assert.Equal(t, []string{types.SessionKeyScopeUpload, types.SessionKeyScopeFinalize}, scopes)
+
+	_, err = types.ParseSessionKeyScopes("upload,delete")
+	assert.Error(t, err)
+	_, err = types.ParseSessionKeyScopes("")
+	assert.Error(t, err)
+
+	scope, ok := types.GetSessionKeyScope("setBundleRule")
+	assert.True(t, ok)
+	assert.Equal(t, types.SessionKeyScopeRules, scope)
+	_, ok = types.GetSessionKeyScope("createSessionKey")
+	assert.False(t, ok)
+}
@@ -17,10 +17,20 @@ const (
 	MinFinalizeTime = 60 // 1 minute
 	MinBundleFiles  = 1  // 1 file
 
-	MaxTagsLength = 1024
+	MaxTagsLength   = 1024
+	MaxTagKeyLength = 256
 
 	MaxBundleNameLength = 128
 	MaxObjectNameLength = 512
+	MaxNonceLength      = 128
+
+	MaxSessionKeyBuckets   = 100
+	MaxSessionKeyExpiryAge = 30 * 24 * 60 * 60 // 30 days
+
+	MaxUploadObjects             = 1000            // max objects uploaded in one uploadObjects request
+	MaxUploadObjectsManifestSize = 4 * 1024 * 1024 // 4MB
+
+	BundleUploadChunkSize = 16 * 1024 * 1024 // 16MB, the size of the chunks of a resumable bundle upload
 )
 
 var (
@@ -105,6 +115,94 @@ var (
 		Code:    10018,
 		Message: "Invalid bundle rule params",
 	}
+	ErrorInvalidManifest = &models.Error{
+		Code:    10019,
+		Message: "Invalid manifest",
+	}
+	ErrorBundlingBundleChanged = &models.Error{
+		Code:    10020,
+		Message: "Bundling bundle changed during the upload, please retry",
+	}
+	ErrorBundleUploadNotExist = &models.Error{
+		Code:    10021,
+		Message: "Bundle upload does not exist",
+	}
+	ErrorInvalidBundleUploadStatus = &models.Error{
+		Code:    10022,
+		Message: "Bundle upload is not uploading",
+	}
+	ErrorInvalidBundleUploadChunk = &models.Error{
+		Code:    10023,
+		Message: "Invalid bundle upload chunk",
+	}
+	ErrorObjectNotExist = &models.Error{
+		Code:    10024,
+		Message: "Object does not exist",
+	}
+	ErrorGnfdObjectExist = &models.Error{
+		Code:    10025,
+		Message: "Object already exists on Greenfield",
+	}
+	ErrorBundleExist = &models.Error{
+		Code:    10026,
+		Message: "Bundle already exists",
+	}
+	ErrorObjectHashMismatch = &models.Error{
+		Code:    10027,
+		Message: "Object content does not match its hash",
+	}
+	ErrorDuplicateObjectName = &models.Error{
+		Code:    10028,
+		Message: "Duplicate object name in bundle",
+	}
+	ErrorObjectRangeOverlap = &models.Error{
+		Code:    10029,
+		Message: "Objects overlap in bundle",
+	}
+	ErrorObjectOutOfBundle = &models.Error{
+		Code:    10030,
+		Message: "Object exceeds bundle size",
+	}
+	ErrorUnsupportedHashAlgo = &models.Error{
+		Code:    10031,
+		Message: "Unsupported hash algorithm",
+	}
+	ErrorNonceUsed = &models.Error{
+		Code:    10032,
+		Message: "Nonce is already used",
+	}
+	ErrorInvalidNonce = &models.Error{
+		Code:    10033,
+		Message: "Invalid nonce",
+	}
+	ErrorInvalidSessionKey = &models.Error{
+		Code:    10034,
+		Message: "Invalid session key",
+	}
+	ErrorSessionKeyQuotaExceeded = &models.Error{
+		Code:    10035,
+		Message: "Session key quota exceeded",
+	}
+	ErrorSessionKeyExist = &models.Error{
+		Code:    10036,
+		Message: "Session key already exists",
+	}
+	ErrorSessionKeyNotExist = &models.Error{
+		Code:    10037,
+		Message: "Session key does not exist",
+	}
+	ErrorAccessDenied = &models.Error{
+		Code:    10038,
+		Message: "Access denied",
+	}
+	ErrorInvalidPresignedUrl = &models.Error{
+		Code:    10039,
+		Message: "Invalid presigned url",
+	}
+	ErrorObjectHashMissing = &models.Error{
+		Code:    10040,
+		Message: "Object has no hash in bundle meta",
+	}
 )
 
 func InvalidSignatureErrorWithError(err error) *models.Error {
@@ -162,3 +260,38 @@ func InvalidTagsErrorWithError(err error) *models.Error {
 		Message: err.Error(),
 	}
 }
+
+func InvalidManifestErrorWithError(err error) *models.Error {
+	return &models.Error{
+		Code:    10019,
+		Message: err.Error(),
+	}
+}
+
+func InvalidBundleUploadChunkErrorWithError(err error) *models.Error {
+	return &models.Error{
+		Code:    10023,
+		Message: err.Error(),
+	}
+}
+
+func InvalidSessionKeyErrorWithError(err error) *models.Error {
+	return &models.Error{
+		Code:    10034,
+		Message: err.Error(),
+	}
+}
+
+func AccessDeniedErrorWithError(err error) *models.Error {
+	return &models.Error{
+		Code:    10038,
+		Message: err.Error(),
+	}
+}
+
+func InvalidPresignedUrlErrorWithError(err error) *models.Error {
+	return &models.Error{
+		Code:    10039,
+		Message: err.Error(),
+	}
+}
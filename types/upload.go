@@ -0,0 +1,100 @@
+package types
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	bundleTypes "github.com/bnb-chain/greenfield-bundle-sdk/types"
+
+	"github.com/node-real/greenfield-bundle-service/models"
+)
+
+// UploadObjectsEntry describes an object of an uploadObjects request, the manifest of the request is a JSON array of
+// the entries in the order of the files
+type UploadObjectsEntry struct {
+	Name        string            `json:"name"`
+	Sha256      string            `json:"sha256"`
+	ContentType string            `json:"contentType"`
+	Tags        map[string]string `json:"tags,omitempty"`
+}
+
+// TagsString returns the tags of the entry in the JSON form they are stored in, empty if there are no tags
+func (e *UploadObjectsEntry) TagsString() string {
+	if len(e.Tags) == 0 {
+		return ""
+	}
+	tags, _ := json.Marshal(e.Tags)
+	return string(tags)
+}
+
+// ParseUploadObjectsManifest parses and validates the manifest of an uploadObjects request
+func ParseUploadObjectsManifest(manifest []byte) ([]UploadObjectsEntry, *models.Error) {
+	var entries []UploadObjectsEntry
+	if err := json.Unmarshal(manifest, &entries); err != nil {
+		return nil, InvalidManifestErrorWithError(err)
+	}
+	if len(entries) == 0 || len(entries) > MaxUploadObjects {
+		return nil, InvalidManifestErrorWithError(fmt.Errorf("manifest should have 1 to %d objects", MaxUploadObjects))
+	}
+
+	names := make(map[string]bool, len(entries))
+	for i := range entries {
+		entry := &entries[i]
+		if entry.Name == "" {
+			return nil, InvalidObjectNameErrorWithError(fmt.Errorf("object name is empty"))
+		}
+		if merr := ValidateObjectName(entry.Name); merr != nil {
+			return nil, merr
+		}
+		if names[entry.Name] {
+			return nil, InvalidManifestErrorWithError(fmt.Errorf("duplicate object name %s", entry.Name))
+		}
+		names[entry.Name] = true
+
+		if hash, err := hex.DecodeString(entry.Sha256); err != nil || len(hash) != 32 {
+			return nil, InvalidManifestErrorWithError(fmt.Errorf("invalid sha256 of object %s", entry.Name))
+		}
+		if entry.ContentType == "" {
+			return nil, InvalidManifestErrorWithError(fmt.Errorf("content type of object %s is empty", entry.Name))
+		}
+		if tags := entry.TagsString(); len(tags) > MaxTagsLength {
+			return nil, InvalidTagsErrorWithError(fmt.Errorf("tags length should be less than %d", MaxTagsLength))
+		}
+		if merr := ValidateTagKeys(entry.Tags); merr != nil {
+			return nil, merr
+		}
+	}
+	return entries, nil
+}
+
+// ValidateBundleObjectsMeta checks the objects in the meta of a bundle whose object data takes dataSize bytes, the
+// objects should have distinct names, and lie within the object data without overlapping each other
+func ValidateBundleObjectsMeta(metas []*bundleTypes.ObjectMeta, dataSize int64) *models.Error {
+	names := make(map[string]bool, len(metas))
+	ranges := make([]*bundleTypes.ObjectMeta, 0, len(metas))
+	for _, meta := range metas {
+		if names[meta.Name] {
+			return ErrorDuplicateObjectName
+		}
+		names[meta.Name] = true
+
+		if dataSize < 0 || meta.Offset > uint64(dataSize) || meta.Size > uint64(dataSize)-meta.Offset {
+			return ErrorObjectOutOfBundle
+		}
+		if meta.Size > 0 {
+			ranges = append(ranges, meta)
+		}
+	}
+
+	sort.Slice(ranges, func(i, j int) bool {
+		return ranges[i].Offset < ranges[j].Offset
+	})
+	for i := 1; i < len(ranges); i++ {
+		if ranges[i-1].Offset+ranges[i-1].Size > ranges[i].Offset {
+			return ErrorObjectRangeOverlap
+		}
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	bundleTypes "github.com/bnb-chain/greenfield-bundle-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/node-real/greenfield-bundle-service/models"
+	"github.com/node-real/greenfield-bundle-service/types"
+)
+
+func TestParseUploadObjectsManifest(t *testing.T) {
+	sha := strings.Repeat("ab", 32)
+
+	entries, merr := types.ParseUploadObjectsManifest([]byte(`[
+		{"name": "a.txt", "sha256": "` + sha + `", "contentType": "text/plain", "tags": {"team": "a", "kind": "doc"}},
+		{"name": "b.png", "sha256": "` + sha + `", "contentType": "image/png"}
+	]`))
+	require.Nil(t, merr)
+	require.Len(t, entries, 2)
+	assert.Equal(t, `{"kind":"doc","team":"a"}`, entries[0].TagsString())
+	assert.Empty(t, entries[1].TagsString())
+
+	for _, manifest := range []string{
+		`{}`,
+		`[]`,
+		`[{"name": "", "sha256": "` + sha + `", "contentType": "text/plain"}]`,
+		`[{"name": "a.txt", "sha256": "abcd", "contentType": "text/plain"}]`,
+		`[{"name": "a.txt", "sha256": "` + sha + `"}]`,
+		`[{"name": "a.txt", "sha256": "` + sha + `", "contentType": "text/plain"}, {"name": "a.txt", "sha256": "` + sha + `", "contentType": "text/plain"}]`,
+		`[{"name": "a.txt", "sha256": "` + sha + `", "contentType": "text/plain", "tags": {"` + strings.Repeat("k", types.MaxTagKeyLength+1) + `": "v"}}]`,
+	} {
+		_, merr = types.ParseUploadObjectsManifest([]byte(manifest))
+		assert.NotNil(t, merr, manifest)
+	}
+}
+
+func TestValidateBundleObjectsMeta(t *testing.T) {
+	meta := func(name string, offset, size uint64) *bundleTypes.ObjectMeta {
+		return &bundleTypes.ObjectMeta{Name: name, Offset: offset, Size: size}
+	}
+
+	for _, tc := range []struct {
+		name  string
+		metas []*bundleTypes.ObjectMeta
+		want  *models.Error
+	}{
+		{name: "valid", metas: []*bundleTypes.ObjectMeta{meta("b", 10, 90), meta("a", 0, 10), meta("empty", 10, 0)}},
+		{name: "duplicate name", metas: []*bundleTypes.ObjectMeta{meta("a", 0, 10), meta("a", 10, 10)}, want: types.ErrorDuplicateObjectName},
+		{name: "overlap", metas: []*bundleTypes.ObjectMeta{meta("a", 0, 10), meta("b", 50, 10), meta("c", 9, 10)}, want: types.ErrorObjectRangeOverlap},
+		{name: "same range", metas: []*bundleTypes.ObjectMeta{meta("a", 0, 10), meta("b", 0, 10)}, want: types.ErrorObjectRangeOverlap},
+		{name: "offset beyond size", metas: []*bundleTypes.ObjectMeta{meta("a", 101, 0)}, want: types.ErrorObjectOutOfBundle},
+		{name: "end beyond size", metas: []*bundleTypes.ObjectMeta{meta("a", 90, 11)}, want: types.ErrorObjectOutOfBundle},
+		{name: "size overflow", metas: []*bundleTypes.ObjectMeta{meta("a", 1, ^uint64(0))}, want: types.ErrorObjectOutOfBundle},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, types.ValidateBundleObjectsMeta(tc.metas, 100))
+		})
+	}
+}
@@ -2,21 +2,54 @@ package util
 
 import (
 	"encoding/json"
+	"fmt"
+	"math/big"
 	"os"
+	"regexp"
 )
 
 type BundleConfig struct {
-	BundlerPrivateKeys []string `json:"bundler_private_keys"`
-	AWSRegion          string   `json:"aws_region"`
-	AWSSecretName      string   `json:"aws_secret_name"`
-	LocalStoragePath   string   `json:"local_storage_path"`
-	OssIAMType         string   `json:"oss_iam_type"`
-	OssBucketUrl       string   `json:"oss_bucket_url"`
+	BundlerPrivateKeys       []string `json:"bundler_private_keys"`
+	AWSRegion                string   `json:"aws_region"`
+	AWSSecretName            string   `json:"aws_secret_name"`
+	StorageBackend           string   `json:"storage_backend"` // one of "local", "oss" and "s3"
+	LocalStoragePath         string   `json:"local_storage_path"`
+	OssIAMType               string   `json:"oss_iam_type"`
+	OssBucketUrl             string   `json:"oss_bucket_url"`
+	OssPartSize              int64    `json:"oss_part_size"`        // part size of multipart uploads in bytes, 16MB by default
+	OssPartParallelism       int      `json:"oss_part_parallelism"` // number of parts uploaded concurrently, 4 by default
+	S3Endpoint               string   `json:"s3_endpoint"`          // leave empty for AWS S3, set to the service url for MinIO and etc.
+	S3Region                 string   `json:"s3_region"`
+	S3Bucket                 string   `json:"s3_bucket"`
+	S3ForcePathStyle         bool     `json:"s3_force_path_style"`
+	LeaseDuration            int64    `json:"lease_duration"`              // lease duration of the bundler replicas in seconds, 30 by default
+	ShutdownTimeout          int64    `json:"shutdown_timeout"`            // seconds for the in-flight uploads and submissions to finish on shutdown, 15 by default
+	ObjectNameConflictPolicy string   `json:"object_name_conflict_policy"` // one of "latest" and "reject", "latest" by default
+	CompactionThreshold      float64  `json:"compaction_threshold"`        // fraction of a sealed bundle deleted before it is compacted, 0.5 by default
+	HashAlgo                 string   `json:"hash_algo"`                   // hash algorithm of the uploaded objects, "SHA256" by default
 }
 
 type GnfdConfig struct {
-	ChainId string `json:"chain_id"`
-	RpcUrl  string `json:"rpc_url"`
+	ChainId          string `json:"chain_id"`
+	RpcUrl           string `json:"rpc_url"`
+	ReaderPrivateKey string `json:"reader_private_key"` // key of the read-only account of the server, a random account is used if it is empty
+}
+
+// gnfdChainIdRegexp matches the Greenfield chain ids, e.g. greenfield_1017-1, whose number after "_" is the EIP-155
+// chain id
+var gnfdChainIdRegexp = regexp.MustCompile(`^[a-z]+_([1-9][0-9]*)-[1-9][0-9]*$`)
+
+// EIP155ChainId returns the EIP-155 chain id of the Greenfield chain, e.g. 5600 of greenfield_5600-1
+func (c *GnfdConfig) EIP155ChainId() (*big.Int, error) {
+	matches := gnfdChainIdRegexp.FindStringSubmatch(c.ChainId)
+	if matches == nil {
+		return nil, fmt.Errorf("invalid greenfield chain id %s", c.ChainId)
+	}
+	chainId, ok := new(big.Int).SetString(matches[1], 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid greenfield chain id %s", c.ChainId)
+	}
+	return chainId, nil
 }
 
 type DBConfig struct {
@@ -41,11 +74,31 @@ type LogConfig struct {
 	Compress                     bool   `json:"compress"`
 }
 
+type MetricsConfig struct {
+	Enable bool `json:"enable"`
+	Port   int  `json:"port"`
+}
+
+type CacheConfig struct {
+	MaxEntries  int   `json:"max_entries"`  // max number of buckets and of permissions cached, 10000 by default
+	TTL         int64 `json:"ttl"`          // seconds the bucket info and granted permissions are cached, 60 by default
+	NegativeTTL int64 `json:"negative_ttl"` // seconds the missing buckets and denied permissions are cached, 10 by default
+}
+
+type AuthConfig struct {
+	MaxExpiryAges map[string]int64 `json:"max_expiry_ages"` // max seconds before the requests of an api operation expire, e.g. {"deleteBundle": 300}, 7 days by default
+	EnablePresign bool             `json:"enable_presign"`  // whether the presigned urls are enabled, they need a presign secret of at least 32 bytes
+	PresignSecret string           `json:"presign_secret"`  // key of the signatures of the presigned urls shared by the servers, read from the PRESIGN_SECRET env if it is empty
+}
+
 type ServerConfig struct {
-	DBConfig     *DBConfig     `json:"db_config"`
-	BundleConfig *BundleConfig `json:"bundle_config"`
-	GnfdConfig   *GnfdConfig   `json:"gnfd_config"`
-	LogConfig    *LogConfig    `json:"log_config"`
+	DBConfig      *DBConfig      `json:"db_config"`
+	BundleConfig  *BundleConfig  `json:"bundle_config"`
+	GnfdConfig    *GnfdConfig    `json:"gnfd_config"`
+	LogConfig     *LogConfig     `json:"log_config"`
+	MetricsConfig *MetricsConfig `json:"metrics_config"`
+	CacheConfig   *CacheConfig   `json:"cache_config"`
+	AuthConfig    *AuthConfig    `json:"auth_config"`
 }
 
 func ParseServerConfigFromFile(filePath string) *ServerConfig {
@@ -67,6 +120,14 @@ func ParseServerConfigFromFile(filePath string) *ServerConfig {
 		config.DBConfig.Username, config.DBConfig.Password = GetDBUsernamePasswordFromSM(config.DBConfig)
 	}
 
+	if config.AuthConfig != nil && config.AuthConfig.PresignSecret == "" {
+		config.AuthConfig.PresignSecret = os.Getenv("PRESIGN_SECRET")
+	}
+
+	if config.GnfdConfig.ReaderPrivateKey == "" {
+		config.GnfdConfig.ReaderPrivateKey = os.Getenv("GNFD_READER_PRIVATE_KEY")
+	}
+
 	if len(config.BundleConfig.BundlerPrivateKeys) == 0 {
 		config.BundleConfig.BundlerPrivateKeys = GetBundlerPrivateKeysFromEnv(config.BundleConfig)
 	}
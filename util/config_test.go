@@ -25,3 +25,16 @@ func TestGetBundlerPrivateKeysFromEnv(t *testing.T) {
 	bts, _ := json.Marshal(dbKeys)
 	println(string(bts))
 }
+
+func TestGnfdConfig_EIP155ChainId(t *testing.T) {
+	chainId, err := (&GnfdConfig{ChainId: "greenfield_5600-1"}).EIP155ChainId()
+	if err != nil || chainId.Int64() != 5600 {
+		t.Fatalf("unexpected chain id %v, err=%v", chainId, err)
+	}
+
+	for _, invalid := range []string{"", "greenfield", "greenfield_-1", "greenfield_0-1", "5600"} {
+		if _, err := (&GnfdConfig{ChainId: invalid}).EIP155ChainId(); err == nil {
+			t.Fatalf("chain id %s should be invalid", invalid)
+		}
+	}
+}